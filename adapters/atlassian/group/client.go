@@ -0,0 +1,150 @@
+package group
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ErrUnexpectedResponse is returned when the Atlassian API responds with an unexpected status code.
+var ErrUnexpectedResponse = errors.New("unexpected response from atlassian")
+
+// user is an Atlassian user, with only the properties used by the adapter. EmailAddress is empty if the user's
+// profile visibility settings hide it.
+type user struct {
+	AccountID    string `json:"accountId"`
+	EmailAddress string `json:"emailAddress"`
+}
+
+// Client is a minimal Jira Cloud REST API v3 client, authenticated with an Atlassian account's email and API token.
+type Client struct {
+	httpClient *http.Client
+	server     string
+	email      string
+	token      string
+}
+
+// NewClient creates a new Atlassian client for a site URL, e.g. https://example.atlassian.net.
+func NewClient(siteURL string, email string, token string) *Client {
+	return &Client{
+		httpClient: http.DefaultClient,
+		server:     strings.TrimSuffix(siteURL, "/"),
+		email:      email,
+		token:      token,
+	}
+}
+
+// WithHTTPClient sets a custom HTTP client, e.g. to configure timeouts or proxies.
+func (c *Client) WithHTTPClient(httpClient *http.Client) *Client {
+	c.httpClient = httpClient
+
+	return c
+}
+
+// do makes a request to the Jira API, and decodes the response into out if it isn't nil.
+func (c *Client) do(ctx context.Context, method string, path string, body interface{}, out interface{}) error {
+	var reader io.Reader
+
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshal -> %w", err)
+		}
+
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.server+"/rest/api/3"+path, reader)
+	if err != nil {
+		return fmt.Errorf("newrequest(%s, %s) -> %w", method, path, err)
+	}
+
+	req.SetBasicAuth(c.email, c.token)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("do(%s, %s) -> %w", method, path, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("do(%s, %s) -> %w: %s", method, path, ErrUnexpectedResponse, res.Status)
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+			return fmt.Errorf("decode(%s, %s) -> %w", method, path, err)
+		}
+	}
+
+	return nil
+}
+
+// GetGroupMembers gets a page of active users in a group, starting at startAt, and whether it's the last page.
+func (c *Client) GetGroupMembers(
+	ctx context.Context,
+	groupID string,
+	startAt int,
+	maxResults int,
+) ([]user, bool, error) {
+	query := url.Values{
+		"groupId":    {groupID},
+		"startAt":    {strconv.Itoa(startAt)},
+		"maxResults": {strconv.Itoa(maxResults)},
+	}
+
+	var out struct {
+		Values []user `json:"values"`
+		IsLast bool   `json:"isLast"`
+	}
+
+	if err := c.do(ctx, http.MethodGet, "/group/member?"+query.Encode(), nil, &out); err != nil {
+		return nil, false, err
+	}
+
+	return out.Values, out.IsLast, nil
+}
+
+// FindUserByEmail finds a user by their email, or returns nil if there isn't a user whose email is visible and
+// matches exactly.
+func (c *Client) FindUserByEmail(ctx context.Context, email string) (*user, error) {
+	query := url.Values{"query": {email}}
+
+	var users []user
+
+	if err := c.do(ctx, http.MethodGet, "/user/search?"+query.Encode(), nil, &users); err != nil {
+		return nil, err
+	}
+
+	// The search matches display names and partial emails too.
+	for _, found := range users {
+		if strings.EqualFold(found.EmailAddress, email) {
+			return &found, nil
+		}
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+// AddUserToGroup adds a user to a group by their account ID.
+func (c *Client) AddUserToGroup(ctx context.Context, groupID string, accountID string) error {
+	query := url.Values{"groupId": {groupID}}
+
+	return c.do(ctx, http.MethodPost, "/group/user?"+query.Encode(), map[string]string{"accountId": accountID}, nil)
+}
+
+// RemoveUserFromGroup removes a user from a group by their account ID.
+func (c *Client) RemoveUserFromGroup(ctx context.Context, groupID string, accountID string) error {
+	query := url.Values{"groupId": {groupID}, "accountId": {accountID}}
+
+	return c.do(ctx, http.MethodDelete, "/group/user?"+query.Encode(), nil, nil)
+}
@@ -0,0 +1,108 @@
+package group
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// startServer starts a fake Jira API, which checks each request is authenticated before passing it to handler.
+func startServer(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		email, token, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "admin@email", email)
+		assert.Equal(t, "token", token)
+
+		handler(w, r)
+	}))
+
+	t.Cleanup(server.Close)
+
+	return NewClient(server.URL+"/", "admin@email", "token")
+}
+
+//nolint:funlen
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("GetGroupMembers", func(t *testing.T) {
+		t.Parallel()
+
+		client := startServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/rest/api/3/group/member", r.URL.Path)
+			assert.Equal(t, "group-id", r.URL.Query().Get("groupId"))
+			assert.Equal(t, "50", r.URL.Query().Get("startAt"))
+			assert.Equal(t, "50", r.URL.Query().Get("maxResults"))
+
+			_, _ = w.Write([]byte(`{"isLast":true,"values":[
+				{"accountId":"foo","emailAddress":"foo@email","displayName":"Foo"},
+				{"accountId":"hidden","displayName":"Hidden"}
+			]}`))
+		})
+
+		users, isLast, err := client.GetGroupMembers(ctx, "group-id", 50, 50)
+
+		assert.NoError(t, err)
+		assert.True(t, isLast)
+		assert.Equal(t, []user{{AccountID: "foo", EmailAddress: "foo@email"}, {AccountID: "hidden"}}, users)
+	})
+
+	t.Run("FindUserByEmail", func(t *testing.T) {
+		t.Parallel()
+
+		client := startServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/rest/api/3/user/search", r.URL.Path)
+
+			// The search also matches partial emails.
+			_, _ = w.Write([]byte(`[
+				{"accountId":"foobar","emailAddress":"foo.bar@email"},
+				{"accountId":"foo","emailAddress":"foo@email"}
+			]`))
+		})
+
+		found, err := client.FindUserByEmail(ctx, "Foo@Email")
+		assert.NoError(t, err)
+		assert.Equal(t, &user{AccountID: "foo", EmailAddress: "foo@email"}, found)
+
+		found, err = client.FindUserByEmail(ctx, "bar@email")
+		assert.NoError(t, err)
+		assert.Nil(t, found)
+	})
+
+	t.Run("Group users", func(t *testing.T) {
+		t.Parallel()
+
+		client := startServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/rest/api/3/group/user", r.URL.Path)
+			assert.Equal(t, "group-id", r.URL.Query().Get("groupId"))
+
+			switch r.Method {
+			case http.MethodPost:
+				var body map[string]string
+
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, map[string]string{"accountId": "foo"}, body)
+
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"groupId":"group-id"}`))
+			case http.MethodDelete:
+				if r.URL.Query().Get("accountId") != "foo" {
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}
+		})
+
+		assert.NoError(t, client.AddUserToGroup(ctx, "group-id", "foo"))
+		assert.NoError(t, client.RemoveUserFromGroup(ctx, "group-id", "foo"))
+		assert.ErrorIs(t, client.RemoveUserFromGroup(ctx, "group-id", "bar"), ErrUnexpectedResponse)
+	})
+}
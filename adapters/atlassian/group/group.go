@@ -0,0 +1,226 @@
+/*
+Package group synchronises email addresses with an Atlassian group, which grants access to Jira and Confluence.
+
+In order to use this adapter, you'll need an Atlassian API token for a user who is able to manage the group, e.g. a
+site or organization admin.
+*/
+package group
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	gosync "github.com/ovotech/go-sync"
+)
+
+// Ensure the adapter type fully satisfies the ports.Adapter and ports.ConfiguredAdapter interfaces.
+var (
+	_ gosync.Adapter           = &Group{}
+	_ gosync.ConfiguredAdapter = &Group{}
+)
+
+var (
+	// ErrUserNotFound is returned when an email can't be resolved to an Atlassian account ID.
+	ErrUserNotFound = errors.New("user not found")
+	// ErrMissingEmail is reported as a warning when a member's email is hidden by their profile visibility settings.
+	ErrMissingEmail = errors.New("member's email is hidden")
+)
+
+const perPage = 50 // perPage is the most group members Atlassian returns per page.
+
+// iAtlassianClient is a subset of the Atlassian Client, and used to build mocks for easy testing.
+type iAtlassianClient interface {
+	GetGroupMembers(ctx context.Context, groupID string, startAt int, maxResults int) ([]user, bool, error)
+	FindUserByEmail(ctx context.Context, email string) (*user, error)
+	AddUserToGroup(ctx context.Context, groupID string, accountID string) error
+	RemoveUserFromGroup(ctx context.Context, groupID string, accountID string) error
+}
+
+type Group struct {
+	client  iAtlassianClient
+	groupID string
+	// emailToAccountID resolves an email to the account ID of an Atlassian user.
+	emailToAccountID func(ctx context.Context, email string) (string, error)
+	// cache stores the email -> account ID mapping for use with the Remove method.
+	cache  map[string]string
+	logger *log.Logger
+}
+
+// WithEmailToAccountID sets how emails are resolved to Atlassian account IDs when adding and removing users, e.g.
+// from a directory export, for users whose emails are hidden by their profile visibility settings. By default, users
+// are searched for by email, which only finds users whose emails are visible. Return ErrUserNotFound (or an error
+// wrapping it) if the email doesn't belong to a user.
+func WithEmailToAccountID(fn func(ctx context.Context, email string) (string, error)) func(*Group) {
+	return func(group *Group) {
+		group.emailToAccountID = fn
+	}
+}
+
+// WithLogger sets a custom logger.
+func WithLogger(logger *log.Logger) func(*Group) {
+	return func(group *Group) {
+		group.logger = logger
+	}
+}
+
+// New instantiates a new Atlassian group adapter, for the group with the given ID.
+func New(client *Client, groupID string, optsFn ...func(*Group)) *Group {
+	group := &Group{
+		client:           client,
+		groupID:          groupID,
+		emailToAccountID: nil,
+		cache:            nil,
+		logger:           log.New(os.Stderr, "[go-sync/atlassian/group] ", log.LstdFlags|log.Lshortfile|log.Lmsgprefix),
+	}
+
+	// By default, search for users by email.
+	group.emailToAccountID = group.findAccountID
+
+	for _, fn := range optsFn {
+		fn(group)
+	}
+
+	return group
+}
+
+// Config returns the adapter's configuration, with the API token redacted.
+func (g *Group) Config() map[string]string {
+	config := map[string]string{
+		"group": g.groupID,
+	}
+
+	if client, ok := g.client.(*Client); ok {
+		config["server"] = client.server
+		config["email"] = client.email
+		config["token"] = gosync.Redacted
+	}
+
+	return config
+}
+
+// findAccountID searches for a user by email, and returns their account ID.
+func (g *Group) findAccountID(ctx context.Context, email string) (string, error) {
+	found, err := g.client.FindUserByEmail(ctx, email)
+	if err != nil {
+		return "", fmt.Errorf("finduserbyemail(%s) -> %w", email, err)
+	}
+
+	if found == nil {
+		return "", fmt.Errorf("finduserbyemail(%s) -> %w", email, ErrUserNotFound)
+	}
+
+	return found.AccountID, nil
+}
+
+// getAccountID returns the account ID of an email from the cache, or resolves it otherwise.
+func (g *Group) getAccountID(ctx context.Context, email string) (string, error) {
+	if accountID, ok := g.cache[email]; ok {
+		return accountID, nil
+	}
+
+	accountID, err := g.emailToAccountID(ctx, email)
+	if err != nil {
+		return "", fmt.Errorf("emailtoaccountid(%s) -> %w", email, err)
+	}
+
+	return accountID, nil
+}
+
+// Get emails of active users in an Atlassian group. Members whose emails are hidden by their profile visibility
+// settings can't be synchronised, so they're skipped and reported as an ErrMissingEmail warning.
+func (g *Group) Get(ctx context.Context) ([]string, error) {
+	g.logger.Printf("Fetching accounts from Atlassian group %s", g.groupID)
+
+	// Initialise the cache.
+	g.cache = make(map[string]string)
+
+	emails := make([]string, 0)
+
+	for startAt := 0; ; startAt += perPage {
+		users, isLast, err := g.client.GetGroupMembers(ctx, g.groupID, startAt, perPage)
+		if err != nil {
+			return nil, fmt.Errorf("atlassian.group.get.getgroupmembers(%s, %d) -> %w", g.groupID, startAt, err)
+		}
+
+		for _, user := range users {
+			if user.EmailAddress == "" {
+				gosync.Warn(ctx, fmt.Errorf("atlassian.group.get(%s) -> %w", user.AccountID, ErrMissingEmail))
+
+				continue
+			}
+
+			emails = append(emails, user.EmailAddress)
+
+			// Add the email -> account ID map for use with the Remove method.
+			g.cache[user.EmailAddress] = user.AccountID
+		}
+
+		if isLast || len(users) == 0 {
+			break
+		}
+	}
+
+	g.logger.Println("Fetched accounts successfully")
+
+	return emails, nil
+}
+
+// Add emails to an Atlassian group.
+func (g *Group) Add(ctx context.Context, emails []string) error {
+	g.logger.Printf("Adding %s to Atlassian group %s", emails, g.groupID)
+
+	for index, email := range emails {
+		accountID, err := g.getAccountID(ctx, email)
+		if err != nil {
+			return fmt.Errorf("atlassian.group.add -> %w", err)
+		}
+
+		err = g.client.AddUserToGroup(ctx, g.groupID, accountID)
+		if err != nil {
+			return fmt.Errorf("atlassian.group.add.addusertogroup(%s, %s) -> %w", g.groupID, email, err)
+		}
+
+		if g.cache != nil {
+			g.cache[email] = accountID
+		}
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
+	}
+
+	g.logger.Println("Finished adding accounts successfully")
+
+	return nil
+}
+
+// Remove emails from an Atlassian group.
+func (g *Group) Remove(ctx context.Context, emails []string) error {
+	g.logger.Printf("Removing %s from Atlassian group %s", emails, g.groupID)
+
+	// If the cache hasn't been generated, regenerate it.
+	if g.cache == nil {
+		return fmt.Errorf("atlassian.group.remove -> %w", gosync.ErrCacheEmpty)
+	}
+
+	for index, email := range emails {
+		accountID, err := g.getAccountID(ctx, email)
+		if err != nil {
+			return fmt.Errorf("atlassian.group.remove -> %w", err)
+		}
+
+		err = g.client.RemoveUserFromGroup(ctx, g.groupID, accountID)
+		if err != nil {
+			return fmt.Errorf("atlassian.group.remove.removeuserfromgroup(%s, %s) -> %w", g.groupID, email, err)
+		}
+
+		delete(g.cache, email)
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
+	}
+
+	g.logger.Println("Finished removing accounts successfully")
+
+	return nil
+}
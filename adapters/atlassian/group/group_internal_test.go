@@ -0,0 +1,183 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	gosync "github.com/ovotech/go-sync"
+	"github.com/stretchr/testify/assert"
+)
+
+var errAtlassian = errors.New("an example error")
+
+func createMockedAdapter(t *testing.T, optsFn ...func(*Group)) (*Group, *mockIAtlassianClient) {
+	t.Helper()
+
+	client := newMockIAtlassianClient(t)
+	adapter := New(NewClient("https://example.atlassian.net", "admin@email", "token"), "group-id", optsFn...)
+	adapter.client = client
+
+	return adapter, client
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	adapter := New(NewClient("https://example.atlassian.net/", "admin@email", "secret-token"), "group-id")
+
+	assert.Equal(t, "group-id", adapter.groupID)
+	assert.Equal(t, map[string]string{
+		"group":  "group-id",
+		"server": "https://example.atlassian.net",
+		"email":  "admin@email",
+		"token":  gosync.Redacted,
+	}, adapter.Config())
+}
+
+//nolint:funlen
+func TestGroup_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := gosync.ContextWithWarnings(context.TODO())
+		adapter, client := createMockedAdapter(t)
+
+		firstPage := make([]user, perPage)
+		for i := range firstPage {
+			firstPage[i] = user{AccountID: fmt.Sprintf("user%d", i), EmailAddress: fmt.Sprintf("user%d@email", i)}
+		}
+
+		client.EXPECT().GetGroupMembers(ctx, "group-id", 0, perPage).Return(firstPage, false, nil)
+		client.EXPECT().GetGroupMembers(ctx, "group-id", perPage, perPage).Return([]user{
+			{AccountID: "foo", EmailAddress: "foo@email"},
+			{AccountID: "hidden"},
+		}, true, nil)
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Len(t, emails, perPage+1)
+		assert.Equal(t, "foo@email", emails[perPage])
+		assert.Equal(t, "foo", adapter.cache["foo@email"])
+		assert.NotContains(t, adapter.cache, "")
+
+		warnings := gosync.Warnings(ctx)
+		assert.Len(t, warnings, 1)
+		assert.ErrorIs(t, warnings[0], ErrMissingEmail)
+		assert.ErrorContains(t, warnings[0], "hidden")
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.TODO()
+		adapter, client := createMockedAdapter(t)
+
+		client.EXPECT().GetGroupMembers(ctx, "group-id", 0, perPage).Return(nil, false, errAtlassian)
+
+		_, err := adapter.Get(ctx)
+
+		assert.ErrorIs(t, err, errAtlassian)
+	})
+}
+
+//nolint:funlen
+func TestGroup_Add(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+		adapter.cache = map[string]string{}
+
+		client.EXPECT().FindUserByEmail(ctx, "foo@email").Return(&user{AccountID: "foo", EmailAddress: "foo@email"}, nil)
+		client.EXPECT().AddUserToGroup(ctx, "group-id", "foo").Return(nil)
+
+		assert.NoError(t, adapter.Add(ctx, []string{"foo@email"}))
+		assert.Equal(t, map[string]string{"foo@email": "foo"}, adapter.cache)
+	})
+
+	t.Run("User not found", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+
+		client.EXPECT().FindUserByEmail(ctx, "foo@email").Return(nil, nil)
+
+		assert.ErrorIs(t, adapter.Add(ctx, []string{"foo@email"}), ErrUserNotFound)
+	})
+
+	t.Run("WithEmailToAccountID", func(t *testing.T) {
+		t.Parallel()
+
+		accountIDs := map[string]string{"hidden@email": "hidden"}
+
+		adapter, client := createMockedAdapter(t, WithEmailToAccountID(func(_ context.Context, email string) (string, error) {
+			if accountID, ok := accountIDs[email]; ok {
+				return accountID, nil
+			}
+
+			return "", ErrUserNotFound
+		}))
+
+		client.EXPECT().AddUserToGroup(ctx, "group-id", "hidden").Return(nil)
+
+		assert.NoError(t, adapter.Add(ctx, []string{"hidden@email"}))
+		assert.ErrorIs(t, adapter.Add(ctx, []string{"missing@email"}), ErrUserNotFound)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+
+		client.EXPECT().FindUserByEmail(ctx, "foo@email").Return(&user{AccountID: "foo", EmailAddress: "foo@email"}, nil)
+		client.EXPECT().AddUserToGroup(ctx, "group-id", "foo").Return(errAtlassian)
+
+		assert.ErrorIs(t, adapter.Add(ctx, []string{"foo@email"}), errAtlassian)
+	})
+}
+
+func TestGroup_Remove(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+		adapter.cache = map[string]string{"foo@email": "foo", "bar@email": "bar"}
+
+		client.EXPECT().RemoveUserFromGroup(ctx, "group-id", "foo").Return(nil)
+
+		assert.NoError(t, adapter.Remove(ctx, []string{"foo@email"}))
+		assert.Equal(t, map[string]string{"bar@email": "bar"}, adapter.cache)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+		adapter.cache = map[string]string{"foo@email": "foo"}
+
+		client.EXPECT().RemoveUserFromGroup(ctx, "group-id", "foo").Return(errAtlassian)
+
+		assert.ErrorIs(t, adapter.Remove(ctx, []string{"foo@email"}), errAtlassian)
+	})
+
+	t.Run("Cache not built", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, _ := createMockedAdapter(t)
+
+		assert.ErrorIs(t, adapter.Remove(ctx, []string{"foo@email"}), gosync.ErrCacheEmpty)
+	})
+}
@@ -0,0 +1,218 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package group
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockIAtlassianClient is an autogenerated mock type for the iAtlassianClient type
+type mockIAtlassianClient struct {
+	mock.Mock
+}
+
+type mockIAtlassianClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockIAtlassianClient) EXPECT() *mockIAtlassianClient_Expecter {
+	return &mockIAtlassianClient_Expecter{mock: &_m.Mock}
+}
+
+// AddUserToGroup provides a mock function with given fields: ctx, groupID, accountID
+func (_m *mockIAtlassianClient) AddUserToGroup(ctx context.Context, groupID string, accountID string) error {
+	ret := _m.Called(ctx, groupID, accountID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, groupID, accountID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockIAtlassianClient_AddUserToGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddUserToGroup'
+type mockIAtlassianClient_AddUserToGroup_Call struct {
+	*mock.Call
+}
+
+// AddUserToGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID string
+//   - accountID string
+func (_e *mockIAtlassianClient_Expecter) AddUserToGroup(ctx interface{}, groupID interface{}, accountID interface{}) *mockIAtlassianClient_AddUserToGroup_Call {
+	return &mockIAtlassianClient_AddUserToGroup_Call{Call: _e.mock.On("AddUserToGroup", ctx, groupID, accountID)}
+}
+
+func (_c *mockIAtlassianClient_AddUserToGroup_Call) Run(run func(ctx context.Context, groupID string, accountID string)) *mockIAtlassianClient_AddUserToGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockIAtlassianClient_AddUserToGroup_Call) Return(_a0 error) *mockIAtlassianClient_AddUserToGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// FindUserByEmail provides a mock function with given fields: ctx, email
+func (_m *mockIAtlassianClient) FindUserByEmail(ctx context.Context, email string) (*user, error) {
+	ret := _m.Called(ctx, email)
+
+	var r0 *user
+	if rf, ok := ret.Get(0).(func(context.Context, string) *user); ok {
+		r0 = rf(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*user)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockIAtlassianClient_FindUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserByEmail'
+type mockIAtlassianClient_FindUserByEmail_Call struct {
+	*mock.Call
+}
+
+// FindUserByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *mockIAtlassianClient_Expecter) FindUserByEmail(ctx interface{}, email interface{}) *mockIAtlassianClient_FindUserByEmail_Call {
+	return &mockIAtlassianClient_FindUserByEmail_Call{Call: _e.mock.On("FindUserByEmail", ctx, email)}
+}
+
+func (_c *mockIAtlassianClient_FindUserByEmail_Call) Run(run func(ctx context.Context, email string)) *mockIAtlassianClient_FindUserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockIAtlassianClient_FindUserByEmail_Call) Return(_a0 *user, _a1 error) *mockIAtlassianClient_FindUserByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetGroupMembers provides a mock function with given fields: ctx, groupID, startAt, maxResults
+func (_m *mockIAtlassianClient) GetGroupMembers(ctx context.Context, groupID string, startAt int, maxResults int) ([]user, bool, error) {
+	ret := _m.Called(ctx, groupID, startAt, maxResults)
+
+	var r0 []user
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []user); ok {
+		r0 = rf(ctx, groupID, startAt, maxResults)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) bool); ok {
+		r1 = rf(ctx, groupID, startAt, maxResults)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, int, int) error); ok {
+		r2 = rf(ctx, groupID, startAt, maxResults)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// mockIAtlassianClient_GetGroupMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupMembers'
+type mockIAtlassianClient_GetGroupMembers_Call struct {
+	*mock.Call
+}
+
+// GetGroupMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID string
+//   - startAt int
+//   - maxResults int
+func (_e *mockIAtlassianClient_Expecter) GetGroupMembers(ctx interface{}, groupID interface{}, startAt interface{}, maxResults interface{}) *mockIAtlassianClient_GetGroupMembers_Call {
+	return &mockIAtlassianClient_GetGroupMembers_Call{Call: _e.mock.On("GetGroupMembers", ctx, groupID, startAt, maxResults)}
+}
+
+func (_c *mockIAtlassianClient_GetGroupMembers_Call) Run(run func(ctx context.Context, groupID string, startAt int, maxResults int)) *mockIAtlassianClient_GetGroupMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *mockIAtlassianClient_GetGroupMembers_Call) Return(_a0 []user, _a1 bool, _a2 error) *mockIAtlassianClient_GetGroupMembers_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+// RemoveUserFromGroup provides a mock function with given fields: ctx, groupID, accountID
+func (_m *mockIAtlassianClient) RemoveUserFromGroup(ctx context.Context, groupID string, accountID string) error {
+	ret := _m.Called(ctx, groupID, accountID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, groupID, accountID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockIAtlassianClient_RemoveUserFromGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUserFromGroup'
+type mockIAtlassianClient_RemoveUserFromGroup_Call struct {
+	*mock.Call
+}
+
+// RemoveUserFromGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID string
+//   - accountID string
+func (_e *mockIAtlassianClient_Expecter) RemoveUserFromGroup(ctx interface{}, groupID interface{}, accountID interface{}) *mockIAtlassianClient_RemoveUserFromGroup_Call {
+	return &mockIAtlassianClient_RemoveUserFromGroup_Call{Call: _e.mock.On("RemoveUserFromGroup", ctx, groupID, accountID)}
+}
+
+func (_c *mockIAtlassianClient_RemoveUserFromGroup_Call) Run(run func(ctx context.Context, groupID string, accountID string)) *mockIAtlassianClient_RemoveUserFromGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockIAtlassianClient_RemoveUserFromGroup_Call) Return(_a0 error) *mockIAtlassianClient_RemoveUserFromGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+type mockConstructorTestingTnewMockIAtlassianClient interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// newMockIAtlassianClient creates a new instance of mockIAtlassianClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func newMockIAtlassianClient(t mockConstructorTestingTnewMockIAtlassianClient) *mockIAtlassianClient {
+	mock := &mockIAtlassianClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
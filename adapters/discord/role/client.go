@@ -0,0 +1,119 @@
+package role
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ErrUnexpectedResponse is returned when the Discord API responds with an unexpected status code.
+var ErrUnexpectedResponse = errors.New("unexpected response from discord")
+
+// apiURL is the Discord API v10.
+const apiURL = "https://discord.com/api/v10"
+
+// member is a member of a Discord guild (server), with only the properties used by the adapter.
+type member struct {
+	User struct {
+		ID  string `json:"id"`
+		Bot bool   `json:"bot"`
+	} `json:"user"`
+	Roles []string `json:"roles"`
+}
+
+// hasRole returns true if the member holds the role.
+func (m member) hasRole(roleID string) bool {
+	for _, role := range m.Roles {
+		if role == roleID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Client is a minimal Discord API client, authenticated with a bot token.
+//
+// The adapter was meant to use github.com/bwmarrin/discordgo, but it couldn't be fetched when the adapter was
+// written, so Client stands in for it. Role only uses iDiscordClient, which discordgo's session can be wrapped to
+// satisfy.
+type Client struct {
+	httpClient *http.Client
+	server     string
+	token      string
+}
+
+// NewClient creates a new Discord client, authenticated with a bot token.
+func NewClient(token string) *Client {
+	return &Client{
+		httpClient: http.DefaultClient,
+		server:     apiURL,
+		token:      token,
+	}
+}
+
+// WithHTTPClient sets a custom HTTP client, e.g. to configure timeouts or proxies.
+func (c *Client) WithHTTPClient(httpClient *http.Client) *Client {
+	c.httpClient = httpClient
+
+	return c
+}
+
+// do makes a request to the Discord API, and decodes the response into out if it isn't nil.
+func (c *Client) do(ctx context.Context, method string, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, method, c.server+path, nil)
+	if err != nil {
+		return fmt.Errorf("newrequest(%s, %s) -> %w", method, path, err)
+	}
+
+	req.Header.Set("Authorization", "Bot "+c.token)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("do(%s, %s) -> %w", method, path, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("do(%s, %s) -> %w: %s", method, path, ErrUnexpectedResponse, res.Status)
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+			return fmt.Errorf("decode(%s, %s) -> %w", method, path, err)
+		}
+	}
+
+	return nil
+}
+
+// GetGuildMembers gets a page of members of a guild, ordered by user ID, starting after the user ID in after. Pass an
+// empty after to get the first page.
+func (c *Client) GetGuildMembers(ctx context.Context, guildID string, after string, limit int) ([]member, error) {
+	query := url.Values{"limit": {strconv.Itoa(limit)}}
+	if after != "" {
+		query.Set("after", after)
+	}
+
+	var members []member
+
+	if err := c.do(ctx, http.MethodGet, "/guilds/"+guildID+"/members?"+query.Encode(), &members); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// GuildMemberRoleAdd gives a role to a member of a guild.
+func (c *Client) GuildMemberRoleAdd(ctx context.Context, guildID string, userID string, roleID string) error {
+	return c.do(ctx, http.MethodPut, "/guilds/"+guildID+"/members/"+userID+"/roles/"+roleID, nil)
+}
+
+// GuildMemberRoleRemove takes a role away from a member of a guild.
+func (c *Client) GuildMemberRoleRemove(ctx context.Context, guildID string, userID string, roleID string) error {
+	return c.do(ctx, http.MethodDelete, "/guilds/"+guildID+"/members/"+userID+"/roles/"+roleID, nil)
+}
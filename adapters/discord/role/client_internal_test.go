@@ -0,0 +1,82 @@
+package role
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestClient creates a client that sends requests to a test server.
+func newTestClient(server *httptest.Server) *Client {
+	client := NewClient("token")
+	client.server = server.URL
+
+	return client
+}
+
+//nolint:funlen
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("GetGuildMembers", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bot token", r.Header.Get("Authorization"))
+			assert.Equal(t, "/guilds/guild-id/members", r.URL.Path)
+			assert.Equal(t, "10", r.URL.Query().Get("limit"))
+			assert.Equal(t, "last", r.URL.Query().Get("after"))
+
+			_, _ = w.Write([]byte(`[{"user":{"id":"foo","bot":false},"roles":["role-id"]}]`))
+		}))
+		defer server.Close()
+
+		members, err := newTestClient(server).GetGuildMembers(ctx, "guild-id", "last", 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []member{guildMember("foo", false, "role-id")}, members)
+	})
+
+	t.Run("GuildMemberRoleAdd/GuildMemberRoleRemove", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/guilds/guild-id/members/foo/roles/role-id", r.URL.Path)
+
+			switch r.Method {
+			case http.MethodPut, http.MethodDelete:
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				t.Errorf("unexpected method %s", r.Method)
+			}
+		}))
+		defer server.Close()
+
+		client := newTestClient(server)
+
+		assert.NoError(t, client.GuildMemberRoleAdd(ctx, "guild-id", "foo", "role-id"))
+		assert.NoError(t, client.GuildMemberRoleRemove(ctx, "guild-id", "foo", "role-id"))
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		client := newTestClient(server)
+
+		err := client.GuildMemberRoleRemove(ctx, "guild-id", "foo", "role-id")
+		assert.ErrorIs(t, err, ErrUnexpectedResponse)
+
+		_, err = client.GetGuildMembers(ctx, "guild-id", "", 1)
+		assert.ErrorIs(t, err, ErrUnexpectedResponse)
+	})
+}
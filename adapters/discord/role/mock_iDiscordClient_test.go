@@ -0,0 +1,166 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package role
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockIDiscordClient is an autogenerated mock type for the iDiscordClient type
+type mockIDiscordClient struct {
+	mock.Mock
+}
+
+type mockIDiscordClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockIDiscordClient) EXPECT() *mockIDiscordClient_Expecter {
+	return &mockIDiscordClient_Expecter{mock: &_m.Mock}
+}
+
+// GetGuildMembers provides a mock function with given fields: ctx, guildID, after, limit
+func (_m *mockIDiscordClient) GetGuildMembers(ctx context.Context, guildID string, after string, limit int) ([]member, error) {
+	ret := _m.Called(ctx, guildID, after, limit)
+
+	var r0 []member
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) []member); ok {
+		r0 = rf(ctx, guildID, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]member)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = rf(ctx, guildID, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockIDiscordClient_GetGuildMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGuildMembers'
+type mockIDiscordClient_GetGuildMembers_Call struct {
+	*mock.Call
+}
+
+// GetGuildMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - after string
+//   - limit int
+func (_e *mockIDiscordClient_Expecter) GetGuildMembers(ctx interface{}, guildID interface{}, after interface{}, limit interface{}) *mockIDiscordClient_GetGuildMembers_Call {
+	return &mockIDiscordClient_GetGuildMembers_Call{Call: _e.mock.On("GetGuildMembers", ctx, guildID, after, limit)}
+}
+
+func (_c *mockIDiscordClient_GetGuildMembers_Call) Run(run func(ctx context.Context, guildID string, after string, limit int)) *mockIDiscordClient_GetGuildMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *mockIDiscordClient_GetGuildMembers_Call) Return(_a0 []member, _a1 error) *mockIDiscordClient_GetGuildMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GuildMemberRoleAdd provides a mock function with given fields: ctx, guildID, userID, roleID
+func (_m *mockIDiscordClient) GuildMemberRoleAdd(ctx context.Context, guildID string, userID string, roleID string) error {
+	ret := _m.Called(ctx, guildID, userID, roleID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, guildID, userID, roleID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockIDiscordClient_GuildMemberRoleAdd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GuildMemberRoleAdd'
+type mockIDiscordClient_GuildMemberRoleAdd_Call struct {
+	*mock.Call
+}
+
+// GuildMemberRoleAdd is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - userID string
+//   - roleID string
+func (_e *mockIDiscordClient_Expecter) GuildMemberRoleAdd(ctx interface{}, guildID interface{}, userID interface{}, roleID interface{}) *mockIDiscordClient_GuildMemberRoleAdd_Call {
+	return &mockIDiscordClient_GuildMemberRoleAdd_Call{Call: _e.mock.On("GuildMemberRoleAdd", ctx, guildID, userID, roleID)}
+}
+
+func (_c *mockIDiscordClient_GuildMemberRoleAdd_Call) Run(run func(ctx context.Context, guildID string, userID string, roleID string)) *mockIDiscordClient_GuildMemberRoleAdd_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *mockIDiscordClient_GuildMemberRoleAdd_Call) Return(_a0 error) *mockIDiscordClient_GuildMemberRoleAdd_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// GuildMemberRoleRemove provides a mock function with given fields: ctx, guildID, userID, roleID
+func (_m *mockIDiscordClient) GuildMemberRoleRemove(ctx context.Context, guildID string, userID string, roleID string) error {
+	ret := _m.Called(ctx, guildID, userID, roleID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, guildID, userID, roleID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockIDiscordClient_GuildMemberRoleRemove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GuildMemberRoleRemove'
+type mockIDiscordClient_GuildMemberRoleRemove_Call struct {
+	*mock.Call
+}
+
+// GuildMemberRoleRemove is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - userID string
+//   - roleID string
+func (_e *mockIDiscordClient_Expecter) GuildMemberRoleRemove(ctx interface{}, guildID interface{}, userID interface{}, roleID interface{}) *mockIDiscordClient_GuildMemberRoleRemove_Call {
+	return &mockIDiscordClient_GuildMemberRoleRemove_Call{Call: _e.mock.On("GuildMemberRoleRemove", ctx, guildID, userID, roleID)}
+}
+
+func (_c *mockIDiscordClient_GuildMemberRoleRemove_Call) Run(run func(ctx context.Context, guildID string, userID string, roleID string)) *mockIDiscordClient_GuildMemberRoleRemove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *mockIDiscordClient_GuildMemberRoleRemove_Call) Return(_a0 error) *mockIDiscordClient_GuildMemberRoleRemove_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+type mockConstructorTestingTnewMockIDiscordClient interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// newMockIDiscordClient creates a new instance of mockIDiscordClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func newMockIDiscordClient(t mockConstructorTestingTnewMockIDiscordClient) *mockIDiscordClient {
+	mock := &mockIDiscordClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,219 @@
+/*
+Package role synchronises Discord users with a role in a Discord guild (server).
+
+Discord doesn't expose users' emails, so by default the adapter synchronises Discord user IDs. Use WithIdentityMap to
+translate between Discord user IDs and the emails (or other identifiers) used by other adapters.
+
+In order to use this adapter, you'll need a Discord bot with the Server Members privileged intent and the Manage Roles
+permission, whose highest role is above the role being synchronised.
+*/
+package role
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	gosync "github.com/ovotech/go-sync"
+)
+
+// Ensure the adapter type fully satisfies the ports.Adapter and ports.ConfiguredAdapter interfaces.
+var (
+	_ gosync.Adapter           = &Role{}
+	_ gosync.ConfiguredAdapter = &Role{}
+)
+
+// ErrUserNotFound is returned when an identifier can't be translated to a Discord user ID.
+var ErrUserNotFound = errors.New("user not found")
+
+const perPage = 1000
+
+// iDiscordClient is a subset of the Discord Client, and used to build mocks for easy testing.
+type iDiscordClient interface {
+	GetGuildMembers(ctx context.Context, guildID string, after string, limit int) ([]member, error)
+	GuildMemberRoleAdd(ctx context.Context, guildID string, userID string, roleID string) error
+	GuildMemberRoleRemove(ctx context.Context, guildID string, userID string, roleID string) error
+}
+
+type Role struct {
+	client  iDiscordClient
+	guildID string
+	roleID  string
+	// toUserID and toIdentity translate between the adapter's identifiers and Discord user IDs.
+	toUserID   func(identity string) (string, bool)
+	toIdentity func(userID string) (string, bool)
+	// cache stores the identifier -> user ID mapping for use with the Add/Remove methods.
+	cache  map[string]string
+	logger *log.Logger
+}
+
+// WithIdentityMap translates between Discord user IDs and the identifiers used by other adapters, e.g. emails.
+// toUserID looks up the Discord user ID for an identifier passed to Add, and toIdentity looks up the identifier for a
+// member returned by Get; both return false if there isn't one. Members without an identifier are returned by their
+// Discord user ID, so they are removed from the role unless the other adapter also has their ID.
+func WithIdentityMap(
+	toUserID func(identity string) (string, bool),
+	toIdentity func(userID string) (string, bool),
+) func(*Role) {
+	return func(role *Role) {
+		role.toUserID = toUserID
+		role.toIdentity = toIdentity
+	}
+}
+
+// WithLogger sets a custom logger.
+func WithLogger(logger *log.Logger) func(*Role) {
+	return func(role *Role) {
+		role.logger = logger
+	}
+}
+
+// identity is the default identity map, which uses the Discord user ID as the identifier.
+func identity(id string) (string, bool) {
+	return id, true
+}
+
+// New instantiates a new Discord role adapter, for the role with the given ID in the guild with the given ID.
+func New(client *Client, guildID string, roleID string, optsFn ...func(*Role)) *Role {
+	role := &Role{
+		client:     client,
+		guildID:    guildID,
+		roleID:     roleID,
+		toUserID:   identity,
+		toIdentity: identity,
+		cache:      nil,
+		logger:     log.New(os.Stderr, "[go-sync/discord/role] ", log.LstdFlags|log.Lshortfile|log.Lmsgprefix),
+	}
+
+	for _, fn := range optsFn {
+		fn(role)
+	}
+
+	return role
+}
+
+// Config returns the adapter's configuration.
+func (r *Role) Config() map[string]string {
+	return map[string]string{
+		"guild": r.guildID,
+		"role":  r.roleID,
+	}
+}
+
+// Get identifiers of members of a Discord guild holding the role.
+func (r *Role) Get(ctx context.Context) ([]string, error) {
+	r.logger.Printf("Fetching members of Discord guild %s with role %s", r.guildID, r.roleID)
+
+	// Initialise the cache.
+	r.cache = make(map[string]string)
+
+	identities := make([]string, 0)
+	after := ""
+
+	for {
+		members, err := r.client.GetGuildMembers(ctx, r.guildID, after, perPage)
+		if err != nil {
+			return nil, fmt.Errorf("discord.role.get.getguildmembers(%s, %s) -> %w", r.guildID, after, err)
+		}
+
+		for _, member := range members {
+			// Bots are given roles by integrations rather than people, so aren't managed by Go Sync.
+			if member.User.Bot || !member.hasRole(r.roleID) {
+				continue
+			}
+
+			id, ok := r.toIdentity(member.User.ID)
+			if !ok {
+				id = member.User.ID
+			}
+
+			identities = append(identities, id)
+
+			// Add the identifier -> ID map for use with the Add/Remove methods.
+			r.cache[id] = member.User.ID
+		}
+
+		if len(members) < perPage {
+			break
+		}
+
+		after = members[len(members)-1].User.ID
+	}
+
+	r.logger.Println("Fetched members successfully")
+
+	return identities, nil
+}
+
+// getUserID translates an identifier to a Discord user ID.
+func (r *Role) getUserID(id string) (string, error) {
+	if userID, ok := r.cache[id]; ok {
+		return userID, nil
+	}
+
+	userID, ok := r.toUserID(id)
+	if !ok || userID == "" {
+		return "", fmt.Errorf("touserid(%s) -> %w", id, ErrUserNotFound)
+	}
+
+	return userID, nil
+}
+
+// Add gives the role to Discord users.
+func (r *Role) Add(ctx context.Context, identities []string) error {
+	r.logger.Printf("Adding %s to Discord role %s", identities, r.roleID)
+
+	for index, id := range identities {
+		userID, err := r.getUserID(id)
+		if err != nil {
+			return fmt.Errorf("discord.role.add -> %w", err)
+		}
+
+		err = r.client.GuildMemberRoleAdd(ctx, r.guildID, userID, r.roleID)
+		if err != nil {
+			return fmt.Errorf("discord.role.add.guildmemberroleadd(%s, %s) -> %w", r.roleID, id, err)
+		}
+
+		if r.cache != nil {
+			r.cache[id] = userID
+		}
+
+		gosync.ReportProgress(ctx, index+1, len(identities))
+	}
+
+	r.logger.Println("Finished adding members successfully")
+
+	return nil
+}
+
+// Remove takes the role away from Discord users.
+func (r *Role) Remove(ctx context.Context, identities []string) error {
+	r.logger.Printf("Removing %s from Discord role %s", identities, r.roleID)
+
+	// If the cache hasn't been generated, regenerate it.
+	if r.cache == nil {
+		return fmt.Errorf("discord.role.remove -> %w", gosync.ErrCacheEmpty)
+	}
+
+	for index, id := range identities {
+		userID, err := r.getUserID(id)
+		if err != nil {
+			return fmt.Errorf("discord.role.remove -> %w", err)
+		}
+
+		err = r.client.GuildMemberRoleRemove(ctx, r.guildID, userID, r.roleID)
+		if err != nil {
+			return fmt.Errorf("discord.role.remove.guildmemberroleremove(%s, %s) -> %w", r.roleID, id, err)
+		}
+
+		delete(r.cache, id)
+
+		gosync.ReportProgress(ctx, index+1, len(identities))
+	}
+
+	r.logger.Println("Finished removing members successfully")
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package role
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gosync "github.com/ovotech/go-sync"
+	"github.com/stretchr/testify/assert"
+)
+
+func guildMember(id string, bot bool, roles ...string) member {
+	out := member{Roles: roles}
+	out.User.ID = id
+	out.User.Bot = bot
+
+	return out
+}
+
+// emailMap translates between emails and Discord user IDs using a static map, as in the README.
+func emailMap(emails map[string]string) func(*Role) {
+	ids := make(map[string]string, len(emails))
+	for email, id := range emails {
+		ids[id] = email
+	}
+
+	return WithIdentityMap(
+		func(email string) (string, bool) {
+			id, ok := emails[email]
+
+			return id, ok
+		},
+		func(id string) (string, bool) {
+			email, ok := ids[id]
+
+			return email, ok
+		},
+	)
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	role := New(NewClient("secret-token"), "guild-id", "role-id")
+
+	assert.Equal(t, "guild-id", role.guildID)
+	assert.Equal(t, "role-id", role.roleID)
+	assert.Nil(t, role.cache)
+	assert.Equal(t, map[string]string{"guild": "guild-id", "role": "role-id"}, role.Config())
+}
+
+func TestRole_Get(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("User IDs", func(t *testing.T) {
+		t.Parallel()
+
+		client := newMockIDiscordClient(t)
+		role := New(NewClient(""), "guild-id", "role-id")
+		role.client = client
+
+		// A full first page means there may be more members.
+		firstPage := make([]member, perPage)
+		for i := range firstPage {
+			firstPage[i] = guildMember("other", false, "other-role")
+		}
+
+		firstPage[0] = guildMember("foo", false, "role-id")
+		firstPage[perPage-1] = guildMember("last", false)
+
+		client.EXPECT().GetGuildMembers(ctx, "guild-id", "", perPage).Return(firstPage, nil)
+		client.EXPECT().GetGuildMembers(ctx, "guild-id", "last", perPage).Return([]member{
+			guildMember("bar", false, "other-role", "role-id"),
+			guildMember("bot", true, "role-id"),
+		}, nil)
+
+		ids, err := role.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo", "bar"}, ids)
+		assert.Equal(t, map[string]string{"foo": "foo", "bar": "bar"}, role.cache)
+	})
+
+	t.Run("Identity map", func(t *testing.T) {
+		t.Parallel()
+
+		client := newMockIDiscordClient(t)
+		role := New(NewClient(""), "guild-id", "role-id", emailMap(map[string]string{"foo@email": "foo"}))
+		role.client = client
+
+		client.EXPECT().GetGuildMembers(ctx, "guild-id", "", perPage).Return([]member{
+			guildMember("foo", false, "role-id"),
+			guildMember("unmapped", false, "role-id"),
+		}, nil)
+
+		ids, err := role.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email", "unmapped"}, ids)
+		assert.Equal(t, map[string]string{"foo@email": "foo", "unmapped": "unmapped"}, role.cache)
+	})
+}
+
+func TestRole_Add(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		client := newMockIDiscordClient(t)
+		role := New(NewClient(""), "guild-id", "role-id", emailMap(map[string]string{"foo@email": "foo"}))
+		role.client = client
+
+		client.EXPECT().GuildMemberRoleAdd(ctx, "guild-id", "foo", "role-id").Return(nil)
+
+		err := role.Add(ctx, []string{"foo@email"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("User not found", func(t *testing.T) {
+		t.Parallel()
+
+		client := newMockIDiscordClient(t)
+		role := New(NewClient(""), "guild-id", "role-id", emailMap(map[string]string{}))
+		role.client = client
+
+		err := role.Add(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, ErrUserNotFound)
+		assert.Zero(t, client.Calls)
+	})
+}
+
+func TestRole_Remove(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		client := newMockIDiscordClient(t)
+		role := New(NewClient(""), "guild-id", "role-id", emailMap(map[string]string{"foo@email": "foo"}))
+		role.client = client
+		role.cache = map[string]string{"foo@email": "foo", "unmapped": "unmapped"}
+
+		client.EXPECT().GuildMemberRoleRemove(ctx, "guild-id", "foo", "role-id").Return(nil)
+		client.EXPECT().GuildMemberRoleRemove(ctx, "guild-id", "unmapped", "role-id").Return(nil)
+
+		err := role.Remove(ctx, []string{"foo@email", "unmapped"})
+
+		assert.NoError(t, err)
+		assert.Empty(t, role.cache)
+	})
+
+	t.Run("Cache empty", func(t *testing.T) {
+		t.Parallel()
+
+		role := New(NewClient(""), "guild-id", "role-id")
+
+		err := role.Remove(ctx, []string{"foo"})
+
+		assert.ErrorIs(t, err, gosync.ErrCacheEmpty)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		testErr := errors.New("foo") //nolint:goerr113
+
+		client := newMockIDiscordClient(t)
+		role := New(NewClient(""), "guild-id", "role-id")
+		role.client = client
+		role.cache = map[string]string{"foo": "foo"}
+
+		client.EXPECT().GuildMemberRoleRemove(ctx, "guild-id", "foo", "role-id").Return(testErr)
+
+		err := role.Remove(ctx, []string{"foo"})
+
+		assert.ErrorIs(t, err, testErr)
+	})
+}
@@ -0,0 +1,276 @@
+/*
+Package csv synchronises email addresses with a column of a CSV file.
+
+This is useful for systems that can only export spreadsheets, and for end-to-end tests of a sync without depending on
+a third party service.
+*/
+package csv
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	gosync "github.com/ovotech/go-sync"
+)
+
+// Ensure the adapter type fully satisfies the ports.Adapter and ports.ConfiguredAdapter interfaces.
+var (
+	_ gosync.Adapter           = &CSV{}
+	_ gosync.ConfiguredAdapter = &CSV{}
+)
+
+// ErrColumnNotFound is returned when the file's header doesn't contain the column set with WithHeader.
+var ErrColumnNotFound = errors.New("column not found")
+
+type CSV struct {
+	path   string
+	column int    // column is the index of the column containing emails.
+	header string // header is the name of the column containing emails, if the file has a header row.
+	logger *log.Logger
+}
+
+// WithColumn sets the index of the column containing emails. Default is 0, the first column.
+func WithColumn(index int) func(*CSV) {
+	return func(adapter *CSV) {
+		adapter.column = index
+	}
+}
+
+// WithHeader treats the first row of the file as a header, and finds the column containing emails by its name.
+// If the file doesn't exist yet, Add creates it with a header row.
+func WithHeader(name string) func(*CSV) {
+	return func(adapter *CSV) {
+		adapter.header = name
+	}
+}
+
+// WithLogger sets a custom logger.
+func WithLogger(logger *log.Logger) func(*CSV) {
+	return func(adapter *CSV) {
+		adapter.logger = logger
+	}
+}
+
+// New instantiates a new CSV adapter, for the file at path.
+func New(path string, optsFn ...func(*CSV)) *CSV {
+	adapter := &CSV{
+		path:   path,
+		column: 0,
+		header: "",
+		logger: log.New(os.Stderr, "[go-sync/file/csv] ", log.LstdFlags|log.Lshortfile|log.Lmsgprefix),
+	}
+
+	for _, fn := range optsFn {
+		fn(adapter)
+	}
+
+	return adapter
+}
+
+// Config returns the adapter's configuration.
+func (c *CSV) Config() map[string]string {
+	return map[string]string{
+		"path":   c.path,
+		"column": strconv.Itoa(c.column),
+		"header": c.header,
+	}
+}
+
+// read reads every row of the file, and returns the index of the column containing emails. A missing file is treated
+// as empty.
+func (c *CSV) read() ([][]string, int, error) {
+	file, err := os.Open(c.path)
+	if errors.Is(err, os.ErrNotExist) {
+		if c.header != "" {
+			return [][]string{{c.header}}, 0, nil
+		}
+
+		return nil, c.column, nil
+	}
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("open(%s) -> %w", c.path, err)
+	}
+
+	defer file.Close() //nolint:errcheck
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+
+	rows, err := reader.ReadAll()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, 0, fmt.Errorf("read(%s) -> %w", c.path, err)
+	}
+
+	if c.header == "" {
+		return rows, c.column, nil
+	}
+
+	if len(rows) == 0 {
+		return [][]string{{c.header}}, 0, nil
+	}
+
+	for index, name := range rows[0] {
+		if strings.TrimSpace(name) == c.header {
+			return rows, index, nil
+		}
+	}
+
+	return nil, 0, fmt.Errorf("read(%s, %s) -> %w", c.path, c.header, ErrColumnNotFound)
+}
+
+// records returns the rows of the file after the header row, if there is one.
+func (c *CSV) records(rows [][]string) [][]string {
+	if c.header != "" && len(rows) > 0 {
+		return rows[1:]
+	}
+
+	return rows
+}
+
+// write atomically replaces the file with rows, by writing to a temporary file alongside it and renaming it over the
+// original, so a crash part way through never leaves a partially written file.
+func (c *CSV) write(rows [][]string) error {
+	file, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("createtemp(%s) -> %w", c.path, err)
+	}
+
+	// Clean up the temporary file if anything fails before it's renamed.
+	defer os.Remove(file.Name()) //nolint:errcheck
+
+	writer := csv.NewWriter(file)
+
+	if err = writer.WriteAll(rows); err != nil {
+		_ = file.Close()
+
+		return fmt.Errorf("write(%s) -> %w", c.path, err)
+	}
+
+	if err = file.Close(); err != nil {
+		return fmt.Errorf("close(%s) -> %w", c.path, err)
+	}
+
+	if err = os.Rename(file.Name(), c.path); err != nil {
+		return fmt.Errorf("rename(%s) -> %w", c.path, err)
+	}
+
+	return nil
+}
+
+// Get emails from the CSV file.
+func (c *CSV) Get(_ context.Context) ([]string, error) {
+	c.logger.Printf("Fetching accounts from CSV file %s", c.path)
+
+	rows, column, err := c.read()
+	if err != nil {
+		return nil, fmt.Errorf("file.csv.get -> %w", err)
+	}
+
+	emails := make([]string, 0, len(rows))
+
+	for _, row := range c.records(rows) {
+		if column >= len(row) {
+			continue
+		}
+
+		if email := strings.TrimSpace(row[column]); email != "" {
+			emails = append(emails, email)
+		}
+	}
+
+	c.logger.Println("Fetched accounts successfully")
+
+	return emails, nil
+}
+
+// Add emails to the CSV file, as new rows with only the email column set. Emails already in the file are skipped.
+func (c *CSV) Add(ctx context.Context, emails []string) error {
+	c.logger.Printf("Adding %s to CSV file %s", emails, c.path)
+
+	rows, column, err := c.read()
+	if err != nil {
+		return fmt.Errorf("file.csv.add -> %w", err)
+	}
+
+	// Keep the rows the same width as the header, if there is one.
+	width := column + 1
+	if len(rows) > 0 && len(rows[0]) > width {
+		width = len(rows[0])
+	}
+
+	existing := make(map[string]bool, len(rows))
+
+	for _, row := range c.records(rows) {
+		if column < len(row) {
+			existing[strings.TrimSpace(row[column])] = true
+		}
+	}
+
+	for _, email := range emails {
+		// Skip emails already in the file, so it stays a set of emails.
+		if existing[email] {
+			continue
+		}
+
+		existing[email] = true
+
+		row := make([]string, width)
+		row[column] = email
+		rows = append(rows, row)
+	}
+
+	if err = c.write(rows); err != nil {
+		return fmt.Errorf("file.csv.add -> %w", err)
+	}
+
+	gosync.ReportProgress(ctx, len(emails), len(emails))
+	c.logger.Println("Finished adding accounts successfully")
+
+	return nil
+}
+
+// Remove emails from the CSV file, by removing every row with a matching email column.
+func (c *CSV) Remove(ctx context.Context, emails []string) error {
+	c.logger.Printf("Removing %s from CSV file %s", emails, c.path)
+
+	rows, column, err := c.read()
+	if err != nil {
+		return fmt.Errorf("file.csv.remove -> %w", err)
+	}
+
+	remove := make(map[string]bool, len(emails))
+	for _, email := range emails {
+		remove[email] = true
+	}
+
+	kept := make([][]string, 0, len(rows))
+	if c.header != "" && len(rows) > 0 {
+		kept = append(kept, rows[0])
+	}
+
+	for _, row := range c.records(rows) {
+		if column < len(row) && remove[strings.TrimSpace(row[column])] {
+			continue
+		}
+
+		kept = append(kept, row)
+	}
+
+	if err = c.write(kept); err != nil {
+		return fmt.Errorf("file.csv.remove -> %w", err)
+	}
+
+	gosync.ReportProgress(ctx, len(emails), len(emails))
+	c.logger.Println("Finished removing accounts successfully")
+
+	return nil
+}
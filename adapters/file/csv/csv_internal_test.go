@@ -0,0 +1,145 @@
+package csv
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// writeFile writes a CSV file to a temporary directory, and returns its path.
+func writeFile(t *testing.T, contents string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "emails.csv")
+
+	assert.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+
+	return path
+}
+
+// readFile returns the contents of a file.
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+
+	contents, err := os.ReadFile(path)
+	assert.NoError(t, err)
+
+	return string(contents)
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	adapter := New("emails.csv", WithColumn(2))
+
+	assert.Equal(t, "emails.csv", adapter.path)
+	assert.Equal(t, 2, adapter.column)
+	assert.Equal(t, map[string]string{"path": "emails.csv", "column": "2", "header": ""}, adapter.Config())
+}
+
+func TestCSV_Get(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Column", func(t *testing.T) {
+		t.Parallel()
+
+		adapter := New(writeFile(t, "Foo,foo@email\nBar, bar@email \nBaz,\nShort\n"), WithColumn(1))
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email", "bar@email"}, emails)
+	})
+
+	t.Run("Header", func(t *testing.T) {
+		t.Parallel()
+
+		adapter := New(writeFile(t, "name,email\nFoo,foo@email\nBar,bar@email\n"), WithHeader("email"))
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email", "bar@email"}, emails)
+	})
+
+	t.Run("Header not found", func(t *testing.T) {
+		t.Parallel()
+
+		adapter := New(writeFile(t, "name,mail\nFoo,foo@email\n"), WithHeader("email"))
+
+		_, err := adapter.Get(ctx)
+
+		assert.ErrorIs(t, err, ErrColumnNotFound)
+	})
+
+	t.Run("Missing file", func(t *testing.T) {
+		t.Parallel()
+
+		adapter := New(filepath.Join(t.TempDir(), "missing.csv"))
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Empty(t, emails)
+	})
+}
+
+func TestCSV_Add(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Header", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeFile(t, "name,email,team\nFoo,foo@email,platform\n")
+		adapter := New(path, WithHeader("email"))
+
+		err := adapter.Add(ctx, []string{"bar@email", "foo@email"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "name,email,team\nFoo,foo@email,platform\n,bar@email,\n", readFile(t, path))
+
+		// No temporary files are left behind.
+		entries, err := os.ReadDir(filepath.Dir(path))
+
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+
+	t.Run("Missing file", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "emails.csv")
+		adapter := New(path, WithHeader("email"))
+
+		err := adapter.Add(ctx, []string{"foo@email"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "email\nfoo@email\n", readFile(t, path))
+	})
+}
+
+func TestCSV_Remove(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	path := writeFile(t, "Foo,foo@email\nBar,bar@email\nBaz,baz@email\n")
+	adapter := New(path, WithColumn(1))
+
+	err := adapter.Remove(ctx, []string{"foo@email", "baz@email"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Bar,bar@email\n", readFile(t, path))
+
+	emails, err := adapter.Get(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bar@email"}, emails)
+}
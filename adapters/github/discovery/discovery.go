@@ -0,0 +1,17 @@
+/*
+Package discovery converts between emails and GitHub usernames, for the GitHub adapters.
+
+Converting email addresses to GitHub usernames isn't straightforward, so each GitHub adapter must be given a discovery
+service. At OVO, we enforce SAML for our GitHub users, and have provided a SAML -> GitHub Username discovery service in
+the saml package, but you may need to write your own.
+*/
+package discovery
+
+import "context"
+
+// GitHubDiscovery is required because there are multiple ways to convert a GitHub email into a username.
+// At OVO we use SAML, but other organisations may use public emails or another mechanism.
+type GitHubDiscovery interface {
+	GetUsernameFromEmail(context.Context, []string) ([]string, error)
+	GetEmailFromUsername(context.Context, []string) ([]string, error)
+}
@@ -0,0 +1,258 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package org
+
+import (
+	context "context"
+
+	github "github.com/google/go-github/v47/github"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockIGitHubOrg is an autogenerated mock type for the iGitHubOrg type
+type mockIGitHubOrg struct {
+	mock.Mock
+}
+
+type mockIGitHubOrg_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockIGitHubOrg) EXPECT() *mockIGitHubOrg_Expecter {
+	return &mockIGitHubOrg_Expecter{mock: &_m.Mock}
+}
+
+// EditOrgMembership provides a mock function with given fields: ctx, user, org, membership
+func (_m *mockIGitHubOrg) EditOrgMembership(ctx context.Context, user string, org string, membership *github.Membership) (*github.Membership, *github.Response, error) {
+	ret := _m.Called(ctx, user, org, membership)
+
+	var r0 *github.Membership
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *github.Membership) *github.Membership); ok {
+		r0 = rf(ctx, user, org, membership)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*github.Membership)
+		}
+	}
+
+	var r1 *github.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *github.Membership) *github.Response); ok {
+		r1 = rf(ctx, user, org, membership)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*github.Response)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, *github.Membership) error); ok {
+		r2 = rf(ctx, user, org, membership)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// mockIGitHubOrg_EditOrgMembership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditOrgMembership'
+type mockIGitHubOrg_EditOrgMembership_Call struct {
+	*mock.Call
+}
+
+// EditOrgMembership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user string
+//   - org string
+//   - membership *github.Membership
+func (_e *mockIGitHubOrg_Expecter) EditOrgMembership(ctx interface{}, user interface{}, org interface{}, membership interface{}) *mockIGitHubOrg_EditOrgMembership_Call {
+	return &mockIGitHubOrg_EditOrgMembership_Call{Call: _e.mock.On("EditOrgMembership", ctx, user, org, membership)}
+}
+
+func (_c *mockIGitHubOrg_EditOrgMembership_Call) Run(run func(ctx context.Context, user string, org string, membership *github.Membership)) *mockIGitHubOrg_EditOrgMembership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*github.Membership))
+	})
+	return _c
+}
+
+func (_c *mockIGitHubOrg_EditOrgMembership_Call) Return(_a0 *github.Membership, _a1 *github.Response, _a2 error) *mockIGitHubOrg_EditOrgMembership_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+// ListMembers provides a mock function with given fields: ctx, org, opts
+func (_m *mockIGitHubOrg) ListMembers(ctx context.Context, org string, opts *github.ListMembersOptions) ([]*github.User, *github.Response, error) {
+	ret := _m.Called(ctx, org, opts)
+
+	var r0 []*github.User
+	if rf, ok := ret.Get(0).(func(context.Context, string, *github.ListMembersOptions) []*github.User); ok {
+		r0 = rf(ctx, org, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*github.User)
+		}
+	}
+
+	var r1 *github.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, *github.ListMembersOptions) *github.Response); ok {
+		r1 = rf(ctx, org, opts)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*github.Response)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, *github.ListMembersOptions) error); ok {
+		r2 = rf(ctx, org, opts)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// mockIGitHubOrg_ListMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMembers'
+type mockIGitHubOrg_ListMembers_Call struct {
+	*mock.Call
+}
+
+// ListMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - org string
+//   - opts *github.ListMembersOptions
+func (_e *mockIGitHubOrg_Expecter) ListMembers(ctx interface{}, org interface{}, opts interface{}) *mockIGitHubOrg_ListMembers_Call {
+	return &mockIGitHubOrg_ListMembers_Call{Call: _e.mock.On("ListMembers", ctx, org, opts)}
+}
+
+func (_c *mockIGitHubOrg_ListMembers_Call) Run(run func(ctx context.Context, org string, opts *github.ListMembersOptions)) *mockIGitHubOrg_ListMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*github.ListMembersOptions))
+	})
+	return _c
+}
+
+func (_c *mockIGitHubOrg_ListMembers_Call) Return(_a0 []*github.User, _a1 *github.Response, _a2 error) *mockIGitHubOrg_ListMembers_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+// ListPendingOrgInvitations provides a mock function with given fields: ctx, org, opts
+func (_m *mockIGitHubOrg) ListPendingOrgInvitations(ctx context.Context, org string, opts *github.ListOptions) ([]*github.Invitation, *github.Response, error) {
+	ret := _m.Called(ctx, org, opts)
+
+	var r0 []*github.Invitation
+	if rf, ok := ret.Get(0).(func(context.Context, string, *github.ListOptions) []*github.Invitation); ok {
+		r0 = rf(ctx, org, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*github.Invitation)
+		}
+	}
+
+	var r1 *github.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, *github.ListOptions) *github.Response); ok {
+		r1 = rf(ctx, org, opts)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*github.Response)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, *github.ListOptions) error); ok {
+		r2 = rf(ctx, org, opts)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// mockIGitHubOrg_ListPendingOrgInvitations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPendingOrgInvitations'
+type mockIGitHubOrg_ListPendingOrgInvitations_Call struct {
+	*mock.Call
+}
+
+// ListPendingOrgInvitations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - org string
+//   - opts *github.ListOptions
+func (_e *mockIGitHubOrg_Expecter) ListPendingOrgInvitations(ctx interface{}, org interface{}, opts interface{}) *mockIGitHubOrg_ListPendingOrgInvitations_Call {
+	return &mockIGitHubOrg_ListPendingOrgInvitations_Call{Call: _e.mock.On("ListPendingOrgInvitations", ctx, org, opts)}
+}
+
+func (_c *mockIGitHubOrg_ListPendingOrgInvitations_Call) Run(run func(ctx context.Context, org string, opts *github.ListOptions)) *mockIGitHubOrg_ListPendingOrgInvitations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*github.ListOptions))
+	})
+	return _c
+}
+
+func (_c *mockIGitHubOrg_ListPendingOrgInvitations_Call) Return(_a0 []*github.Invitation, _a1 *github.Response, _a2 error) *mockIGitHubOrg_ListPendingOrgInvitations_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+// RemoveOrgMembership provides a mock function with given fields: ctx, user, org
+func (_m *mockIGitHubOrg) RemoveOrgMembership(ctx context.Context, user string, org string) (*github.Response, error) {
+	ret := _m.Called(ctx, user, org)
+
+	var r0 *github.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *github.Response); ok {
+		r0 = rf(ctx, user, org)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*github.Response)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, user, org)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockIGitHubOrg_RemoveOrgMembership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveOrgMembership'
+type mockIGitHubOrg_RemoveOrgMembership_Call struct {
+	*mock.Call
+}
+
+// RemoveOrgMembership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user string
+//   - org string
+func (_e *mockIGitHubOrg_Expecter) RemoveOrgMembership(ctx interface{}, user interface{}, org interface{}) *mockIGitHubOrg_RemoveOrgMembership_Call {
+	return &mockIGitHubOrg_RemoveOrgMembership_Call{Call: _e.mock.On("RemoveOrgMembership", ctx, user, org)}
+}
+
+func (_c *mockIGitHubOrg_RemoveOrgMembership_Call) Run(run func(ctx context.Context, user string, org string)) *mockIGitHubOrg_RemoveOrgMembership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockIGitHubOrg_RemoveOrgMembership_Call) Return(_a0 *github.Response, _a1 error) *mockIGitHubOrg_RemoveOrgMembership_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+type mockConstructorTestingTnewMockIGitHubOrg interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// newMockIGitHubOrg creates a new instance of mockIGitHubOrg. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func newMockIGitHubOrg(t mockConstructorTestingTnewMockIGitHubOrg) *mockIGitHubOrg {
+	mock := &mockIGitHubOrg{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,234 @@
+/*
+Package org synchronises emails with the members of a GitHub organisation.
+
+You must provide a discovery service in order to use this adapter, in the same way as the team adapter. See the
+discovery package for more information.
+*/
+package org
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/google/go-github/v47/github"
+	gosync "github.com/ovotech/go-sync"
+	"github.com/ovotech/go-sync/adapters/github/discovery"
+)
+
+// Ensure the adapter type fully satisfies the ports.Adapter interface.
+var _ gosync.Adapter = &Org{}
+
+// iGitHubOrg is a subset of the GitHub Organizations service, and used to build mocks for easy testing.
+type iGitHubOrg interface {
+	ListMembers(ctx context.Context, org string, opts *github.ListMembersOptions) ([]*github.User, *github.Response, error)
+	ListPendingOrgInvitations(
+		ctx context.Context,
+		org string,
+		opts *github.ListOptions,
+	) ([]*github.Invitation, *github.Response, error)
+	EditOrgMembership(
+		ctx context.Context,
+		user,
+		org string,
+		membership *github.Membership,
+	) (*github.Membership, *github.Response, error)
+	RemoveOrgMembership(ctx context.Context, user, org string) (*github.Response, error)
+}
+
+type Org struct {
+	orgs      iGitHubOrg                // GitHub v3 REST API organisations.
+	discovery discovery.GitHubDiscovery // Discovery adapter to convert GH users -> emails (and vice versa).
+	org       string                    // GitHub organisation.
+	role      string                    // Role given to added members.
+	cache     map[string]string         // Cache of users.
+	logger    *log.Logger
+}
+
+// WithRole sets the role given to members added to the organisation, either "member" or "admin". Default is "member".
+// Existing members' roles aren't changed.
+func WithRole(role string) func(*Org) {
+	return func(org *Org) {
+		org.role = role
+	}
+}
+
+// WithLogger sets a custom logger.
+func WithLogger(logger *log.Logger) func(*Org) {
+	return func(org *Org) {
+		org.logger = logger
+	}
+}
+
+// New instantiates a new GitHub Organisation adapter.
+func New(client *github.Client, discovery discovery.GitHubDiscovery, org string, optsFn ...func(*Org)) *Org {
+	adapter := &Org{
+		orgs:      client.Organizations,
+		discovery: discovery,
+		org:       org,
+		role:      "member",
+		cache:     nil,
+		logger:    log.New(os.Stderr, "[go-sync/github/org] ", log.LstdFlags|log.Lshortfile|log.Lmsgprefix),
+	}
+
+	for _, fn := range optsFn {
+		fn(adapter)
+	}
+
+	return adapter
+}
+
+// addLogins converts logins to emails, adds them to the cache, and returns the emails.
+func (o *Org) addLogins(ctx context.Context, logins []string) ([]string, error) {
+	if len(logins) == 0 {
+		return nil, nil
+	}
+
+	emails, err := o.discovery.GetEmailFromUsername(ctx, logins)
+	if err != nil {
+		return nil, fmt.Errorf("discovery -> %w", err)
+	}
+
+	for index, login := range logins {
+		o.cache[emails[index]] = login
+	}
+
+	return emails, nil
+}
+
+// Get emails of members of a GitHub organisation, including those with a pending invitation.
+func (o *Org) Get(ctx context.Context) ([]string, error) {
+	o.logger.Printf("Fetching accounts from GitHub organisation %s", o.org)
+
+	// Initialise the cache.
+	o.cache = make(map[string]string)
+
+	out := make([]string, 0)
+
+	opts := &github.ListMembersOptions{}
+
+	for {
+		users, resp, err := o.orgs.ListMembers(ctx, o.org, opts)
+		if err != nil {
+			return nil, fmt.Errorf("github.org.get.listmembers(%s) -> %w", o.org, err)
+		}
+
+		logins := make([]string, 0, len(users))
+		for _, user := range users {
+			logins = append(logins, *user.Login)
+		}
+
+		emails, err := o.addLogins(ctx, logins)
+		if err != nil {
+			return nil, fmt.Errorf("github.org.get -> %w", err)
+		}
+
+		out = append(out, emails...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	pending, err := o.getPending(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("github.org.get -> %w", err)
+	}
+
+	out = append(out, pending...)
+
+	o.logger.Println("Fetched accounts successfully")
+
+	return out, nil
+}
+
+// getPending gets the emails of users with a pending invitation to the organisation, so they aren't invited again by
+// Add before they've accepted. Removing a user with a pending invitation cancels the invitation.
+func (o *Org) getPending(ctx context.Context) ([]string, error) {
+	out := make([]string, 0)
+
+	opts := &github.ListOptions{}
+
+	for {
+		invitations, resp, err := o.orgs.ListPendingOrgInvitations(ctx, o.org, opts)
+		if err != nil {
+			return nil, fmt.Errorf("listpendingorginvitations(%s) -> %w", o.org, err)
+		}
+
+		logins := make([]string, 0, len(invitations))
+
+		for _, invitation := range invitations {
+			// Invitations sent to an email rather than a GitHub user can't be managed by the membership API.
+			if invitation.Login == nil {
+				continue
+			}
+
+			logins = append(logins, *invitation.Login)
+		}
+
+		emails, err := o.addLogins(ctx, logins)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, emails...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return out, nil
+}
+
+// Add emails to a GitHub organisation. Users who aren't already members are sent an invitation.
+func (o *Org) Add(ctx context.Context, emails []string) error {
+	o.logger.Printf("Adding %s to GitHub organisation %s", emails, o.org)
+
+	names, err := o.discovery.GetUsernameFromEmail(ctx, emails)
+	if err != nil {
+		return fmt.Errorf("github.org.add.discovery -> %w", err)
+	}
+
+	for index, name := range names {
+		_, _, err = o.orgs.EditOrgMembership(ctx, name, o.org, &github.Membership{Role: github.String(o.role)})
+		if err != nil {
+			return fmt.Errorf("github.org.add.editorgmembership(%s, %s) -> %w", name, o.org, err)
+		}
+
+		gosync.ReportProgress(ctx, index+1, len(names))
+	}
+
+	o.logger.Println("Finished adding accounts successfully")
+
+	return nil
+}
+
+// Remove emails from a GitHub organisation. This also removes them from all the organisation's teams.
+func (o *Org) Remove(ctx context.Context, emails []string) error {
+	o.logger.Printf("Removing %s from GitHub organisation %s", emails, o.org)
+
+	if o.cache == nil {
+		return fmt.Errorf("github.org.remove -> %w", gosync.ErrCacheEmpty)
+	}
+
+	for index, email := range emails {
+		name := o.cache[email]
+
+		_, err := o.orgs.RemoveOrgMembership(ctx, name, o.org)
+		if err != nil {
+			return fmt.Errorf("github.org.remove.removeorgmembership(%s, %s) -> %w", name, o.org, err)
+		}
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
+	}
+
+	o.logger.Println("Finished removing accounts successfully")
+
+	return nil
+}
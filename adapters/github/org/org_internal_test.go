@@ -0,0 +1,108 @@
+package org
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-github/v47/github"
+	gosync "github.com/ovotech/go-sync"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	discovery := NewMockGitHubDiscovery(t)
+	adapter := New(&github.Client{}, discovery, "org")
+
+	assert.Equal(t, "org", adapter.org)
+	assert.Equal(t, "member", adapter.role)
+	assert.Equal(t, "admin", New(&github.Client{}, discovery, "org", WithRole("admin")).role)
+}
+
+func TestOrg_Get(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	gitHubClient := newMockIGitHubOrg(t)
+	discovery := NewMockGitHubDiscovery(t)
+	adapter := New(&github.Client{}, discovery, "org")
+	adapter.orgs = gitHubClient
+
+	gitHubClient.EXPECT().ListMembers(ctx, "org", &github.ListMembersOptions{}).
+		Return([]*github.User{{Login: github.String("foo")}}, &github.Response{NextPage: 1}, nil)
+	gitHubClient.EXPECT().ListMembers(ctx, "org", &github.ListMembersOptions{ListOptions: github.ListOptions{Page: 1}}).
+		Return([]*github.User{{Login: github.String("bar")}}, &github.Response{NextPage: 0}, nil)
+	discovery.EXPECT().GetEmailFromUsername(ctx, []string{"foo"}).Return([]string{"foo@email"}, nil)
+	discovery.EXPECT().GetEmailFromUsername(ctx, []string{"bar"}).Return([]string{"bar@email"}, nil)
+
+	// Pending invitations are treated as members, so they aren't invited again.
+	gitHubClient.EXPECT().ListPendingOrgInvitations(ctx, "org", &github.ListOptions{}).
+		Return([]*github.Invitation{
+			{Login: github.String("baz")},
+			{Email: github.String("invited@email")},
+		}, &github.Response{NextPage: 0}, nil)
+	discovery.EXPECT().GetEmailFromUsername(ctx, []string{"baz"}).Return([]string{"baz@email"}, nil)
+
+	users, err := adapter.Get(ctx)
+
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, users, []string{"foo@email", "bar@email", "baz@email"})
+	assert.Equal(t, map[string]string{"foo@email": "foo", "bar@email": "bar", "baz@email": "baz"}, adapter.cache)
+}
+
+func TestOrg_Add(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	gitHubClient := newMockIGitHubOrg(t)
+	discovery := NewMockGitHubDiscovery(t)
+	adapter := New(&github.Client{}, discovery, "org")
+	adapter.orgs = gitHubClient
+
+	membership := &github.Membership{Role: github.String("member")}
+
+	discovery.EXPECT().GetUsernameFromEmail(ctx, []string{"fizz@email", "buzz@email"}).
+		Return([]string{"fizz", "buzz"}, nil)
+	gitHubClient.EXPECT().EditOrgMembership(ctx, "fizz", "org", membership).Return(nil, nil, nil)
+	gitHubClient.EXPECT().EditOrgMembership(ctx, "buzz", "org", membership).Return(nil, nil, nil)
+
+	err := adapter.Add(ctx, []string{"fizz@email", "buzz@email"})
+
+	assert.NoError(t, err)
+}
+
+func TestOrg_Remove(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		gitHubClient := newMockIGitHubOrg(t)
+		discovery := NewMockGitHubDiscovery(t)
+		adapter := New(&github.Client{}, discovery, "org")
+		adapter.orgs = gitHubClient
+		adapter.cache = map[string]string{"foo@email": "foo", "bar@email": "bar"}
+
+		gitHubClient.EXPECT().RemoveOrgMembership(ctx, "foo", "org").Return(nil, nil)
+		gitHubClient.EXPECT().RemoveOrgMembership(ctx, "bar", "org").Return(nil, nil)
+
+		err := adapter.Remove(ctx, []string{"foo@email", "bar@email"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Empty cache", func(t *testing.T) {
+		t.Parallel()
+
+		adapter := New(&github.Client{}, NewMockGitHubDiscovery(t), "org")
+
+		err := adapter.Remove(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, gosync.ErrCacheEmpty)
+	})
+}
@@ -81,6 +81,64 @@ func (_c *mockIGitHubTeam_AddTeamMembershipBySlug_Call) Return(_a0 *github.Membe
 	return _c
 }
 
+// ListPendingTeamInvitationsBySlug provides a mock function with given fields: ctx, org, slug, opts
+func (_m *mockIGitHubTeam) ListPendingTeamInvitationsBySlug(ctx context.Context, org string, slug string, opts *github.ListOptions) ([]*github.Invitation, *github.Response, error) {
+	ret := _m.Called(ctx, org, slug, opts)
+
+	var r0 []*github.Invitation
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *github.ListOptions) []*github.Invitation); ok {
+		r0 = rf(ctx, org, slug, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*github.Invitation)
+		}
+	}
+
+	var r1 *github.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *github.ListOptions) *github.Response); ok {
+		r1 = rf(ctx, org, slug, opts)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*github.Response)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, *github.ListOptions) error); ok {
+		r2 = rf(ctx, org, slug, opts)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// mockIGitHubTeam_ListPendingTeamInvitationsBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPendingTeamInvitationsBySlug'
+type mockIGitHubTeam_ListPendingTeamInvitationsBySlug_Call struct {
+	*mock.Call
+}
+
+// ListPendingTeamInvitationsBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - org string
+//   - slug string
+//   - opts *github.ListOptions
+func (_e *mockIGitHubTeam_Expecter) ListPendingTeamInvitationsBySlug(ctx interface{}, org interface{}, slug interface{}, opts interface{}) *mockIGitHubTeam_ListPendingTeamInvitationsBySlug_Call {
+	return &mockIGitHubTeam_ListPendingTeamInvitationsBySlug_Call{Call: _e.mock.On("ListPendingTeamInvitationsBySlug", ctx, org, slug, opts)}
+}
+
+func (_c *mockIGitHubTeam_ListPendingTeamInvitationsBySlug_Call) Run(run func(ctx context.Context, org string, slug string, opts *github.ListOptions)) *mockIGitHubTeam_ListPendingTeamInvitationsBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*github.ListOptions))
+	})
+	return _c
+}
+
+func (_c *mockIGitHubTeam_ListPendingTeamInvitationsBySlug_Call) Return(_a0 []*github.Invitation, _a1 *github.Response, _a2 error) *mockIGitHubTeam_ListPendingTeamInvitationsBySlug_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
 // ListTeamMembersBySlug provides a mock function with given fields: ctx, org, slug, opts
 func (_m *mockIGitHubTeam) ListTeamMembersBySlug(ctx context.Context, org string, slug string, opts *github.TeamListTeamMembersOptions) ([]*github.User, *github.Response, error) {
 	ret := _m.Called(ctx, org, slug, opts)
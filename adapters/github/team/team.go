@@ -15,17 +15,15 @@ import (
 
 	"github.com/google/go-github/v47/github"
 	gosync "github.com/ovotech/go-sync"
+	"github.com/ovotech/go-sync/adapters/github/discovery"
 )
 
 // Ensure the adapter type fully satisfies the ports.Adapter interface.
 var _ gosync.Adapter = &Team{}
 
 // GitHubDiscovery is required because there are multiple ways to convert a GitHub email into a username.
-// At OVO we use SAML, but other organisations may use public emails or another mechanism.
-type GitHubDiscovery interface {
-	GetUsernameFromEmail(context.Context, []string) ([]string, error)
-	GetEmailFromUsername(context.Context, []string) ([]string, error)
-}
+// It's shared by the GitHub adapters, see discovery.GitHubDiscovery.
+type GitHubDiscovery = discovery.GitHubDiscovery
 
 // iSlackConversation is a subset of the Slack Client, and used to build mocks for easy testing.
 type iGitHubTeam interface {
@@ -43,6 +41,12 @@ type iGitHubTeam interface {
 		opts *github.TeamAddTeamMembershipOptions,
 	) (*github.Membership, *github.Response, error)
 	RemoveTeamMembershipBySlug(ctx context.Context, org, slug, user string) (*github.Response, error)
+	ListPendingTeamInvitationsBySlug(
+		ctx context.Context,
+		org,
+		slug string,
+		opts *github.ListOptions,
+	) ([]*github.Invitation, *github.Response, error)
 }
 
 type Team struct {
@@ -119,11 +123,65 @@ func (t *Team) Get(ctx context.Context) ([]string, error) {
 		opts.Page = resp.NextPage
 	}
 
+	pending, err := t.getPending(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("github.team.get -> %w", err)
+	}
+
+	out = append(out, pending...)
+
 	t.logger.Println("Fetched accounts successfully")
 
 	return out, nil
 }
 
+// getPending gets the emails of users with a pending invitation to the team, so they aren't invited again by Add
+// before they've accepted. Removing a user with a pending invitation cancels the invitation.
+func (t *Team) getPending(ctx context.Context) ([]string, error) {
+	out := make([]string, 0)
+
+	opts := &github.ListOptions{}
+
+	for {
+		invitations, resp, err := t.teams.ListPendingTeamInvitationsBySlug(ctx, t.org, t.slug, opts)
+		if err != nil {
+			return nil, fmt.Errorf("listpendingteaminvitationsbyslug(%s, %s) -> %w", t.org, t.slug, err)
+		}
+
+		logins := make([]string, 0, len(invitations))
+
+		for _, invitation := range invitations {
+			// Invitations sent to an email rather than a GitHub user can't be managed by the team membership API.
+			if invitation.Login == nil {
+				continue
+			}
+
+			logins = append(logins, *invitation.Login)
+		}
+
+		if len(logins) > 0 {
+			emails, err := t.discovery.GetEmailFromUsername(ctx, logins)
+			if err != nil {
+				return nil, fmt.Errorf("discovery -> %w", err)
+			}
+
+			out = append(out, emails...)
+
+			for index, login := range logins {
+				t.cache[emails[index]] = login
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return out, nil
+}
+
 // Add emails to a GitHub Team.
 func (t *Team) Add(ctx context.Context, emails []string) error {
 	t.logger.Printf("Adding %s to GitHub team %s/%s", emails, t.org, t.slug)
@@ -133,7 +191,7 @@ func (t *Team) Add(ctx context.Context, emails []string) error {
 		return fmt.Errorf("github.team.add.discovery -> %w", err)
 	}
 
-	for _, name := range names {
+	for index, name := range names {
 		var opts = &github.TeamAddTeamMembershipOptions{
 			Role: "member",
 		}
@@ -142,6 +200,8 @@ func (t *Team) Add(ctx context.Context, emails []string) error {
 		if err != nil {
 			return fmt.Errorf("github.team.add.addteammembershipbyslug(%s, %s, %s) -> %w", t.org, t.slug, name, err)
 		}
+
+		gosync.ReportProgress(ctx, index+1, len(names))
 	}
 
 	t.logger.Println("Finished adding accounts successfully")
@@ -157,13 +217,15 @@ func (t *Team) Remove(ctx context.Context, emails []string) error {
 		return fmt.Errorf("github.team.remove -> %w", gosync.ErrCacheEmpty)
 	}
 
-	for _, email := range emails {
+	for index, email := range emails {
 		name := t.cache[email]
 
 		_, err := t.teams.RemoveTeamMembershipBySlug(ctx, t.org, t.slug, name)
 		if err != nil {
 			return fmt.Errorf("github.team.remove.removeteammembershipbyslug -> %w", err)
 		}
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
 	}
 
 	t.logger.Println("Finished removing accounts successfully")
@@ -46,11 +46,21 @@ func TestTeam_Get(t *testing.T) {
 	discovery.EXPECT().GetEmailFromUsername(ctx, []string{"foo"}).Return([]string{"foo@email"}, nil)
 	discovery.EXPECT().GetEmailFromUsername(ctx, []string{"bar"}).Return([]string{"bar@email"}, nil)
 
+	// Pending invitations are treated as members, so they aren't invited again.
+	gitHubClient.
+		EXPECT().
+		ListPendingTeamInvitationsBySlug(ctx, "org", "slug", &github.ListOptions{}).
+		Return([]*github.Invitation{
+			{Login: github.String("baz")},
+			{Email: github.String("invited@email")},
+		}, &github.Response{NextPage: 0}, nil)
+	discovery.EXPECT().GetEmailFromUsername(ctx, []string{"baz"}).Return([]string{"baz@email"}, nil)
+
 	users, err := adapter.Get(ctx)
 
 	assert.NoError(t, err)
-	assert.ElementsMatch(t, users, []string{"foo@email", "bar@email"})
-	assert.Equal(t, map[string]string{"foo@email": "foo", "bar@email": "bar"}, adapter.cache)
+	assert.ElementsMatch(t, users, []string{"foo@email", "bar@email", "baz@email"})
+	assert.Equal(t, map[string]string{"foo@email": "foo", "bar@email": "bar", "baz@email": "baz"}, adapter.cache)
 }
 
 func TestTeam_Add(t *testing.T) {
@@ -2,6 +2,7 @@ package group
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -14,6 +15,9 @@ const (
 	maxResults = 200
 )
 
+// ErrNestedGroup is reported as a warning when Get skips a member that's a group rather than a user.
+var ErrNestedGroup = errors.New("member is a nested group")
+
 // Ensure the adapter type fully satisfies the ports.Adapter interface.
 var _ gosync.Adapter = &Group{}
 
@@ -109,6 +113,14 @@ func (g *Group) Get(ctx context.Context) ([]string, error) {
 		}
 
 		for _, member := range response.Members {
+			// Nested groups aren't users, so leave them unmanaged rather than letting Remove delete them.
+			if member.Type == "GROUP" {
+				g.logger.Printf("Skipping %s, as it's a nested group", member.Email)
+				gosync.Warn(ctx, fmt.Errorf("google.group.get(%s, %s) -> %w", g.name, member.Email, ErrNestedGroup))
+
+				continue
+			}
+
 			emails = append(emails, member.Email)
 		}
 
@@ -128,7 +140,7 @@ func (g *Group) Get(ctx context.Context) ([]string, error) {
 func (g *Group) Add(ctx context.Context, emails []string) error {
 	g.logger.Printf("Adding %s to Google Group %s", emails, g.name)
 
-	for _, email := range emails {
+	for index, email := range emails {
 		_, err := g.callInsert(ctx, g.membersService.Insert(g.name, &admin.Member{
 			Email:            email,
 			DeliverySettings: g.deliverySettings,
@@ -137,6 +149,8 @@ func (g *Group) Add(ctx context.Context, emails []string) error {
 		if err != nil {
 			return fmt.Errorf("google.group.add(%s, %s) -> %w", g.name, email, err)
 		}
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
 	}
 
 	g.logger.Println("Finished adding accounts successfully")
@@ -148,11 +162,13 @@ func (g *Group) Add(ctx context.Context, emails []string) error {
 func (g *Group) Remove(ctx context.Context, emails []string) error {
 	g.logger.Printf("Removing %s from Google Group %s", emails, g.name)
 
-	for _, email := range emails {
+	for index, email := range emails {
 		err := g.callDelete(ctx, g.membersService.Delete(g.name, email))
 		if err != nil {
 			return fmt.Errorf("google.group.remove(%s, %s) -> %w", g.name, email, err)
 		}
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
 	}
 
 	g.logger.Println("Finished removing accounts successfully")
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	gosync "github.com/ovotech/go-sync"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	admin "google.golang.org/api/admin/directory/v1"
@@ -74,6 +75,38 @@ func TestGroups_Get(t *testing.T) {
 	assert.ElementsMatch(t, []string{"foo@email", "bar@email"}, emails)
 }
 
+func TestGroups_Get_NestedGroup(t *testing.T) {
+	t.Parallel()
+
+	ctx := gosync.ContextWithWarnings(context.TODO())
+
+	mockMembersService := newMockIMembersService(t)
+	mockMembersService.EXPECT().List("test").Return(nil)
+
+	mockCall := new(mockCalls)
+	mockCall.On("callList", ctx, mock.Anything, "").Return(&admin.Members{
+		Members: []*admin.Member{
+			{Email: "foo@email", Type: "USER"},
+			{Email: "nested@email", Type: "GROUP"},
+		},
+	}, nil)
+
+	group := New(&admin.Service{}, "test")
+	group.membersService = mockMembersService
+	group.callList = mockCall.callList
+
+	emails, err := group.Get(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"foo@email"}, emails)
+
+	warnings := gosync.Warnings(ctx)
+	if assert.Len(t, warnings, 1) {
+		assert.ErrorIs(t, warnings[0], ErrNestedGroup)
+		assert.ErrorContains(t, warnings[0], "nested@email")
+	}
+}
+
 func TestGroups_Add(t *testing.T) {
 	t.Parallel()
 
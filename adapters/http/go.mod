@@ -0,0 +1,15 @@
+module github.com/ovotech/go-sync/adapters/http
+
+go 1.18
+
+require (
+	github.com/ovotech/go-sync v0.5.0
+	github.com/stretchr/testify v1.8.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/stretchr/objx v0.4.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,321 @@
+/*
+Package rest synchronises email addresses with a generic JSON REST API, e.g. an in-house access management service.
+
+Get sends a request to the list endpoint, and decodes a JSON array of emails from the response. Add and Remove send the
+emails as a JSON array to the add and remove endpoints.
+*/
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	gosync "github.com/ovotech/go-sync"
+)
+
+// Ensure the adapter type fully satisfies the ports.Adapter and ports.ConfiguredAdapter interfaces.
+var (
+	_ gosync.Adapter           = &REST{}
+	_ gosync.ConfiguredAdapter = &REST{}
+)
+
+var (
+	// ErrUnexpectedResponse is returned when the API responds with an unexpected status code.
+	ErrUnexpectedResponse = errors.New("unexpected response")
+	// ErrFieldNotFound is returned when a response doesn't match the path set with WithField.
+	ErrFieldNotFound = errors.New("field not found")
+)
+
+// DecodeFunc decodes the emails from the body of a list response.
+type DecodeFunc func(body io.Reader) ([]string, error)
+
+// endpoint is a method and URL the adapter sends requests to.
+type endpoint struct {
+	method string
+	url    string
+}
+
+type REST struct {
+	client  *http.Client
+	list    endpoint
+	add     endpoint
+	remove  endpoint
+	headers http.Header
+	field   string     // field is the path to the emails in a list response, set with WithField.
+	decode  DecodeFunc // decode decodes the emails from a list response.
+	logger  *log.Logger
+}
+
+// WithHTTPClient sets a custom HTTP client, e.g. to configure timeouts, proxies or authentication.
+func WithHTTPClient(client *http.Client) func(*REST) {
+	return func(adapter *REST) {
+		adapter.client = client
+	}
+}
+
+// WithList sets the method used to get emails from the list URL. Default is GET.
+func WithList(method string) func(*REST) {
+	return func(adapter *REST) {
+		adapter.list.method = method
+	}
+}
+
+// WithAdd sets the method and URL emails are sent to by Add. Default is POST to the list URL.
+func WithAdd(method string, url string) func(*REST) {
+	return func(adapter *REST) {
+		adapter.add = endpoint{method: method, url: url}
+	}
+}
+
+// WithRemove sets the method and URL emails are sent to by Remove. Default is DELETE to the list URL.
+func WithRemove(method string, url string) func(*REST) {
+	return func(adapter *REST) {
+		adapter.remove = endpoint{method: method, url: url}
+	}
+}
+
+// WithHeader sets a header sent with every request, e.g. WithHeader("Authorization", "Bearer my-token").
+func WithHeader(name string, value string) func(*REST) {
+	return func(adapter *REST) {
+		adapter.headers.Set(name, value)
+	}
+}
+
+// WithField finds the emails in a list response that wraps them in an object, using a dot-separated path of keys,
+// e.g. data.members for {"data": {"members": [...]}}. If a key contains an array of objects, the rest of the path is
+// applied to each, e.g. members.email for {"members": [{"email": "..."}]}.
+func WithField(path string) func(*REST) {
+	return func(adapter *REST) {
+		adapter.field = path
+		adapter.decode = fieldDecoder(path)
+	}
+}
+
+// WithDecoder sets a custom function to decode the emails from a list response, for responses WithField can't handle.
+func WithDecoder(decode DecodeFunc) func(*REST) {
+	return func(adapter *REST) {
+		adapter.field = ""
+		adapter.decode = decode
+	}
+}
+
+// WithLogger sets a custom logger.
+func WithLogger(logger *log.Logger) func(*REST) {
+	return func(adapter *REST) {
+		adapter.logger = logger
+	}
+}
+
+// New instantiates a new REST adapter, which gets emails from listURL.
+func New(listURL string, optsFn ...func(*REST)) *REST {
+	adapter := &REST{
+		client:  http.DefaultClient,
+		list:    endpoint{method: http.MethodGet, url: listURL},
+		add:     endpoint{method: http.MethodPost, url: listURL},
+		remove:  endpoint{method: http.MethodDelete, url: listURL},
+		headers: make(http.Header),
+		field:   "",
+		decode:  decodeArray,
+		logger:  log.New(os.Stderr, "[go-sync/http/rest] ", log.LstdFlags|log.Lshortfile|log.Lmsgprefix),
+	}
+
+	for _, fn := range optsFn {
+		fn(adapter)
+	}
+
+	return adapter
+}
+
+// Config returns the adapter's configuration. Header values may contain secrets, so only their names are included.
+func (r *REST) Config() map[string]string {
+	headers := make([]string, 0, len(r.headers))
+	for name := range r.headers {
+		headers = append(headers, name)
+	}
+
+	sort.Strings(headers)
+
+	return map[string]string{
+		"list":    r.list.method + " " + r.list.url,
+		"add":     r.add.method + " " + r.add.url,
+		"remove":  r.remove.method + " " + r.remove.url,
+		"headers": strings.Join(headers, ","),
+		"field":   r.field,
+	}
+}
+
+// decodeArray is the default DecodeFunc, which decodes a JSON array of emails.
+func decodeArray(body io.Reader) ([]string, error) {
+	var emails []string
+
+	if err := json.NewDecoder(body).Decode(&emails); err != nil {
+		return nil, fmt.Errorf("decode -> %w", err)
+	}
+
+	return emails, nil
+}
+
+// fieldDecoder returns a DecodeFunc that finds the emails at a dot-separated path in the response.
+func fieldDecoder(path string) DecodeFunc {
+	keys := strings.Split(path, ".")
+
+	return func(body io.Reader) ([]string, error) {
+		var value interface{}
+
+		if err := json.NewDecoder(body).Decode(&value); err != nil {
+			return nil, fmt.Errorf("decode -> %w", err)
+		}
+
+		emails, err := selectField(value, keys)
+		if err != nil {
+			return nil, fmt.Errorf("select(%s) -> %w", path, err)
+		}
+
+		return emails, nil
+	}
+}
+
+// selectField follows keys through a decoded JSON value, and returns the strings at the end of the path. Arrays are
+// flattened, with the rest of the path applied to each element.
+func selectField(value interface{}, keys []string) ([]string, error) {
+	switch typed := value.(type) {
+	case []interface{}:
+		var out []string
+
+		for _, element := range typed {
+			selected, err := selectField(element, keys)
+			if err != nil {
+				return nil, err
+			}
+
+			out = append(out, selected...)
+		}
+
+		return out, nil
+	case map[string]interface{}:
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("%w: expected a string, got an object", ErrFieldNotFound)
+		}
+
+		next, ok := typed[keys[0]]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrFieldNotFound, keys[0])
+		}
+
+		return selectField(next, keys[1:])
+	case string:
+		if len(keys) > 0 {
+			return nil, fmt.Errorf("%w: %s", ErrFieldNotFound, keys[0])
+		}
+
+		return []string{typed}, nil
+	default:
+		return nil, fmt.Errorf("%w: unexpected %T", ErrFieldNotFound, value)
+	}
+}
+
+// do sends a request to an endpoint, with a JSON body if it isn't nil. A successful response's body is passed to read
+// if it isn't nil.
+func (r *REST) do(ctx context.Context, to endpoint, body interface{}, read func(io.Reader) error) error {
+	var reader io.Reader
+
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshal -> %w", err)
+		}
+
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, to.method, to.url, reader)
+	if err != nil {
+		return fmt.Errorf("newrequest(%s, %s) -> %w", to.method, to.url, err)
+	}
+
+	for name, values := range r.headers {
+		req.Header[name] = values
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("do(%s, %s) -> %w", to.method, to.url, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("do(%s, %s) -> %w: %s", to.method, to.url, ErrUnexpectedResponse, res.Status)
+	}
+
+	if read != nil {
+		if err := read(res.Body); err != nil {
+			return fmt.Errorf("read(%s, %s) -> %w", to.method, to.url, err)
+		}
+	}
+
+	return nil
+}
+
+// Get emails from the list endpoint.
+func (r *REST) Get(ctx context.Context) ([]string, error) {
+	r.logger.Printf("Fetching accounts from %s", r.list.url)
+
+	var emails []string
+
+	err := r.do(ctx, r.list, nil, func(body io.Reader) error {
+		var err error
+		emails, err = r.decode(body)
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("http.rest.get -> %w", err)
+	}
+
+	r.logger.Println("Fetched accounts successfully")
+
+	return emails, nil
+}
+
+// Add emails by sending them as a JSON array to the add endpoint.
+func (r *REST) Add(ctx context.Context, emails []string) error {
+	r.logger.Printf("Adding %s to %s", emails, r.add.url)
+
+	if err := r.do(ctx, r.add, emails, nil); err != nil {
+		return fmt.Errorf("http.rest.add -> %w", err)
+	}
+
+	gosync.ReportProgress(ctx, len(emails), len(emails))
+	r.logger.Println("Finished adding accounts successfully")
+
+	return nil
+}
+
+// Remove emails by sending them as a JSON array to the remove endpoint.
+func (r *REST) Remove(ctx context.Context, emails []string) error {
+	r.logger.Printf("Removing %s from %s", emails, r.remove.url)
+
+	if err := r.do(ctx, r.remove, emails, nil); err != nil {
+		return fmt.Errorf("http.rest.remove -> %w", err)
+	}
+
+	gosync.ReportProgress(ctx, len(emails), len(emails))
+	r.logger.Println("Finished removing accounts successfully")
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	adapter := New("https://example.com/members",
+		WithAdd(http.MethodPut, "https://example.com/members/add"),
+		WithHeader("Authorization", "Bearer secret-token"),
+		WithField("data.members"),
+	)
+
+	assert.Equal(t, map[string]string{
+		"list":    "GET https://example.com/members",
+		"add":     "PUT https://example.com/members/add",
+		"remove":  "DELETE https://example.com/members",
+		"headers": "Authorization",
+		"field":   "data.members",
+	}, adapter.Config())
+}
+
+//nolint:funlen
+func TestREST_Get(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Array", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/members", r.URL.Path)
+			assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+			_, _ = w.Write([]byte(`["foo@email", "bar@email"]`))
+		}))
+		defer server.Close()
+
+		adapter := New(server.URL+"/members", WithHTTPClient(server.Client()), WithHeader("Authorization", "Bearer token"))
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email", "bar@email"}, emails)
+	})
+
+	t.Run("Field", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"data":{"members":[{"email":"foo@email"},{"email":"bar@email"}]}}`))
+		}))
+		defer server.Close()
+
+		emails, err := New(server.URL, WithField("data.members.email")).Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email", "bar@email"}, emails)
+
+		_, err = New(server.URL, WithField("data.users")).Get(ctx)
+		assert.ErrorIs(t, err, ErrFieldNotFound)
+
+		_, err = New(server.URL, WithField("data.members")).Get(ctx)
+		assert.ErrorIs(t, err, ErrFieldNotFound)
+	})
+
+	t.Run("Decoder", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("foo@email\nbar@email\n"))
+		}))
+		defer server.Close()
+
+		adapter := New(server.URL, WithDecoder(func(body io.Reader) ([]string, error) {
+			data, err := io.ReadAll(body)
+
+			return strings.Fields(string(data)), err
+		}))
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email", "bar@email"}, emails)
+	})
+
+	t.Run("Unexpected response", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		emails, err := New(server.URL).Get(ctx)
+
+		assert.ErrorIs(t, err, ErrUnexpectedResponse)
+		assert.Nil(t, emails)
+	})
+
+	t.Run("Context", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("request sent with a cancelled context")
+		}))
+		defer server.Close()
+
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := New(server.URL).Get(cancelled)
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestREST_AddRemove(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body []string
+
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+strings.Join(body, ","))
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	adapter := New(server.URL+"/members", WithRemove(http.MethodPost, server.URL+"/members/remove"))
+
+	assert.NoError(t, adapter.Add(ctx, []string{"foo@email", "bar@email"}))
+	assert.NoError(t, adapter.Remove(ctx, []string{"baz@email"}))
+	assert.Equal(t, []string{
+		"POST /members foo@email,bar@email",
+		"POST /members/remove baz@email",
+	}, requests)
+}
@@ -0,0 +1,235 @@
+package group
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrUnexpectedResponse is returned when the Keycloak API responds with an unexpected status code.
+var ErrUnexpectedResponse = errors.New("unexpected response from keycloak")
+
+// tokenExpiryMargin is how long before an access token expires that it's refreshed, to allow for slow requests.
+const tokenExpiryMargin = 30 * time.Second
+
+// user is a Keycloak user, with only the properties used by the adapter.
+type user struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+// Client is a minimal Keycloak Admin REST API client, authenticated as a confidential client's service account with
+// the client credentials grant. Access tokens are requested when needed, and refreshed before they expire.
+//
+// It's a stand-in for github.com/Nerzal/gocloak, which couldn't be fetched when this adapter was written. The adapter
+// only uses Client through iKeycloakClient, so moving to gocloak means replacing this file, not the adapter.
+type Client struct {
+	httpClient   *http.Client
+	server       string
+	authRealm    string // authRealm is the realm the client is registered in.
+	clientID     string
+	clientSecret string
+	mu           sync.Mutex
+	accessToken  string
+	expires      time.Time
+	now          func() time.Time // now is the clock used to expire access tokens, and can be replaced in tests.
+}
+
+// NewClient creates a new Keycloak client for a server, e.g. https://keycloak.example.com, and a confidential client
+// in authRealm with a service account. For Keycloak 16 and earlier, include the /auth path in the server's address.
+func NewClient(server string, authRealm string, clientID string, clientSecret string) *Client {
+	return &Client{
+		httpClient:   http.DefaultClient,
+		server:       strings.TrimSuffix(server, "/"),
+		authRealm:    authRealm,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		mu:           sync.Mutex{},
+		accessToken:  "",
+		expires:      time.Time{},
+		now:          time.Now,
+	}
+}
+
+// WithHTTPClient sets a custom HTTP client, e.g. to configure timeouts or proxies.
+func (c *Client) WithHTTPClient(httpClient *http.Client) *Client {
+	c.httpClient = httpClient
+
+	return c
+}
+
+// getToken returns the current access token, requesting a new one if there isn't one or it's about to expire.
+func (c *Client) getToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.accessToken != "" && c.now().Before(c.expires) {
+		return c.accessToken, nil
+	}
+
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {c.clientID},
+		"client_secret": {c.clientSecret},
+	}
+
+	endpoint := c.server + "/realms/" + url.PathEscape(c.authRealm) + "/protocol/openid-connect/token"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("newrequest(%s) -> %w", c.authRealm, err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("do(%s) -> %w", c.authRealm, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("do(%s) -> %w: %s", c.authRealm, ErrUnexpectedResponse, res.Status)
+	}
+
+	var out struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return "", fmt.Errorf("decode(%s) -> %w", c.authRealm, err)
+	}
+
+	c.accessToken = out.AccessToken
+	c.expires = c.now().Add(time.Duration(out.ExpiresIn)*time.Second - tokenExpiryMargin)
+
+	return c.accessToken, nil
+}
+
+// invalidateToken discards an access token if it's still the current one, so the next request gets a new one.
+func (c *Client) invalidateToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.accessToken == token {
+		c.accessToken = ""
+	}
+}
+
+// do makes a request to the Keycloak Admin API for a realm, and decodes the response into out if it isn't nil. If the
+// access token is rejected (e.g. it was revoked), a new one is requested and the request is retried once.
+func (c *Client) do(
+	ctx context.Context,
+	method string,
+	realm string,
+	path string,
+	out interface{},
+) error {
+	path = "/admin/realms/" + url.PathEscape(realm) + path
+
+	for attempt := 0; ; attempt++ {
+		token, err := c.getToken(ctx)
+		if err != nil {
+			return fmt.Errorf("gettoken -> %w", err)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, c.server+path, http.NoBody)
+		if err != nil {
+			return fmt.Errorf("newrequest(%s, %s) -> %w", method, path, err)
+		}
+
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Accept", "application/json")
+
+		res, err := c.httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("do(%s, %s) -> %w", method, path, err)
+		}
+
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+
+		if err != nil {
+			return fmt.Errorf("read(%s, %s) -> %w", method, path, err)
+		}
+
+		if res.StatusCode == http.StatusUnauthorized && attempt == 0 {
+			c.invalidateToken(token)
+
+			continue
+		}
+
+		if res.StatusCode < 200 || res.StatusCode > 299 {
+			return fmt.Errorf("do(%s, %s) -> %w: %s", method, path, ErrUnexpectedResponse, res.Status)
+		}
+
+		if out != nil {
+			if err := json.NewDecoder(bytes.NewReader(body)).Decode(out); err != nil {
+				return fmt.Errorf("decode(%s, %s) -> %w", method, path, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+// GetGroupMembers gets a page of up to max members of a group, starting at first.
+func (c *Client) GetGroupMembers(
+	ctx context.Context,
+	realm string,
+	groupID string,
+	first int,
+	max int,
+) ([]user, error) {
+	query := url.Values{"first": {strconv.Itoa(first)}, "max": {strconv.Itoa(max)}, "briefRepresentation": {"true"}}
+	path := "/groups/" + url.PathEscape(groupID) + "/members?" + query.Encode()
+
+	var members []user
+
+	if err := c.do(ctx, http.MethodGet, realm, path, &members); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// FindUserByEmail finds a user by their email, or returns nil if there isn't one.
+func (c *Client) FindUserByEmail(ctx context.Context, realm string, email string) (*user, error) {
+	query := url.Values{"email": {email}, "exact": {"true"}, "briefRepresentation": {"true"}}
+
+	var users []user
+
+	if err := c.do(ctx, http.MethodGet, realm, "/users?"+query.Encode(), &users); err != nil {
+		return nil, err
+	}
+
+	// Older versions of Keycloak ignore exact, and match partial emails.
+	for _, found := range users {
+		if strings.EqualFold(found.Email, email) {
+			return &found, nil
+		}
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+// AddUserToGroup adds a user to a group by their ID.
+func (c *Client) AddUserToGroup(ctx context.Context, realm string, userID string, groupID string) error {
+	return c.do(ctx, http.MethodPut, realm, "/users/"+url.PathEscape(userID)+"/groups/"+url.PathEscape(groupID), nil)
+}
+
+// RemoveUserFromGroup removes a user from a group by their ID.
+func (c *Client) RemoveUserFromGroup(ctx context.Context, realm string, userID string, groupID string) error {
+	return c.do(ctx, http.MethodDelete, realm, "/users/"+url.PathEscape(userID)+"/groups/"+url.PathEscape(groupID), nil)
+}
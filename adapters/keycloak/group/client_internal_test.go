@@ -0,0 +1,154 @@
+package group
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// startServer starts a fake Keycloak server, which issues numbered access tokens from the master realm's token
+// endpoint, and checks each Admin API request has the latest one before passing it to handler.
+func startServer(t *testing.T, handler http.HandlerFunc) (*Client, *int32) {
+	t.Helper()
+
+	var tokens int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/realms/master/protocol/openid-connect/token" {
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+			assert.Equal(t, "go-sync", r.PostForm.Get("client_id"))
+			assert.Equal(t, "secret", r.PostForm.Get("client_secret"))
+
+			_, _ = fmt.Fprintf(w, `{"access_token":"token%d","expires_in":300}`, atomic.AddInt32(&tokens, 1))
+
+			return
+		}
+
+		if r.Header.Get("Authorization") != fmt.Sprintf("Bearer token%d", atomic.LoadInt32(&tokens)) {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		handler(w, r)
+	}))
+
+	t.Cleanup(server.Close)
+
+	return NewClient(server.URL+"/", "master", "go-sync", "secret"), &tokens
+}
+
+//nolint:funlen
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("GetGroupMembers", func(t *testing.T) {
+		t.Parallel()
+
+		client, _ := startServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/admin/realms/my-realm/groups/group-id/members", r.URL.Path)
+			assert.Equal(t, "100", r.URL.Query().Get("first"))
+			assert.Equal(t, "100", r.URL.Query().Get("max"))
+
+			_, _ = w.Write([]byte(`[{"id":"foo","username":"foo","email":"foo@email","enabled":true}]`))
+		})
+
+		members, err := client.GetGroupMembers(ctx, "my-realm", "group-id", 100, 100)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []user{{ID: "foo", Username: "foo", Email: "foo@email"}}, members)
+	})
+
+	t.Run("FindUserByEmail", func(t *testing.T) {
+		t.Parallel()
+
+		client, _ := startServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/admin/realms/my-realm/users", r.URL.Path)
+			assert.Equal(t, "true", r.URL.Query().Get("exact"))
+
+			if r.URL.Query().Get("email") == "Foo@Email" {
+				// Older versions of Keycloak also match partial emails.
+				_, _ = w.Write([]byte(`[{"id":"foobar","email":"foo.bar@email"},{"id":"foo","email":"foo@email"}]`))
+			} else {
+				_, _ = w.Write([]byte(`[]`))
+			}
+		})
+
+		found, err := client.FindUserByEmail(ctx, "my-realm", "Foo@Email")
+		assert.NoError(t, err)
+		assert.Equal(t, "foo", found.ID)
+
+		found, err = client.FindUserByEmail(ctx, "my-realm", "bar@email")
+		assert.NoError(t, err)
+		assert.Nil(t, found)
+	})
+
+	t.Run("Group membership", func(t *testing.T) {
+		t.Parallel()
+
+		client, _ := startServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/admin/realms/my-realm/users/foo/groups/group-id" {
+				w.WriteHeader(http.StatusNotFound)
+
+				return
+			}
+
+			assert.Contains(t, []string{http.MethodPut, http.MethodDelete}, r.Method)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+		assert.NoError(t, client.AddUserToGroup(ctx, "my-realm", "foo", "group-id"))
+		assert.NoError(t, client.RemoveUserFromGroup(ctx, "my-realm", "foo", "group-id"))
+		assert.ErrorIs(t, client.RemoveUserFromGroup(ctx, "my-realm", "bar", "group-id"), ErrUnexpectedResponse)
+	})
+
+	t.Run("Token refresh", func(t *testing.T) {
+		t.Parallel()
+
+		client, tokens := startServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+		now := time.Now()
+		client.now = func() time.Time { return now }
+
+		// The token is reused until it's about to expire.
+		assert.NoError(t, client.AddUserToGroup(ctx, "my-realm", "foo", "group-id"))
+		assert.NoError(t, client.AddUserToGroup(ctx, "my-realm", "foo", "group-id"))
+		assert.Equal(t, int32(1), atomic.LoadInt32(tokens))
+
+		now = now.Add(5 * time.Minute)
+
+		assert.NoError(t, client.AddUserToGroup(ctx, "my-realm", "foo", "group-id"))
+		assert.Equal(t, int32(2), atomic.LoadInt32(tokens))
+
+		// A rejected token is replaced, and the request retried.
+		client.accessToken = "revoked"
+
+		assert.NoError(t, client.AddUserToGroup(ctx, "my-realm", "foo", "group-id"))
+		assert.Equal(t, int32(3), atomic.LoadInt32(tokens))
+	})
+
+	t.Run("Authentication error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		t.Cleanup(server.Close)
+
+		client := NewClient(server.URL, "master", "go-sync", "wrong")
+
+		assert.ErrorIs(t, client.AddUserToGroup(ctx, "my-realm", "foo", "group-id"), ErrUnexpectedResponse)
+	})
+}
@@ -0,0 +1,205 @@
+/*
+Package group synchronises email addresses with the direct members of a Keycloak group.
+
+In order to use this adapter, you'll need a confidential Keycloak client with a service account, which has the
+view-users and manage-users roles of the realm-management client in the group's realm.
+*/
+package group
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	gosync "github.com/ovotech/go-sync"
+)
+
+// Ensure the adapter type fully satisfies the ports.Adapter and ports.ConfiguredAdapter interfaces.
+var (
+	_ gosync.Adapter           = &Group{}
+	_ gosync.ConfiguredAdapter = &Group{}
+)
+
+var (
+	// ErrUserNotFound is returned when an email can't be resolved to a Keycloak user.
+	ErrUserNotFound = errors.New("user not found")
+	// ErrMissingEmail is reported as a warning when a member doesn't have an email.
+	ErrMissingEmail = errors.New("member doesn't have an email")
+)
+
+const pageSize = 100 // pageSize is how many group members are requested per page.
+
+// iKeycloakClient is a subset of the Keycloak Client, and used to build mocks for easy testing.
+type iKeycloakClient interface {
+	GetGroupMembers(ctx context.Context, realm string, groupID string, first int, max int) ([]user, error)
+	FindUserByEmail(ctx context.Context, realm string, email string) (*user, error)
+	AddUserToGroup(ctx context.Context, realm string, userID string, groupID string) error
+	RemoveUserFromGroup(ctx context.Context, realm string, userID string, groupID string) error
+}
+
+type Group struct {
+	client  iKeycloakClient
+	realm   string // realm is the Keycloak realm the group is in.
+	groupID string // groupID is the ID of the Keycloak group.
+	// cache stores the email -> user ID mapping for use with the Remove method.
+	cache  map[string]string
+	logger *log.Logger
+}
+
+// WithLogger sets a custom logger.
+func WithLogger(logger *log.Logger) func(*Group) {
+	return func(group *Group) {
+		group.logger = logger
+	}
+}
+
+// New instantiates a new Keycloak group adapter, for the ID of a group in a realm.
+func New(client *Client, realm string, groupID string, optsFn ...func(*Group)) *Group {
+	adapter := &Group{
+		client:  client,
+		realm:   realm,
+		groupID: groupID,
+		cache:   nil,
+		logger:  log.New(os.Stderr, "[go-sync/keycloak/group] ", log.LstdFlags|log.Lshortfile|log.Lmsgprefix),
+	}
+
+	for _, fn := range optsFn {
+		fn(adapter)
+	}
+
+	return adapter
+}
+
+// Config returns the adapter's configuration, with the client secret redacted.
+func (g *Group) Config() map[string]string {
+	config := map[string]string{
+		"realm":   g.realm,
+		"groupID": g.groupID,
+	}
+
+	if client, ok := g.client.(*Client); ok {
+		config["server"] = client.server
+		config["authRealm"] = client.authRealm
+		config["clientID"] = client.clientID
+		config["clientSecret"] = gosync.Redacted
+	}
+
+	return config
+}
+
+// getUserID returns the user ID of an email from the cache, or searches the realm for it otherwise.
+func (g *Group) getUserID(ctx context.Context, email string) (string, error) {
+	if userID, ok := g.cache[email]; ok {
+		return userID, nil
+	}
+
+	found, err := g.client.FindUserByEmail(ctx, g.realm, email)
+	if err != nil {
+		return "", fmt.Errorf("finduserbyemail(%s) -> %w", email, err)
+	}
+
+	if found == nil {
+		return "", fmt.Errorf("finduserbyemail(%s) -> %w", email, ErrUserNotFound)
+	}
+
+	return found.ID, nil
+}
+
+// Get emails of direct members of a Keycloak group. Members without an email are skipped, and reported as an
+// ErrMissingEmail warning.
+func (g *Group) Get(ctx context.Context) ([]string, error) {
+	g.logger.Printf("Fetching accounts from Keycloak group %s", g.groupID)
+
+	// Initialise the cache.
+	g.cache = make(map[string]string)
+
+	emails := make([]string, 0)
+
+	for first := 0; ; first += pageSize {
+		members, err := g.client.GetGroupMembers(ctx, g.realm, g.groupID, first, pageSize)
+		if err != nil {
+			return nil, fmt.Errorf("keycloak.group.get.getgroupmembers(%s, %d) -> %w", g.groupID, first, err)
+		}
+
+		for _, member := range members {
+			if member.Email == "" {
+				gosync.Warn(ctx, fmt.Errorf("keycloak.group.get(%s) -> %w", member.Username, ErrMissingEmail))
+
+				continue
+			}
+
+			emails = append(emails, member.Email)
+
+			// Add the email -> user ID map for use with the Remove method.
+			g.cache[member.Email] = member.ID
+		}
+
+		// A short page is the last one.
+		if len(members) < pageSize {
+			break
+		}
+	}
+
+	g.logger.Println("Fetched accounts successfully")
+
+	return emails, nil
+}
+
+// Add emails to a Keycloak group.
+func (g *Group) Add(ctx context.Context, emails []string) error {
+	g.logger.Printf("Adding %s to Keycloak group %s", emails, g.groupID)
+
+	for index, email := range emails {
+		userID, err := g.getUserID(ctx, email)
+		if err != nil {
+			return fmt.Errorf("keycloak.group.add -> %w", err)
+		}
+
+		err = g.client.AddUserToGroup(ctx, g.realm, userID, g.groupID)
+		if err != nil {
+			return fmt.Errorf("keycloak.group.add.addusertogroup(%s, %s) -> %w", g.groupID, email, err)
+		}
+
+		if g.cache != nil {
+			g.cache[email] = userID
+		}
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
+	}
+
+	g.logger.Println("Finished adding accounts successfully")
+
+	return nil
+}
+
+// Remove emails from a Keycloak group.
+func (g *Group) Remove(ctx context.Context, emails []string) error {
+	g.logger.Printf("Removing %s from Keycloak group %s", emails, g.groupID)
+
+	// If the cache hasn't been generated, regenerate it.
+	if g.cache == nil {
+		return fmt.Errorf("keycloak.group.remove -> %w", gosync.ErrCacheEmpty)
+	}
+
+	for index, email := range emails {
+		userID, err := g.getUserID(ctx, email)
+		if err != nil {
+			return fmt.Errorf("keycloak.group.remove -> %w", err)
+		}
+
+		err = g.client.RemoveUserFromGroup(ctx, g.realm, userID, g.groupID)
+		if err != nil {
+			return fmt.Errorf("keycloak.group.remove.removeuserfromgroup(%s, %s) -> %w", g.groupID, email, err)
+		}
+
+		delete(g.cache, email)
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
+	}
+
+	g.logger.Println("Finished removing accounts successfully")
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	gosync "github.com/ovotech/go-sync"
+	"github.com/stretchr/testify/assert"
+)
+
+var errKeycloak = errors.New("an example error")
+
+func createMockedAdapter(t *testing.T, optsFn ...func(*Group)) (*Group, *mockIKeycloakClient) {
+	t.Helper()
+
+	client := newMockIKeycloakClient(t)
+	adapter := New(NewClient("https://keycloak.example.com", "master", "go-sync", "secret"), "my-realm", "group-id",
+		optsFn...)
+	adapter.client = client
+
+	return adapter, client
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	adapter := New(NewClient("https://keycloak.example.com/", "master", "go-sync", "secret"), "my-realm", "group-id")
+
+	assert.Equal(t, "group-id", adapter.groupID)
+	assert.Equal(t, map[string]string{
+		"realm":        "my-realm",
+		"groupID":      "group-id",
+		"server":       "https://keycloak.example.com",
+		"authRealm":    "master",
+		"clientID":     "go-sync",
+		"clientSecret": gosync.Redacted,
+	}, adapter.Config())
+}
+
+func TestGroup_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := gosync.ContextWithWarnings(context.TODO())
+		adapter, client := createMockedAdapter(t)
+
+		firstPage := make([]user, pageSize)
+		for i := range firstPage {
+			firstPage[i] = user{ID: fmt.Sprint(i), Username: fmt.Sprintf("user%d", i), Email: fmt.Sprintf("user%d@email", i)}
+		}
+
+		client.EXPECT().GetGroupMembers(ctx, "my-realm", "group-id", 0, pageSize).Return(firstPage, nil)
+		client.EXPECT().GetGroupMembers(ctx, "my-realm", "group-id", pageSize, pageSize).Return([]user{
+			{ID: "foo", Username: "foo", Email: "foo@email"},
+			{ID: "service", Username: "service-account-go-sync"},
+		}, nil)
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Len(t, emails, pageSize+1)
+		assert.Equal(t, "foo@email", emails[pageSize])
+		assert.Equal(t, "foo", adapter.cache["foo@email"])
+
+		warnings := gosync.Warnings(ctx)
+		assert.Len(t, warnings, 1)
+		assert.ErrorIs(t, warnings[0], ErrMissingEmail)
+		assert.ErrorContains(t, warnings[0], "service-account-go-sync")
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.TODO()
+		adapter, client := createMockedAdapter(t)
+
+		client.EXPECT().GetGroupMembers(ctx, "my-realm", "group-id", 0, pageSize).Return(nil, errKeycloak)
+
+		_, err := adapter.Get(ctx)
+
+		assert.ErrorIs(t, err, errKeycloak)
+	})
+}
+
+func TestGroup_Add(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+		adapter.cache = map[string]string{}
+
+		client.EXPECT().FindUserByEmail(ctx, "my-realm", "foo@email").Return(&user{ID: "foo", Email: "foo@email"}, nil)
+		client.EXPECT().AddUserToGroup(ctx, "my-realm", "foo", "group-id").Return(nil)
+
+		assert.NoError(t, adapter.Add(ctx, []string{"foo@email"}))
+		assert.Equal(t, map[string]string{"foo@email": "foo"}, adapter.cache)
+	})
+
+	t.Run("User not found", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+
+		client.EXPECT().FindUserByEmail(ctx, "my-realm", "foo@email").Return(nil, nil)
+
+		assert.ErrorIs(t, adapter.Add(ctx, []string{"foo@email"}), ErrUserNotFound)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+
+		client.EXPECT().FindUserByEmail(ctx, "my-realm", "foo@email").Return(&user{ID: "foo", Email: "foo@email"}, nil)
+		client.EXPECT().AddUserToGroup(ctx, "my-realm", "foo", "group-id").Return(errKeycloak)
+
+		assert.ErrorIs(t, adapter.Add(ctx, []string{"foo@email"}), errKeycloak)
+	})
+}
+
+func TestGroup_Remove(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+		adapter.cache = map[string]string{"foo@email": "foo", "bar@email": "bar"}
+
+		client.EXPECT().RemoveUserFromGroup(ctx, "my-realm", "foo", "group-id").Return(nil)
+
+		assert.NoError(t, adapter.Remove(ctx, []string{"foo@email"}))
+		assert.Equal(t, map[string]string{"bar@email": "bar"}, adapter.cache)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+		adapter.cache = map[string]string{"foo@email": "foo"}
+
+		client.EXPECT().RemoveUserFromGroup(ctx, "my-realm", "foo", "group-id").Return(errKeycloak)
+
+		assert.ErrorIs(t, adapter.Remove(ctx, []string{"foo@email"}), errKeycloak)
+	})
+
+	t.Run("Cache not built", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, _ := createMockedAdapter(t)
+
+		assert.ErrorIs(t, adapter.Remove(ctx, []string{"foo@email"}), gosync.ErrCacheEmpty)
+	})
+}
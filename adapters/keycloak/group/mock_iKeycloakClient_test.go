@@ -0,0 +1,215 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package group
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockIKeycloakClient is an autogenerated mock type for the iKeycloakClient type
+type mockIKeycloakClient struct {
+	mock.Mock
+}
+
+type mockIKeycloakClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockIKeycloakClient) EXPECT() *mockIKeycloakClient_Expecter {
+	return &mockIKeycloakClient_Expecter{mock: &_m.Mock}
+}
+
+// AddUserToGroup provides a mock function with given fields: ctx, realm, userID, groupID
+func (_m *mockIKeycloakClient) AddUserToGroup(ctx context.Context, realm string, userID string, groupID string) error {
+	ret := _m.Called(ctx, realm, userID, groupID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, realm, userID, groupID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockIKeycloakClient_AddUserToGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddUserToGroup'
+type mockIKeycloakClient_AddUserToGroup_Call struct {
+	*mock.Call
+}
+
+// AddUserToGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - realm string
+//   - userID string
+//   - groupID string
+func (_e *mockIKeycloakClient_Expecter) AddUserToGroup(ctx interface{}, realm interface{}, userID interface{}, groupID interface{}) *mockIKeycloakClient_AddUserToGroup_Call {
+	return &mockIKeycloakClient_AddUserToGroup_Call{Call: _e.mock.On("AddUserToGroup", ctx, realm, userID, groupID)}
+}
+
+func (_c *mockIKeycloakClient_AddUserToGroup_Call) Run(run func(ctx context.Context, realm string, userID string, groupID string)) *mockIKeycloakClient_AddUserToGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *mockIKeycloakClient_AddUserToGroup_Call) Return(_a0 error) *mockIKeycloakClient_AddUserToGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// FindUserByEmail provides a mock function with given fields: ctx, realm, email
+func (_m *mockIKeycloakClient) FindUserByEmail(ctx context.Context, realm string, email string) (*user, error) {
+	ret := _m.Called(ctx, realm, email)
+
+	var r0 *user
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *user); ok {
+		r0 = rf(ctx, realm, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*user)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, realm, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockIKeycloakClient_FindUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserByEmail'
+type mockIKeycloakClient_FindUserByEmail_Call struct {
+	*mock.Call
+}
+
+// FindUserByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - realm string
+//   - email string
+func (_e *mockIKeycloakClient_Expecter) FindUserByEmail(ctx interface{}, realm interface{}, email interface{}) *mockIKeycloakClient_FindUserByEmail_Call {
+	return &mockIKeycloakClient_FindUserByEmail_Call{Call: _e.mock.On("FindUserByEmail", ctx, realm, email)}
+}
+
+func (_c *mockIKeycloakClient_FindUserByEmail_Call) Run(run func(ctx context.Context, realm string, email string)) *mockIKeycloakClient_FindUserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockIKeycloakClient_FindUserByEmail_Call) Return(_a0 *user, _a1 error) *mockIKeycloakClient_FindUserByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetGroupMembers provides a mock function with given fields: ctx, realm, groupID, first, max
+func (_m *mockIKeycloakClient) GetGroupMembers(ctx context.Context, realm string, groupID string, first int, max int) ([]user, error) {
+	ret := _m.Called(ctx, realm, groupID, first, max)
+
+	var r0 []user
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, int) []user); ok {
+		r0 = rf(ctx, realm, groupID, first, max)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, int) error); ok {
+		r1 = rf(ctx, realm, groupID, first, max)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockIKeycloakClient_GetGroupMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupMembers'
+type mockIKeycloakClient_GetGroupMembers_Call struct {
+	*mock.Call
+}
+
+// GetGroupMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - realm string
+//   - groupID string
+//   - first int
+//   - max int
+func (_e *mockIKeycloakClient_Expecter) GetGroupMembers(ctx interface{}, realm interface{}, groupID interface{}, first interface{}, max interface{}) *mockIKeycloakClient_GetGroupMembers_Call {
+	return &mockIKeycloakClient_GetGroupMembers_Call{Call: _e.mock.On("GetGroupMembers", ctx, realm, groupID, first, max)}
+}
+
+func (_c *mockIKeycloakClient_GetGroupMembers_Call) Run(run func(ctx context.Context, realm string, groupID string, first int, max int)) *mockIKeycloakClient_GetGroupMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *mockIKeycloakClient_GetGroupMembers_Call) Return(_a0 []user, _a1 error) *mockIKeycloakClient_GetGroupMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// RemoveUserFromGroup provides a mock function with given fields: ctx, realm, userID, groupID
+func (_m *mockIKeycloakClient) RemoveUserFromGroup(ctx context.Context, realm string, userID string, groupID string) error {
+	ret := _m.Called(ctx, realm, userID, groupID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, realm, userID, groupID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockIKeycloakClient_RemoveUserFromGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUserFromGroup'
+type mockIKeycloakClient_RemoveUserFromGroup_Call struct {
+	*mock.Call
+}
+
+// RemoveUserFromGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - realm string
+//   - userID string
+//   - groupID string
+func (_e *mockIKeycloakClient_Expecter) RemoveUserFromGroup(ctx interface{}, realm interface{}, userID interface{}, groupID interface{}) *mockIKeycloakClient_RemoveUserFromGroup_Call {
+	return &mockIKeycloakClient_RemoveUserFromGroup_Call{Call: _e.mock.On("RemoveUserFromGroup", ctx, realm, userID, groupID)}
+}
+
+func (_c *mockIKeycloakClient_RemoveUserFromGroup_Call) Run(run func(ctx context.Context, realm string, userID string, groupID string)) *mockIKeycloakClient_RemoveUserFromGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *mockIKeycloakClient_RemoveUserFromGroup_Call) Return(_a0 error) *mockIKeycloakClient_RemoveUserFromGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+type mockConstructorTestingTnewMockIKeycloakClient interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// newMockIKeycloakClient creates a new instance of mockIKeycloakClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func newMockIKeycloakClient(t mockConstructorTestingTnewMockIKeycloakClient) *mockIKeycloakClient {
+	mock := &mockIKeycloakClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,227 @@
+package group
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrMalformedPacket is returned when the directory sends a response that can't be decoded.
+var ErrMalformedPacket = errors.New("malformed ldap packet")
+
+// maxPacketSize limits the size of a response, so a misbehaving server can't exhaust memory.
+const maxPacketSize = 64 << 20
+
+// BER identifier classes, see X.690.
+const (
+	classApplication byte = 0x40
+	classContext     byte = 0x80
+	constructed      byte = 0x20
+)
+
+// Universal tags used by LDAP.
+const (
+	tagBoolean     byte = 0x01
+	tagInteger     byte = 0x02
+	tagOctetString byte = 0x04
+	tagEnumerated  byte = 0x0a
+	tagSequence    byte = 0x10 | constructed
+	tagSet         byte = 0x11 | constructed
+)
+
+// packet is a BER encoded value. LDAP only uses single byte identifiers, so the identifier holds the class,
+// constructed bit and tag together.
+type packet struct {
+	identifier byte
+	value      []byte   // value of a primitive packet.
+	children   []packet // children of a constructed packet.
+}
+
+// newPrimitive creates a primitive packet.
+func newPrimitive(identifier byte, value []byte) packet {
+	return packet{identifier: identifier, value: value, children: nil}
+}
+
+// newConstructed creates a constructed packet, e.g. a sequence.
+func newConstructed(identifier byte, children ...packet) packet {
+	return packet{identifier: identifier | constructed, value: nil, children: children}
+}
+
+// newString creates an octet string packet.
+func newString(value string) packet {
+	return newPrimitive(tagOctetString, []byte(value))
+}
+
+// newInteger creates an integer packet, or an enumerated packet if identifier is tagEnumerated.
+func newInteger(identifier byte, value int64) packet {
+	encoded := []byte{byte(value)}
+
+	for value > 127 || value < -128 {
+		value >>= 8
+		encoded = append([]byte{byte(value)}, encoded...)
+	}
+
+	return newPrimitive(identifier, encoded)
+}
+
+// newBoolean creates a boolean packet.
+func newBoolean(value bool) packet {
+	if value {
+		return newPrimitive(tagBoolean, []byte{0xff})
+	}
+
+	return newPrimitive(tagBoolean, []byte{0x00})
+}
+
+// isConstructed returns true if the packet contains other packets.
+func (p packet) isConstructed() bool {
+	return p.identifier&constructed != 0
+}
+
+// encode returns the packet's BER encoding, using definite lengths.
+func (p packet) encode() []byte {
+	value := p.value
+
+	if p.isConstructed() {
+		value = nil
+
+		for _, child := range p.children {
+			value = append(value, child.encode()...)
+		}
+	}
+
+	return append(append([]byte{p.identifier}, encodeLength(len(value))...), value...)
+}
+
+// encodeLength returns the BER encoding of a length, in short form if possible.
+func encodeLength(length int) []byte {
+	if length < 0x80 {
+		return []byte{byte(length)}
+	}
+
+	var encoded []byte
+
+	for ; length > 0; length >>= 8 {
+		encoded = append([]byte{byte(length)}, encoded...)
+	}
+
+	return append([]byte{0x80 | byte(len(encoded))}, encoded...)
+}
+
+// integer decodes the value of an integer or enumerated packet.
+func (p packet) integer() (int64, error) {
+	if len(p.value) == 0 || len(p.value) > 8 {
+		return 0, fmt.Errorf("%w: integer of %d bytes", ErrMalformedPacket, len(p.value))
+	}
+
+	// Sign extend from the first byte.
+	out := int64(int8(p.value[0]))
+
+	for _, b := range p.value[1:] {
+		out = out<<8 | int64(b)
+	}
+
+	return out, nil
+}
+
+// child returns the child at index, or an error if the packet is too short.
+func (p packet) child(index int) (packet, error) {
+	if index >= len(p.children) {
+		return packet{}, fmt.Errorf("%w: missing element %d of 0x%02x", ErrMalformedPacket, index, p.identifier)
+	}
+
+	return p.children[index], nil
+}
+
+// readPacket reads a single BER encoded packet. Long form lengths are accepted even when a shorter encoding exists,
+// as Active Directory always uses them.
+func readPacket(reader *bufio.Reader) (packet, error) {
+	identifier, err := reader.ReadByte()
+	if err != nil {
+		return packet{}, err //nolint:wrapcheck
+	}
+
+	length, err := readLength(reader)
+	if err != nil {
+		return packet{}, err
+	}
+
+	value := make([]byte, length)
+	if _, err := io.ReadFull(reader, value); err != nil {
+		return packet{}, fmt.Errorf("read -> %w", unexpectedEOF(err))
+	}
+
+	return decodePacket(identifier, value)
+}
+
+// unexpectedEOF converts io.EOF to io.ErrUnexpectedEOF, for a packet that ends part way through.
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
+
+// readLength reads the length of a packet.
+func readLength(reader *bufio.Reader) (int, error) {
+	first, err := reader.ReadByte()
+	if err != nil {
+		return 0, fmt.Errorf("read -> %w", unexpectedEOF(err))
+	}
+
+	if first < 0x80 {
+		return int(first), nil
+	}
+
+	// Indefinite lengths (0x80) aren't allowed by LDAP.
+	size := int(first & 0x7f)
+	if size == 0 || size > 4 {
+		return 0, fmt.Errorf("%w: length of %d bytes", ErrMalformedPacket, size)
+	}
+
+	length := 0
+
+	for i := 0; i < size; i++ {
+		b, err := reader.ReadByte()
+		if err != nil {
+			return 0, fmt.Errorf("read -> %w", unexpectedEOF(err))
+		}
+
+		length = length<<8 | int(b)
+	}
+
+	if length > maxPacketSize {
+		return 0, fmt.Errorf("%w: length %d exceeds %d", ErrMalformedPacket, length, maxPacketSize)
+	}
+
+	return length, nil
+}
+
+// decodePacket decodes the value of a packet, and any children it contains.
+func decodePacket(identifier byte, value []byte) (packet, error) {
+	out := packet{identifier: identifier, value: nil, children: nil}
+
+	if identifier&constructed == 0 {
+		out.value = value
+
+		return out, nil
+	}
+
+	reader := bufio.NewReader(bytes.NewReader(value))
+
+	for {
+		child, err := readPacket(reader)
+		if errors.Is(err, io.EOF) {
+			return out, nil
+		}
+
+		if err != nil {
+			return packet{}, fmt.Errorf("%w: 0x%02x -> %s", ErrMalformedPacket, identifier, err.Error())
+		}
+
+		out.children = append(out.children, child)
+	}
+}
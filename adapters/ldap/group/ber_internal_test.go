@@ -0,0 +1,96 @@
+package group
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPacket_Encode(t *testing.T) {
+	t.Parallel()
+
+	bind := newConstructed(tagSequence,
+		newInteger(tagInteger, 1),
+		newConstructed(opBindRequest,
+			newInteger(tagInteger, 3),
+			newString("cn=admin"),
+			newPrimitive(classContext, []byte("x")),
+		),
+	)
+
+	assert.Equal(t, []byte{
+		0x30, 0x15, 0x02, 0x01, 0x01,
+		0x60, 0x10, 0x02, 0x01, 0x03,
+		0x04, 0x08, 'c', 'n', '=', 'a', 'd', 'm', 'i', 'n',
+		0x80, 0x01, 'x',
+	}, bind.encode())
+
+	assert.Equal(t, []byte{0x04, 0x81, 0xc8}, newString(strings.Repeat("a", 200)).encode()[:3])
+	assert.Equal(t, []byte{0x04, 0x82, 0x01, 0x2c}, newString(strings.Repeat("a", 300)).encode()[:4])
+
+	for value, expected := range map[int64][]byte{
+		0:    {0x00},
+		127:  {0x7f},
+		128:  {0x00, 0x80},
+		256:  {0x01, 0x00},
+		-1:   {0xff},
+		1000: {0x03, 0xe8},
+	} {
+		encoded := newInteger(tagInteger, value)
+		assert.Equal(t, expected, encoded.value, value)
+
+		decoded, err := encoded.integer()
+		assert.NoError(t, err)
+		assert.Equal(t, value, decoded)
+	}
+}
+
+func TestReadPacket(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Long form lengths", func(t *testing.T) {
+		t.Parallel()
+
+		// Active Directory uses 4 byte lengths, even for short values.
+		reader := bufio.NewReader(bytes.NewReader([]byte{
+			0x30, 0x84, 0x00, 0x00, 0x00, 0x0a,
+			0x02, 0x01, 0x02,
+			0x04, 0x84, 0x00, 0x00, 0x00, 0x01, 'a',
+		}))
+
+		decoded, err := readPacket(reader)
+
+		assert.NoError(t, err)
+		assert.Equal(t, newConstructed(tagSequence, newInteger(tagInteger, 2), newString("a")), decoded)
+	})
+
+	t.Run("Round trip", func(t *testing.T) {
+		t.Parallel()
+
+		original := newConstructed(tagSequence, newString(strings.Repeat("a", 1000)), newConstructed(tagSet))
+
+		decoded, err := readPacket(bufio.NewReader(bytes.NewReader(original.encode())))
+
+		assert.NoError(t, err)
+		assert.Equal(t, original.encode(), decoded.encode())
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		t.Parallel()
+
+		for name, data := range map[string][]byte{
+			"Truncated value":      {0x04, 0x05, 'a'},
+			"Truncated child":      {0x30, 0x03, 0x04, 0x05, 'a'},
+			"Indefinite length":    {0x30, 0x80, 0x00, 0x00},
+			"Oversized length":     {0x04, 0x85, 0x01, 0x00, 0x00, 0x00, 0x00},
+			"Exceeds maximum size": {0x04, 0x84, 0x7f, 0xff, 0xff, 0xff},
+		} {
+			_, err := readPacket(bufio.NewReader(bytes.NewReader(data)))
+
+			assert.Error(t, err, name)
+		}
+	})
+}
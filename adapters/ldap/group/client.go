@@ -0,0 +1,800 @@
+package group
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrUnexpectedResponse is returned when the directory responds with an unexpected result code or operation.
+	ErrUnexpectedResponse = errors.New("unexpected response from ldap")
+	// ErrEntryNotFound is returned when a search doesn't find exactly one entry.
+	ErrEntryNotFound = errors.New("entry not found")
+	// ErrDuplicateEntry is returned when a search for a single entry finds more than one, e.g. two users with the same
+	// email.
+	ErrDuplicateEntry = errors.New("more than one entry found")
+	// ErrInsecureBind is returned when binding with a password over a connection that isn't encrypted.
+	ErrInsecureBind = errors.New("refusing to send password over an unencrypted connection")
+)
+
+// LDAP protocol operations, see RFC 4511.
+const (
+	opBindRequest       = classApplication | constructed | 0
+	opBindResponse      = classApplication | constructed | 1
+	opUnbindRequest     = classApplication | 2
+	opSearchRequest     = classApplication | constructed | 3
+	opSearchResultEntry = classApplication | constructed | 4
+	opSearchResultDone  = classApplication | constructed | 5
+	opModifyRequest     = classApplication | constructed | 6
+	opModifyResponse    = classApplication | constructed | 7
+	opSearchResultRef   = classApplication | constructed | 19
+	opExtendedRequest   = classApplication | constructed | 23
+	opExtendedResponse  = classApplication | constructed | 24
+)
+
+// tagControls is the optional list of controls at the end of an LDAP message.
+const tagControls = classContext | constructed | 0
+
+// Object identifiers of the extended operations and controls used by the client.
+const (
+	oidStartTLS     = "1.3.6.1.4.1.1466.20037" // StartTLS extended operation, see RFC 4511.
+	oidPagedResults = "1.2.840.113556.1.4.319" // Simple paged results control, see RFC 2696.
+)
+
+// searchPageSize is how many entries are requested per page of a paged search.
+const searchPageSize = 500
+
+// Search scopes.
+const (
+	scopeBaseObject   = 0
+	scopeWholeSubtree = 2
+)
+
+// Modify operations.
+const (
+	modifyAdd    = 0
+	modifyDelete = 1
+)
+
+// LDAP result codes handled by the client.
+const (
+	resultSuccess                = 0
+	resultSizeLimitExceeded      = 4
+	resultNoSuchAttribute        = 16
+	resultAttributeOrValueExists = 20
+)
+
+// entry is an entry returned by a search, with its attributes in the order they were returned.
+type entry struct {
+	dn         string
+	attributes []attribute
+}
+
+// attribute is an attribute of an entry, and its values.
+type attribute struct {
+	name   string
+	values []string
+}
+
+// result is the outcome of an LDAP operation.
+type result struct {
+	code    int64
+	message string
+}
+
+// err returns nil if the operation succeeded, or an error including the result code and diagnostic message.
+func (r result) err() error {
+	if r.code == resultSuccess {
+		return nil
+	}
+
+	return fmt.Errorf("%w: result code %d %s", ErrUnexpectedResponse, r.code, r.message)
+}
+
+// Client is a minimal LDAPv3 client, which binds to a directory with a DN and password. The connection is opened on
+// first use, and reopened if it fails.
+//
+// The adapter was meant to be built on github.com/go-ldap/ldap/v3, but it couldn't be fetched when the adapter was
+// written. Client, along with the BER encoding in ber.go, stands in for it until it can be swapped in behind
+// iLDAPClient.
+type Client struct {
+	url       string
+	bindDN    string
+	password  string
+	tlsConfig *tls.Config
+	startTLS  bool // startTLS upgrades ldap:// connections to TLS before binding.
+	dialer    *net.Dialer
+
+	mu        sync.Mutex // mu serialises requests, as responses are read in order.
+	conn      net.Conn
+	reader    *bufio.Reader
+	messageID int64
+}
+
+// NewClient creates a new LDAP client for a directory URL, e.g. ldaps://ldap.example.com or ldap://localhost:389. The
+// client binds as bindDN with password, or anonymously if both are empty. Passwords are only sent over ldap:// to
+// localhost, unless WithStartTLS is used.
+func NewClient(url string, bindDN string, password string) *Client {
+	return &Client{
+		url:       url,
+		bindDN:    bindDN,
+		password:  password,
+		tlsConfig: nil,
+		startTLS:  false,
+		dialer:    &net.Dialer{Timeout: 30 * time.Second}, //nolint:gomnd
+		mu:        sync.Mutex{},
+		conn:      nil,
+		reader:    nil,
+		messageID: 0,
+	}
+}
+
+// WithTLSConfig sets a custom TLS config for ldaps:// URLs and StartTLS, e.g. to trust a private certificate authority.
+func (c *Client) WithTLSConfig(tlsConfig *tls.Config) *Client {
+	c.tlsConfig = tlsConfig
+
+	return c
+}
+
+// WithStartTLS upgrades ldap:// connections to TLS with the StartTLS operation before binding, for directories that
+// don't support ldaps://. Connecting fails if the directory doesn't support StartTLS.
+func (c *Client) WithStartTLS() *Client {
+	c.startTLS = true
+
+	return c
+}
+
+// config returns the TLS config used to connect to hostname.
+func (c *Client) config(hostname string) *tls.Config {
+	if c.tlsConfig == nil {
+		return &tls.Config{MinVersion: tls.VersionTLS12, ServerName: hostname}
+	}
+
+	config := c.tlsConfig.Clone()
+	if config.ServerName == "" {
+		config.ServerName = hostname
+	}
+
+	return config
+}
+
+// isLoopback returns true if hostname is the local machine, so traffic to it never crosses the network.
+func isLoopback(hostname string) bool {
+	if strings.EqualFold(hostname, "localhost") {
+		return true
+	}
+
+	ip := net.ParseIP(hostname)
+
+	return ip != nil && ip.IsLoopback()
+}
+
+// Close unbinds from the directory and closes the connection, if it's open.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		return nil
+	}
+
+	c.messageID++
+	_, _ = c.conn.Write(newConstructed(tagSequence,
+		newInteger(tagInteger, c.messageID),
+		newPrimitive(opUnbindRequest, nil),
+	).encode())
+
+	err := c.conn.Close()
+	c.conn = nil
+
+	if err != nil {
+		return fmt.Errorf("close -> %w", err)
+	}
+
+	return nil
+}
+
+// dial opens a connection to the directory, and binds to it.
+func (c *Client) dial(ctx context.Context) error {
+	parsed, err := url.Parse(c.url)
+	if err != nil {
+		return fmt.Errorf("parse(%s) -> %w", c.url, err)
+	}
+
+	host := parsed.Host
+
+	var conn net.Conn
+
+	switch parsed.Scheme {
+	case "ldaps":
+		if parsed.Port() == "" {
+			host = net.JoinHostPort(parsed.Hostname(), "636")
+		}
+
+		config := c.config(parsed.Hostname())
+		conn, err = (&tls.Dialer{NetDialer: c.dialer, Config: config}).DialContext(ctx, "tcp", host)
+	case "ldap":
+		if parsed.Port() == "" {
+			host = net.JoinHostPort(parsed.Hostname(), "389")
+		}
+
+		if c.password != "" && !c.startTLS && !isLoopback(parsed.Hostname()) {
+			return fmt.Errorf("dial(%s) -> %w: use ldaps:// or WithStartTLS", c.url, ErrInsecureBind)
+		}
+
+		conn, err = c.dialer.DialContext(ctx, "tcp", host)
+	default:
+		return fmt.Errorf("dial(%s) -> %w: unsupported scheme %s", c.url, ErrUnexpectedResponse, parsed.Scheme)
+	}
+
+	if err != nil {
+		return fmt.Errorf("dial(%s) -> %w", host, err)
+	}
+
+	c.conn = conn
+	c.reader = bufio.NewReader(conn)
+
+	if parsed.Scheme == "ldap" && c.startTLS {
+		if err := c.negotiateTLS(ctx, parsed.Hostname()); err != nil {
+			return fmt.Errorf("starttls(%s) -> %w", host, err)
+		}
+	}
+
+	responses, _, err := c.roundTrip(ctx, newConstructed(opBindRequest,
+		newInteger(tagInteger, 3), //nolint:gomnd
+		newString(c.bindDN),
+		newPrimitive(classContext, []byte(c.password)),
+	), opBindResponse)
+	if err != nil {
+		return fmt.Errorf("bind(%s) -> %w", c.bindDN, err)
+	}
+
+	res, err := parseResult(responses[0])
+	if err != nil {
+		return fmt.Errorf("bind(%s) -> %w", c.bindDN, err)
+	}
+
+	if err := res.err(); err != nil {
+		return fmt.Errorf("bind(%s) -> %w", c.bindDN, err)
+	}
+
+	return nil
+}
+
+// negotiateTLS upgrades the connection to TLS with the StartTLS extended operation.
+func (c *Client) negotiateTLS(ctx context.Context, hostname string) error {
+	responses, _, err := c.roundTrip(ctx,
+		newConstructed(opExtendedRequest, newPrimitive(classContext, []byte(oidStartTLS))),
+		opExtendedResponse,
+	)
+	if err != nil {
+		return err
+	}
+
+	res, err := parseResult(responses[0])
+	if err != nil {
+		return err
+	}
+
+	if err := res.err(); err != nil {
+		return err
+	}
+
+	conn := tls.Client(c.conn, c.config(hostname))
+	if err := conn.HandshakeContext(ctx); err != nil {
+		return fmt.Errorf("handshake -> %w", contextError(ctx, err))
+	}
+
+	c.conn = conn
+	c.reader = bufio.NewReader(conn)
+
+	return nil
+}
+
+// request sends an operation and any controls to the directory, connecting first if needed, and returns the
+// responses up to and including the final one, and the controls of the final response. If the connection fails, it's
+// closed so the next request reconnects.
+func (c *Client) request(ctx context.Context, op packet, final byte, controls ...packet) ([]packet, packet, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		if err := c.dial(ctx); err != nil {
+			c.reset()
+
+			return nil, packet{}, err
+		}
+	}
+
+	responses, responseControls, err := c.roundTrip(ctx, op, final, controls...)
+	if err != nil {
+		c.reset()
+
+		return nil, packet{}, err
+	}
+
+	return responses, responseControls, nil
+}
+
+// reset closes a failed connection.
+func (c *Client) reset() {
+	if c.conn != nil {
+		_ = c.conn.Close()
+	}
+
+	c.conn = nil
+	c.reader = nil
+}
+
+// roundTrip writes an operation and any controls to the connection, and reads responses until the final one, whose
+// controls are returned too. The context's deadline and cancellation interrupt the connection.
+func (c *Client) roundTrip(ctx context.Context, op packet, final byte, controls ...packet) ([]packet, packet, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, packet{}, fmt.Errorf("roundtrip -> %w", err)
+	}
+
+	// Clear any deadline left by a previous request.
+	deadline, _ := ctx.Deadline()
+	_ = c.conn.SetDeadline(deadline)
+
+	var (
+		done = make(chan struct{})
+		wg   sync.WaitGroup
+	)
+
+	// Wait for the goroutine to exit, so it can't interrupt a later request.
+	defer func() {
+		close(done)
+		wg.Wait()
+	}()
+
+	wg.Add(1)
+
+	go func(conn net.Conn) {
+		defer wg.Done()
+
+		select {
+		case <-ctx.Done():
+			_ = conn.SetDeadline(time.Now())
+		case <-done:
+		}
+	}(c.conn)
+
+	c.messageID++
+
+	message := newConstructed(tagSequence, newInteger(tagInteger, c.messageID), op)
+	if len(controls) > 0 {
+		message.children = append(message.children, newConstructed(tagControls, controls...))
+	}
+
+	if _, err := c.conn.Write(message.encode()); err != nil {
+		return nil, packet{}, fmt.Errorf("write -> %w", contextError(ctx, err))
+	}
+
+	var responses []packet
+
+	for {
+		response, err := readPacket(c.reader)
+		if err != nil {
+			return nil, packet{}, fmt.Errorf("read -> %w", contextError(ctx, err))
+		}
+
+		id, err := response.child(0)
+		if err != nil {
+			return nil, packet{}, err
+		}
+
+		responseOp, err := response.child(1)
+		if err != nil {
+			return nil, packet{}, err
+		}
+
+		// Message ID 0 is an unsolicited notification, e.g. that the server is disconnecting.
+		if messageID, err := id.integer(); err != nil || messageID != c.messageID {
+			return nil, packet{}, fmt.Errorf("%w: message 0x%02x for another request", ErrUnexpectedResponse,
+				responseOp.identifier)
+		}
+
+		responses = append(responses, responseOp)
+
+		if responseOp.identifier == final {
+			responseControls, _ := response.child(2)
+
+			return responses, responseControls, nil
+		}
+	}
+}
+
+// contextError returns the context's error if it caused a network error, as that's more useful than a timeout.
+func contextError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+
+	// The connection's deadline can pass just before the context's.
+	var netErr net.Error
+	if deadline, ok := ctx.Deadline(); ok && errors.As(err, &netErr) && netErr.Timeout() && !time.Now().Before(deadline) {
+		return context.DeadlineExceeded
+	}
+
+	return err
+}
+
+// parseResult parses the result of an operation.
+func parseResult(op packet) (result, error) {
+	code, err := op.child(0)
+	if err != nil {
+		return result{}, err
+	}
+
+	value, err := code.integer()
+	if err != nil {
+		return result{}, err
+	}
+
+	var message string
+	if diagnostic, err := op.child(2); err == nil {
+		message = string(diagnostic.value)
+	}
+
+	return result{code: value, message: message}, nil
+}
+
+// search searches the directory, and returns the entries found, the result of the search and the controls returned
+// with it.
+func (c *Client) search(
+	ctx context.Context,
+	baseDN string,
+	scope int64,
+	sizeLimit int64,
+	filter packet,
+	attributes []string,
+	controls ...packet,
+) ([]entry, result, packet, error) {
+	requested := make([]packet, 0, len(attributes))
+	for _, name := range attributes {
+		requested = append(requested, newString(name))
+	}
+
+	responses, responseControls, err := c.request(ctx, newConstructed(opSearchRequest,
+		newString(baseDN),
+		newInteger(tagEnumerated, scope),
+		newInteger(tagEnumerated, 0), // Never dereference aliases.
+		newInteger(tagInteger, sizeLimit),
+		newInteger(tagInteger, 0), // No time limit, as the context can be used instead.
+		newBoolean(false),
+		filter,
+		newConstructed(tagSequence, requested...),
+	), opSearchResultDone, controls...)
+	if err != nil {
+		return nil, result{}, packet{}, err
+	}
+
+	entries := make([]entry, 0, len(responses)-1)
+
+	for _, response := range responses {
+		switch response.identifier {
+		case opSearchResultEntry:
+			parsed, err := parseEntry(response)
+			if err != nil {
+				return nil, result{}, packet{}, err
+			}
+
+			entries = append(entries, parsed)
+		case opSearchResultRef:
+			// Referrals to other directories aren't followed.
+			continue
+		case opSearchResultDone:
+			res, err := parseResult(response)
+
+			return entries, res, responseControls, err
+		default:
+			return nil, result{}, packet{}, fmt.Errorf("%w: operation 0x%02x", ErrUnexpectedResponse, response.identifier)
+		}
+	}
+
+	return entries, result{}, responseControls, nil
+}
+
+// pagedResultsControl requests a page of up to size entries, starting after cookie, which is empty for the first page.
+func pagedResultsControl(size int64, cookie []byte) packet {
+	value := newConstructed(tagSequence, newInteger(tagInteger, size), newPrimitive(tagOctetString, cookie))
+
+	return newConstructed(tagSequence, newString(oidPagedResults), newPrimitive(tagOctetString, value.encode()))
+}
+
+// pagedResultsCookie returns the cookie of the paged results control in a search's controls, which is empty once the
+// last page has been returned. Directories that don't support paging return no control, and every entry at once.
+func pagedResultsCookie(controls packet) ([]byte, error) {
+	for _, control := range controls.children {
+		oid, err := control.child(0)
+		if err != nil {
+			return nil, err
+		}
+
+		if string(oid.value) != oidPagedResults {
+			continue
+		}
+
+		// The control's value follows its optional criticality.
+		encoded := control.children[len(control.children)-1]
+		if encoded.identifier != tagOctetString {
+			return nil, fmt.Errorf("%w: paged results control without a value", ErrMalformedPacket)
+		}
+
+		value, err := readPacket(bufio.NewReader(bytes.NewReader(encoded.value)))
+		if err != nil {
+			return nil, fmt.Errorf("%w: paged results control -> %s", ErrMalformedPacket, err.Error())
+		}
+
+		cookie, err := value.child(1)
+		if err != nil {
+			return nil, err
+		}
+
+		return cookie.value, nil
+	}
+
+	return nil, nil
+}
+
+// searchPaged searches the subtree under baseDN a page at a time, using the paged results control, and returns all
+// the entries found.
+func (c *Client) searchPaged(ctx context.Context, baseDN string, filter packet, attributes []string) ([]entry, error) {
+	var (
+		entries []entry
+		cookie  []byte
+	)
+
+	for {
+		page, res, controls, err := c.search(ctx, baseDN, scopeWholeSubtree, 0, filter, attributes,
+			pagedResultsControl(searchPageSize, cookie))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := res.err(); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, page...)
+
+		cookie, err = pagedResultsCookie(controls)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(cookie) == 0 {
+			return entries, nil
+		}
+	}
+}
+
+// parseEntry parses a search result entry.
+func parseEntry(op packet) (entry, error) {
+	dn, err := op.child(0)
+	if err != nil {
+		return entry{}, err
+	}
+
+	attributes, err := op.child(1)
+	if err != nil {
+		return entry{}, err
+	}
+
+	out := entry{dn: string(dn.value), attributes: make([]attribute, 0, len(attributes.children))}
+
+	for _, partial := range attributes.children {
+		name, err := partial.child(0)
+		if err != nil {
+			return entry{}, err
+		}
+
+		values, err := partial.child(1)
+		if err != nil {
+			return entry{}, err
+		}
+
+		parsed := attribute{name: string(name.value), values: make([]string, 0, len(values.children))}
+		for _, value := range values.children {
+			parsed.values = append(parsed.values, string(value.value))
+		}
+
+		out.attributes = append(out.attributes, parsed)
+	}
+
+	return out, nil
+}
+
+// presentFilter matches entries with the attribute, e.g. (objectClass=*).
+func presentFilter(attribute string) packet {
+	return newPrimitive(classContext|7, []byte(attribute)) //nolint:gomnd
+}
+
+// equalityFilter matches entries where the attribute equals the value, e.g. (mail=john.doe@example.com).
+func equalityFilter(attribute string, value string) packet {
+	return newConstructed(classContext|3, newString(attribute), newString(value)) //nolint:gomnd
+}
+
+// splitRange splits an attribute name returned by range retrieval, e.g. member;range=0-1499, into the attribute and
+// the end of the range. The end is empty if the name doesn't have a range.
+func splitRange(name string) (string, string) {
+	index := strings.Index(strings.ToLower(name), ";range=")
+	if index == -1 {
+		return name, ""
+	}
+
+	_, end, _ := strings.Cut(name[index+len(";range="):], "-")
+
+	return name[:index], end
+}
+
+// GetAttribute gets the values of an attribute of the entry with the given DN. Large attributes, e.g. the members of a
+// big Active Directory group, are fetched a range of values at a time until all have been returned.
+func (c *Client) GetAttribute(ctx context.Context, dn string, name string) ([]string, error) {
+	var (
+		values    []string
+		requested = name
+	)
+
+	for {
+		entries, res, _, err := c.search(ctx, dn, scopeBaseObject, 0, presentFilter("objectClass"), []string{requested})
+		if err != nil {
+			return nil, fmt.Errorf("search(%s, %s) -> %w", dn, requested, err)
+		}
+
+		if err := res.err(); err != nil {
+			return nil, fmt.Errorf("search(%s, %s) -> %w", dn, requested, err)
+		}
+
+		if len(entries) != 1 {
+			return nil, fmt.Errorf("search(%s, %s) -> %w", dn, requested, ErrEntryNotFound)
+		}
+
+		next := ""
+
+		for _, attr := range entries[0].attributes {
+			base, end := splitRange(attr.name)
+			if !strings.EqualFold(base, name) {
+				continue
+			}
+
+			values = append(values, attr.values...)
+
+			// The final range ends with *, otherwise request the next one.
+			if end != "" && end != "*" {
+				last, err := strconv.Atoi(end)
+				if err != nil {
+					return nil, fmt.Errorf("search(%s, %s) -> %w: range %s", dn, requested, ErrMalformedPacket, attr.name)
+				}
+
+				next = fmt.Sprintf("%s;range=%d-*", name, last+1)
+			}
+		}
+
+		if next == "" {
+			return values, nil
+		}
+
+		requested = next
+	}
+}
+
+// FindDN finds the DN of the single entry under baseDN where the attribute equals the value.
+func (c *Client) FindDN(ctx context.Context, baseDN string, name string, value string) (string, error) {
+	// A size limit of 2 is enough to tell whether there's more than one entry. 1.1 requests no attributes.
+	entries, res, _, err := c.search(ctx, baseDN, scopeWholeSubtree, 2, equalityFilter(name, value), []string{"1.1"})
+	if err != nil {
+		return "", fmt.Errorf("search(%s, %s=%s) -> %w", baseDN, name, value, err)
+	}
+
+	if res.code == resultSizeLimitExceeded || len(entries) > 1 {
+		return "", fmt.Errorf("search(%s, %s=%s) -> %w", baseDN, name, value, ErrDuplicateEntry)
+	}
+
+	if err := res.err(); err != nil {
+		return "", fmt.Errorf("search(%s, %s=%s) -> %w", baseDN, name, value, err)
+	}
+
+	if len(entries) == 0 {
+		return "", fmt.Errorf("search(%s, %s=%s) -> %w", baseDN, name, value, ErrEntryNotFound)
+	}
+
+	return entries[0].dn, nil
+}
+
+// SearchAttribute searches under baseDN for entries where the attribute name equals value, e.g. users whose memberOf
+// is a group's DN, and returns the first value of attribute for each by DN, or an empty string if they don't have it.
+// The results are read a page at a time, so any number of entries can be returned.
+func (c *Client) SearchAttribute(
+	ctx context.Context,
+	baseDN string,
+	name string,
+	value string,
+	attribute string,
+) (map[string]string, error) {
+	entries, err := c.searchPaged(ctx, baseDN, equalityFilter(name, value), []string{attribute})
+	if err != nil {
+		return nil, fmt.Errorf("search(%s, %s=%s) -> %w", baseDN, name, value, err)
+	}
+
+	out := make(map[string]string, len(entries))
+
+	for _, found := range entries {
+		out[found.dn] = ""
+
+		for _, attr := range found.attributes {
+			if strings.EqualFold(attr.name, attribute) && len(attr.values) > 0 {
+				out[found.dn] = attr.values[0]
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// modify adds or deletes a value of an attribute of the entry with the given DN.
+func (c *Client) modify(ctx context.Context, dn string, operation int64, name string, value string) (result, error) {
+	responses, _, err := c.request(ctx, newConstructed(opModifyRequest,
+		newString(dn),
+		newConstructed(tagSequence,
+			newConstructed(tagSequence,
+				newInteger(tagEnumerated, operation),
+				newConstructed(tagSequence, newString(name), newConstructed(tagSet, newString(value))),
+			),
+		),
+	), opModifyResponse)
+	if err != nil {
+		return result{}, err
+	}
+
+	return parseResult(responses[0])
+}
+
+// AddAttributeValue adds a value to an attribute of the entry with the given DN. Adding a value that's already present
+// succeeds.
+func (c *Client) AddAttributeValue(ctx context.Context, dn string, name string, value string) error {
+	res, err := c.modify(ctx, dn, modifyAdd, name, value)
+	if err != nil {
+		return fmt.Errorf("modify(%s, add %s) -> %w", dn, name, err)
+	}
+
+	if res.code == resultAttributeOrValueExists {
+		return nil
+	}
+
+	if err := res.err(); err != nil {
+		return fmt.Errorf("modify(%s, add %s) -> %w", dn, name, err)
+	}
+
+	return nil
+}
+
+// DeleteAttributeValue deletes a value from an attribute of the entry with the given DN. Deleting a value that isn't
+// present succeeds.
+func (c *Client) DeleteAttributeValue(ctx context.Context, dn string, name string, value string) error {
+	res, err := c.modify(ctx, dn, modifyDelete, name, value)
+	if err != nil {
+		return fmt.Errorf("modify(%s, delete %s) -> %w", dn, name, err)
+	}
+
+	if res.code == resultNoSuchAttribute {
+		return nil
+	}
+
+	if err := res.err(); err != nil {
+		return fmt.Errorf("modify(%s, delete %s) -> %w", dn, name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,469 @@
+package group
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ldapResult creates the result of an operation.
+func ldapResult(op byte, code int64, message string) packet {
+	return newConstructed(op, newInteger(tagEnumerated, code), newString(""), newString(message))
+}
+
+// ldapEntry creates a search result entry with a single attribute.
+func ldapEntry(dn string, name string, values ...string) packet {
+	vals := make([]packet, 0, len(values))
+	for _, value := range values {
+		vals = append(vals, newString(value))
+	}
+
+	return newConstructed(opSearchResultEntry,
+		newString(dn),
+		newConstructed(tagSequence, newConstructed(tagSequence, newString(name), newConstructed(tagSet, vals...))),
+	)
+}
+
+// withControls attaches controls to a response from the fake directory.
+func withControls(op packet, controls ...packet) packet {
+	return newConstructed(tagSequence, op, newConstructed(tagControls, controls...))
+}
+
+// startServer starts a fake directory, which passes each request's operation to handler and responds with the
+// operations it returns. Binds are accepted if the password is "secret".
+func startServer(t *testing.T, handler func(op packet) []packet) string {
+	t.Helper()
+
+	return startServerWith(t, nil, func(op packet, _ packet) []packet { return handler(op) })
+}
+
+// startServerWith starts a fake directory like startServer, which also passes each request's controls to handler.
+// If config is set, the directory supports StartTLS, and only accepts binds once it's been used.
+func startServerWith(t *testing.T, config *tls.Config, handler func(op packet, controls packet) []packet) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go serve(conn, config, handler)
+		}
+	}()
+
+	return "ldap://" + listener.Addr().String()
+}
+
+// serve handles the requests on a connection to the fake directory.
+func serve(conn net.Conn, config *tls.Config, handler func(op packet, controls packet) []packet) {
+	defer func() { _ = conn.Close() }()
+
+	reader := bufio.NewReader(conn)
+	secure := config == nil
+
+	for {
+		message, err := readPacket(reader)
+		if err != nil {
+			return
+		}
+
+		var (
+			responses []packet
+			controls  packet
+			upgrade   bool
+		)
+
+		if len(message.children) > 2 {
+			controls = message.children[2]
+		}
+
+		switch op := message.children[1]; op.identifier {
+		case opUnbindRequest:
+			return
+		case opBindRequest:
+			code := int64(49) // Invalid credentials.
+			if !secure {
+				code = 13 // Confidentiality required.
+			} else if string(op.children[2].value) == "secret" {
+				code = resultSuccess
+			}
+
+			responses = []packet{ldapResult(opBindResponse, code, "")}
+		case opExtendedRequest:
+			code := int64(2) // Protocol error, as no extended operations are supported.
+			if config != nil && !secure && string(op.children[0].value) == oidStartTLS {
+				code, upgrade = resultSuccess, true
+			}
+
+			responses = []packet{ldapResult(opExtendedResponse, code, "")}
+		default:
+			responses = handler(op, controls)
+		}
+
+		for _, response := range responses {
+			children := []packet{message.children[0], response}
+			if response.identifier == tagSequence {
+				children = append([]packet{message.children[0]}, response.children...)
+			}
+
+			_, _ = conn.Write(newConstructed(tagSequence, children...).encode())
+		}
+
+		if upgrade {
+			conn = tls.Server(conn, config)
+			reader = bufio.NewReader(conn)
+			secure = true
+		}
+	}
+}
+
+// testCertificate creates a self-signed certificate for 127.0.0.1, and returns TLS configs for a server using it and
+// a client trusting it.
+func testCertificate(t *testing.T) (*tls.Config, *tls.Config) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(certificate)
+
+	server := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+	}
+
+	return server, &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: pool}
+}
+
+// pagedResultsRequest decodes the size and cookie of the paged results control in a request's controls.
+func pagedResultsRequest(t *testing.T, controls packet) (int64, string) {
+	t.Helper()
+
+	assert.Len(t, controls.children, 1)
+	assert.Equal(t, oidPagedResults, string(controls.children[0].children[0].value))
+
+	value, err := readPacket(bufio.NewReader(bytes.NewReader(controls.children[0].children[1].value)))
+	assert.NoError(t, err)
+
+	size, err := value.children[0].integer()
+	assert.NoError(t, err)
+
+	return size, string(value.children[1].value)
+}
+
+//nolint:funlen
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("GetAttribute", func(t *testing.T) {
+		t.Parallel()
+
+		var requested []string
+
+		url := startServer(t, func(op packet) []packet {
+			assert.Equal(t, byte(opSearchRequest), op.identifier)
+			assert.Equal(t, "cn=group", string(op.children[0].value))
+
+			name := string(op.children[7].children[0].value)
+			requested = append(requested, name)
+
+			// Active Directory returns large attributes a range of values at a time.
+			switch name {
+			case "member":
+				return []packet{
+					ldapEntry("cn=group", "member;range=0-1", "uid=foo", "uid=bar"),
+					ldapResult(opSearchResultDone, resultSuccess, ""),
+				}
+			case "member;range=2-*":
+				return []packet{
+					ldapEntry("cn=group", "member;range=2-*", "uid=baz"),
+					ldapResult(opSearchResultDone, resultSuccess, ""),
+				}
+			default:
+				return []packet{ldapResult(opSearchResultDone, 32, "no such object")} //nolint:gomnd
+			}
+		})
+
+		client := NewClient(url, "cn=admin", "secret")
+		defer client.Close()
+
+		values, err := client.GetAttribute(ctx, "cn=group", "member")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"uid=foo", "uid=bar", "uid=baz"}, values)
+		assert.Equal(t, []string{"member", "member;range=2-*"}, requested)
+
+		_, err = client.GetAttribute(ctx, "cn=group", "mail")
+		assert.ErrorIs(t, err, ErrUnexpectedResponse)
+		assert.ErrorContains(t, err, "no such object")
+	})
+
+	t.Run("FindDN", func(t *testing.T) {
+		t.Parallel()
+
+		url := startServer(t, func(op packet) []packet {
+			filter := op.children[6]
+			assert.Equal(t, classContext|constructed|3, filter.identifier)
+			assert.Equal(t, "mail", string(filter.children[0].value))
+
+			switch string(filter.children[1].value) {
+			case "foo@email":
+				return []packet{
+					ldapEntry("uid=foo,dc=example", "mail", "foo@email"),
+					ldapResult(opSearchResultDone, resultSuccess, ""),
+				}
+			case "duplicate@email":
+				return []packet{
+					ldapEntry("uid=one,dc=example", "mail", "duplicate@email"),
+					ldapEntry("uid=two,dc=example", "mail", "duplicate@email"),
+					ldapResult(opSearchResultDone, resultSizeLimitExceeded, ""),
+				}
+			default:
+				return []packet{ldapResult(opSearchResultDone, resultSuccess, "")}
+			}
+		})
+
+		client := NewClient(url, "cn=admin", "secret")
+		defer client.Close()
+
+		dn, err := client.FindDN(ctx, "dc=example", "mail", "foo@email")
+		assert.NoError(t, err)
+		assert.Equal(t, "uid=foo,dc=example", dn)
+
+		_, err = client.FindDN(ctx, "dc=example", "mail", "duplicate@email")
+		assert.ErrorIs(t, err, ErrDuplicateEntry)
+
+		_, err = client.FindDN(ctx, "dc=example", "mail", "bar@email")
+		assert.ErrorIs(t, err, ErrEntryNotFound)
+	})
+
+	t.Run("SearchAttribute", func(t *testing.T) {
+		t.Parallel()
+
+		var cookies []string
+
+		url := startServerWith(t, nil, func(op packet, controls packet) []packet {
+			assert.Equal(t, byte(opSearchRequest), op.identifier)
+			assert.Equal(t, "dc=example", string(op.children[0].value))
+			assert.Equal(t, "memberOf", string(op.children[6].children[0].value))
+			assert.Equal(t, "cn=group", string(op.children[6].children[1].value))
+
+			size, cookie := pagedResultsRequest(t, controls)
+			assert.Equal(t, int64(searchPageSize), size)
+
+			cookies = append(cookies, cookie)
+
+			// Each page ends with a cookie for the next one, which is empty on the last page.
+			next := ""
+			entries := []packet{ldapEntry("uid=bar,dc=example", "mail", "bar@email"), ldapEntry("cn=svc,dc=example", "cn")}
+
+			if cookie == "" {
+				next = "page-2"
+				entries = []packet{ldapEntry("uid=foo,dc=example", "mail", "foo@email")}
+			}
+
+			return append(entries,
+				withControls(ldapResult(opSearchResultDone, resultSuccess, ""), pagedResultsControl(0, []byte(next))),
+			)
+		})
+
+		client := NewClient(url, "cn=admin", "secret")
+		defer client.Close()
+
+		mails, err := client.SearchAttribute(ctx, "dc=example", "memberOf", "cn=group", "mail")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"uid=foo,dc=example": "foo@email",
+			"uid=bar,dc=example": "bar@email",
+			"cn=svc,dc=example":  "",
+		}, mails)
+		assert.Equal(t, []string{"", "page-2"}, cookies)
+	})
+
+	t.Run("SearchAttribute without paging", func(t *testing.T) {
+		t.Parallel()
+
+		// Directories that don't support paging return every entry, without a control.
+		url := startServer(t, func(op packet) []packet {
+			return []packet{
+				ldapEntry("uid=foo,dc=example", "mail", "foo@email"),
+				ldapResult(opSearchResultDone, resultSuccess, ""),
+			}
+		})
+
+		client := NewClient(url, "cn=admin", "secret")
+		defer client.Close()
+
+		mails, err := client.SearchAttribute(ctx, "dc=example", "memberOf", "cn=group", "mail")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"uid=foo,dc=example": "foo@email"}, mails)
+	})
+
+	t.Run("StartTLS", func(t *testing.T) {
+		t.Parallel()
+
+		serverConfig, clientConfig := testCertificate(t)
+
+		url := startServerWith(t, serverConfig, func(op packet, _ packet) []packet {
+			return []packet{
+				ldapEntry("cn=group", "member", "uid=foo"),
+				ldapResult(opSearchResultDone, resultSuccess, ""),
+			}
+		})
+
+		client := NewClient(url, "cn=admin", "secret").WithTLSConfig(clientConfig).WithStartTLS()
+		defer client.Close()
+
+		values, err := client.GetAttribute(ctx, "cn=group", "member")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"uid=foo"}, values)
+
+		// The directory refuses to bind over the unencrypted connection.
+		_, err = NewClient(url, "cn=admin", "secret").GetAttribute(ctx, "cn=group", "member")
+		assert.ErrorContains(t, err, "result code 13")
+
+		// An untrusted certificate fails the handshake.
+		_, err = NewClient(url, "cn=admin", "secret").WithStartTLS().GetAttribute(ctx, "cn=group", "member")
+		assert.ErrorContains(t, err, "handshake")
+	})
+
+	t.Run("StartTLS unsupported", func(t *testing.T) {
+		t.Parallel()
+
+		url := startServer(t, func(op packet) []packet {
+			t.Error("request sent without StartTLS")
+
+			return nil
+		})
+
+		_, err := NewClient(url, "cn=admin", "secret").WithStartTLS().GetAttribute(ctx, "cn=group", "member")
+
+		assert.ErrorIs(t, err, ErrUnexpectedResponse)
+		assert.ErrorContains(t, err, "starttls")
+	})
+
+	t.Run("Insecure bind", func(t *testing.T) {
+		t.Parallel()
+
+		// Passwords aren't sent in the clear to other hosts, so the client doesn't connect.
+		_, err := NewClient("ldap://ldap.example.com", "cn=admin", "secret").GetAttribute(ctx, "cn=group", "member")
+
+		assert.ErrorIs(t, err, ErrInsecureBind)
+	})
+
+	t.Run("Modify", func(t *testing.T) {
+		t.Parallel()
+
+		url := startServer(t, func(op packet) []packet {
+			assert.Equal(t, byte(opModifyRequest), op.identifier)
+			assert.Equal(t, "cn=group", string(op.children[0].value))
+
+			change := op.children[1].children[0]
+			operation, _ := change.children[0].integer()
+			value := string(change.children[1].children[1].children[0].value)
+
+			switch {
+			case value == "uid=existing" && operation == modifyAdd:
+				return []packet{ldapResult(opModifyResponse, resultAttributeOrValueExists, "")}
+			case value == "uid=missing" && operation == modifyDelete:
+				return []packet{ldapResult(opModifyResponse, resultNoSuchAttribute, "")}
+			case value == "uid=forbidden":
+				return []packet{ldapResult(opModifyResponse, 50, "insufficient access")} //nolint:gomnd
+			default:
+				return []packet{ldapResult(opModifyResponse, resultSuccess, "")}
+			}
+		})
+
+		client := NewClient(url, "cn=admin", "secret")
+		defer client.Close()
+
+		assert.NoError(t, client.AddAttributeValue(ctx, "cn=group", "member", "uid=foo"))
+		assert.NoError(t, client.AddAttributeValue(ctx, "cn=group", "member", "uid=existing"))
+		assert.NoError(t, client.DeleteAttributeValue(ctx, "cn=group", "member", "uid=foo"))
+		assert.NoError(t, client.DeleteAttributeValue(ctx, "cn=group", "member", "uid=missing"))
+		assert.ErrorIs(t, client.AddAttributeValue(ctx, "cn=group", "member", "uid=forbidden"), ErrUnexpectedResponse)
+		assert.ErrorIs(t, client.DeleteAttributeValue(ctx, "cn=group", "member", "uid=forbidden"), ErrUnexpectedResponse)
+	})
+
+	t.Run("Invalid credentials", func(t *testing.T) {
+		t.Parallel()
+
+		url := startServer(t, func(op packet) []packet {
+			t.Error("request sent without binding")
+
+			return nil
+		})
+
+		_, err := NewClient(url, "cn=admin", "wrong").GetAttribute(ctx, "cn=group", "member")
+
+		assert.ErrorIs(t, err, ErrUnexpectedResponse)
+		assert.ErrorContains(t, err, "result code 49")
+	})
+
+	t.Run("Context", func(t *testing.T) {
+		t.Parallel()
+
+		// The directory never responds to searches.
+		url := startServer(t, func(op packet) []packet { return nil })
+
+		client := NewClient(url, "cn=admin", "secret")
+		defer client.Close()
+
+		timeout, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		_, err := client.GetAttribute(timeout, "cn=group", "member")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		cancelled, cancel := context.WithCancel(ctx)
+		time.AfterFunc(50*time.Millisecond, cancel)
+
+		_, err = client.GetAttribute(cancelled, "cn=group", "member")
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("Unsupported scheme", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewClient("http://ldap.example.com", "", "").GetAttribute(ctx, "cn=group", "member")
+
+		assert.ErrorIs(t, err, ErrUnexpectedResponse)
+	})
+}
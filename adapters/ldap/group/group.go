@@ -0,0 +1,284 @@
+/*
+Package group synchronises email addresses with an LDAP group, e.g. in Active Directory or OpenLDAP.
+
+In order to use this adapter, you'll need to bind as a user that can read the group and its members, and modify the
+group's member attribute.
+*/
+package group
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	gosync "github.com/ovotech/go-sync"
+)
+
+// Ensure the adapter type fully satisfies the ports.Adapter and ports.ConfiguredAdapter interfaces.
+var (
+	_ gosync.Adapter           = &Group{}
+	_ gosync.ConfiguredAdapter = &Group{}
+)
+
+// ErrMissingEmail is returned when a member of the group has no email, e.g. a service account or nested group.
+var ErrMissingEmail = errors.New("member has no email")
+
+// iLDAPClient is a subset of the LDAP Client, and used to build mocks for easy testing.
+type iLDAPClient interface {
+	GetAttribute(ctx context.Context, dn string, name string) ([]string, error)
+	SearchAttribute(
+		ctx context.Context, baseDN string, name string, value string, attribute string,
+	) (map[string]string, error)
+	FindDN(ctx context.Context, baseDN string, name string, value string) (string, error)
+	AddAttributeValue(ctx context.Context, dn string, name string, value string) error
+	DeleteAttributeValue(ctx context.Context, dn string, name string, value string) error
+}
+
+type Group struct {
+	client          iLDAPClient
+	groupDN         string
+	memberAttribute string // memberAttribute lists the DNs of the group's members.
+	mailAttribute   string // mailAttribute is the email of a user.
+	userBaseDN      string // userBaseDN is searched to find the DN of a user added to the group.
+	// memberOfAttribute lists the DNs of a user's groups. If set, Get searches for the group's members with it.
+	memberOfAttribute string
+	// cache stores the email -> DN mapping for use with the Remove method.
+	cache  map[string]string
+	logger *log.Logger
+}
+
+// WithMemberAttribute sets the attribute of the group that lists its members' DNs. Default is member.
+func WithMemberAttribute(name string) func(*Group) {
+	return func(group *Group) {
+		group.memberAttribute = name
+	}
+}
+
+// WithMailAttribute sets the attribute that holds a user's email, e.g. userPrincipalName. Default is mail.
+func WithMailAttribute(name string) func(*Group) {
+	return func(group *Group) {
+		group.mailAttribute = name
+	}
+}
+
+// WithMemberOfAttribute makes Get search userBaseDN for users whose attribute, e.g. memberOf, includes the group DN,
+// rather than reading the group's member attribute and then each member. Results are read a page at a time, and
+// include each user's email, so large groups are fetched in a few requests. Default is to read the member attribute.
+func WithMemberOfAttribute(name string) func(*Group) {
+	return func(group *Group) {
+		group.memberOfAttribute = name
+	}
+}
+
+// WithUserBaseDN sets the DN searched to find users added to the group, e.g. ou=people,dc=example,dc=com. Default is
+// the domain of the group DN, e.g. dc=example,dc=com.
+func WithUserBaseDN(dn string) func(*Group) {
+	return func(group *Group) {
+		group.userBaseDN = dn
+	}
+}
+
+// WithLogger sets a custom logger.
+func WithLogger(logger *log.Logger) func(*Group) {
+	return func(group *Group) {
+		group.logger = logger
+	}
+}
+
+// New instantiates a new LDAP group adapter, for the group with the given DN, e.g. cn=engineering,ou=groups,dc=example,
+// dc=com.
+func New(client *Client, groupDN string, optsFn ...func(*Group)) *Group {
+	group := &Group{
+		client:            client,
+		groupDN:           groupDN,
+		memberAttribute:   "member",
+		mailAttribute:     "mail",
+		userBaseDN:        domainDN(groupDN),
+		memberOfAttribute: "",
+		cache:             nil,
+		logger:            log.New(os.Stderr, "[go-sync/ldap/group] ", log.LstdFlags|log.Lshortfile|log.Lmsgprefix),
+	}
+
+	for _, fn := range optsFn {
+		fn(group)
+	}
+
+	return group
+}
+
+// domainDN returns the domain components at the end of a DN, e.g. dc=example,dc=com for
+// cn=engineering,ou=groups,dc=example,dc=com.
+func domainDN(dn string) string {
+	parts := strings.Split(dn, ",")
+
+	for index, part := range parts {
+		if strings.HasPrefix(strings.ToLower(strings.TrimSpace(part)), "dc=") {
+			return strings.Join(parts[index:], ",")
+		}
+	}
+
+	return dn
+}
+
+// Config returns the adapter's configuration, with the password redacted.
+func (g *Group) Config() map[string]string {
+	config := map[string]string{
+		"group":             g.groupDN,
+		"memberAttribute":   g.memberAttribute,
+		"mailAttribute":     g.mailAttribute,
+		"userBaseDN":        g.userBaseDN,
+		"memberOfAttribute": g.memberOfAttribute,
+	}
+
+	if client, ok := g.client.(*Client); ok {
+		config["url"] = client.url
+		config["bindDN"] = client.bindDN
+		config["password"] = gosync.Redacted
+	}
+
+	return config
+}
+
+// Get emails of the members of an LDAP group.
+func (g *Group) Get(ctx context.Context) ([]string, error) {
+	g.logger.Printf("Fetching accounts from LDAP group %s", g.groupDN)
+
+	members, err := g.getMembers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialise the cache.
+	cache := make(map[string]string, len(members))
+	emails := make([]string, 0, len(members))
+
+	for _, member := range members {
+		if member.mail == "" {
+			return nil, fmt.Errorf("ldap.group.get(%s, %s) -> %w", member.dn, g.mailAttribute, ErrMissingEmail)
+		}
+
+		emails = append(emails, member.mail)
+
+		// Add the email -> DN map for use with the Remove method.
+		cache[member.mail] = member.dn
+	}
+
+	g.cache = cache
+
+	g.logger.Println("Fetched accounts successfully")
+
+	return emails, nil
+}
+
+// member is a member of the group, and their email, which is empty if they don't have one.
+type member struct {
+	dn   string
+	mail string
+}
+
+// getMembers gets the DNs and emails of the group's members.
+func (g *Group) getMembers(ctx context.Context) ([]member, error) {
+	if g.memberOfAttribute != "" {
+		mails, err := g.client.SearchAttribute(ctx, g.userBaseDN, g.memberOfAttribute, g.groupDN, g.mailAttribute)
+		if err != nil {
+			return nil, fmt.Errorf("ldap.group.get.searchattribute(%s, %s) -> %w", g.userBaseDN, g.memberOfAttribute, err)
+		}
+
+		members := make([]member, 0, len(mails))
+		for dn, mail := range mails {
+			members = append(members, member{dn: dn, mail: mail})
+		}
+
+		// Sort the members, so emails are returned in the same order each time.
+		sort.Slice(members, func(i, j int) bool { return members[i].dn < members[j].dn })
+
+		return members, nil
+	}
+
+	dns, err := g.client.GetAttribute(ctx, g.groupDN, g.memberAttribute)
+	if err != nil {
+		return nil, fmt.Errorf("ldap.group.get.getattribute(%s, %s) -> %w", g.groupDN, g.memberAttribute, err)
+	}
+
+	members := make([]member, 0, len(dns))
+
+	for _, dn := range dns {
+		mail, err := g.client.GetAttribute(ctx, dn, g.mailAttribute)
+		if err != nil {
+			return nil, fmt.Errorf("ldap.group.get.getattribute(%s, %s) -> %w", dn, g.mailAttribute, err)
+		}
+
+		found := member{dn: dn, mail: ""}
+		if len(mail) > 0 {
+			found.mail = mail[0]
+		}
+
+		members = append(members, found)
+	}
+
+	return members, nil
+}
+
+// Add emails to an LDAP group, by finding the DN of the user with each email.
+func (g *Group) Add(ctx context.Context, emails []string) error {
+	g.logger.Printf("Adding %s to LDAP group %s", emails, g.groupDN)
+
+	for index, email := range emails {
+		dn, err := g.client.FindDN(ctx, g.userBaseDN, g.mailAttribute, email)
+		if err != nil {
+			return fmt.Errorf("ldap.group.add.finddn(%s) -> %w", email, err)
+		}
+
+		if err := g.client.AddAttributeValue(ctx, g.groupDN, g.memberAttribute, dn); err != nil {
+			return fmt.Errorf("ldap.group.add.addattributevalue(%s, %s) -> %w", g.groupDN, dn, err)
+		}
+
+		if g.cache != nil {
+			g.cache[email] = dn
+		}
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
+	}
+
+	g.logger.Println("Finished adding accounts successfully")
+
+	return nil
+}
+
+// Remove emails from an LDAP group.
+func (g *Group) Remove(ctx context.Context, emails []string) error {
+	g.logger.Printf("Removing %s from LDAP group %s", emails, g.groupDN)
+
+	if g.cache == nil {
+		return fmt.Errorf("ldap.group.remove -> %w", gosync.ErrCacheEmpty)
+	}
+
+	for index, email := range emails {
+		// Emails that weren't in the group when Get was called are looked up instead.
+		dn, ok := g.cache[email]
+		if !ok {
+			var err error
+
+			dn, err = g.client.FindDN(ctx, g.userBaseDN, g.mailAttribute, email)
+			if err != nil {
+				return fmt.Errorf("ldap.group.remove.finddn(%s) -> %w", email, err)
+			}
+		}
+
+		if err := g.client.DeleteAttributeValue(ctx, g.groupDN, g.memberAttribute, dn); err != nil {
+			return fmt.Errorf("ldap.group.remove.deleteattributevalue(%s, %s) -> %w", g.groupDN, dn, err)
+		}
+
+		delete(g.cache, email)
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
+	}
+
+	g.logger.Println("Finished removing accounts successfully")
+
+	return nil
+}
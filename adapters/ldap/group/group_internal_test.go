@@ -0,0 +1,214 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gosync "github.com/ovotech/go-sync"
+	"github.com/stretchr/testify/assert"
+)
+
+var errLDAP = errors.New("an example error")
+
+const groupDN = "cn=engineering,ou=groups,dc=example,dc=com"
+
+func createMockedAdapter(t *testing.T, optsFn ...func(*Group)) (*Group, *mockILDAPClient) {
+	t.Helper()
+
+	client := newMockILDAPClient(t)
+	adapter := New(NewClient("ldaps://ldap.example.com", "cn=admin,dc=example,dc=com", "secret"), groupDN, optsFn...)
+	adapter.client = client
+
+	return adapter, client
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	adapter := New(NewClient("ldaps://ldap.example.com", "cn=admin,dc=example,dc=com", "secret"), groupDN)
+
+	assert.Equal(t, groupDN, adapter.groupDN)
+	assert.Equal(t, "member", adapter.memberAttribute)
+	assert.Equal(t, "mail", adapter.mailAttribute)
+	assert.Equal(t, "dc=example,dc=com", adapter.userBaseDN)
+	assert.Equal(t, map[string]string{
+		"group":             groupDN,
+		"memberAttribute":   "member",
+		"mailAttribute":     "mail",
+		"userBaseDN":        "dc=example,dc=com",
+		"memberOfAttribute": "",
+		"url":               "ldaps://ldap.example.com",
+		"bindDN":            "cn=admin,dc=example,dc=com",
+		"password":          gosync.Redacted,
+	}, adapter.Config())
+
+	adapter = New(NewClient("ldaps://ldap.example.com", "", ""), "cn=engineering",
+		WithMemberAttribute("uniqueMember"),
+		WithMailAttribute("userPrincipalName"),
+		WithUserBaseDN("ou=people,dc=example,dc=com"),
+		WithMemberOfAttribute("memberOf"),
+	)
+
+	assert.Equal(t, "uniqueMember", adapter.memberAttribute)
+	assert.Equal(t, "userPrincipalName", adapter.mailAttribute)
+	assert.Equal(t, "ou=people,dc=example,dc=com", adapter.userBaseDN)
+	assert.Equal(t, "memberOf", adapter.memberOfAttribute)
+	assert.Equal(t, "cn=engineering", domainDN("cn=engineering"))
+}
+
+func TestGroup_Get(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+
+		client.EXPECT().GetAttribute(ctx, groupDN, "member").Return([]string{"uid=foo", "uid=bar"}, nil)
+		client.EXPECT().GetAttribute(ctx, "uid=foo", "mail").Return([]string{"foo@email"}, nil)
+		client.EXPECT().GetAttribute(ctx, "uid=bar", "mail").Return([]string{"bar@email"}, nil)
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email", "bar@email"}, emails)
+		assert.Equal(t, map[string]string{"foo@email": "uid=foo", "bar@email": "uid=bar"}, adapter.cache)
+	})
+
+	t.Run("Missing email", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t, WithMailAttribute("userPrincipalName"))
+
+		client.EXPECT().GetAttribute(ctx, groupDN, "member").Return([]string{"cn=svc-deploy"}, nil)
+		client.EXPECT().GetAttribute(ctx, "cn=svc-deploy", "userPrincipalName").Return(nil, nil)
+
+		emails, err := adapter.Get(ctx)
+
+		assert.ErrorIs(t, err, ErrMissingEmail)
+		assert.ErrorContains(t, err, "cn=svc-deploy")
+		assert.Nil(t, emails)
+		assert.Nil(t, adapter.cache)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+
+		client.EXPECT().GetAttribute(ctx, groupDN, "member").Return(nil, errLDAP)
+
+		_, err := adapter.Get(ctx)
+
+		assert.ErrorIs(t, err, errLDAP)
+	})
+
+	t.Run("Member of", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t, WithMemberOfAttribute("memberOf"))
+
+		client.EXPECT().SearchAttribute(ctx, "dc=example,dc=com", "memberOf", groupDN, "mail").
+			Return(map[string]string{"uid=foo": "foo@email", "uid=bar": "bar@email"}, nil).Once()
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"bar@email", "foo@email"}, emails)
+		assert.Equal(t, map[string]string{"foo@email": "uid=foo", "bar@email": "uid=bar"}, adapter.cache)
+
+		client.EXPECT().SearchAttribute(ctx, "dc=example,dc=com", "memberOf", groupDN, "mail").
+			Return(map[string]string{"cn=svc-deploy": ""}, nil).Once()
+
+		_, err = adapter.Get(ctx)
+
+		assert.ErrorIs(t, err, ErrMissingEmail)
+
+		client.EXPECT().SearchAttribute(ctx, "dc=example,dc=com", "memberOf", groupDN, "mail").Return(nil, errLDAP).Once()
+
+		_, err = adapter.Get(ctx)
+
+		assert.ErrorIs(t, err, errLDAP)
+	})
+}
+
+func TestGroup_Add(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+
+		client.EXPECT().FindDN(ctx, "dc=example,dc=com", "mail", "foo@email").Return("uid=foo", nil)
+		client.EXPECT().FindDN(ctx, "dc=example,dc=com", "mail", "bar@email").Return("uid=bar", nil)
+		client.EXPECT().AddAttributeValue(ctx, groupDN, "member", "uid=foo").Return(nil)
+		client.EXPECT().AddAttributeValue(ctx, groupDN, "member", "uid=bar").Return(nil)
+
+		err := adapter.Add(ctx, []string{"foo@email", "bar@email"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("User not found", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+
+		client.EXPECT().FindDN(ctx, "dc=example,dc=com", "mail", "foo@email").Return("", ErrEntryNotFound)
+
+		err := adapter.Add(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, ErrEntryNotFound)
+	})
+}
+
+func TestGroup_Remove(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+		adapter.cache = map[string]string{"foo@email": "uid=foo"}
+
+		client.EXPECT().DeleteAttributeValue(ctx, groupDN, "member", "uid=foo").Return(nil)
+		client.EXPECT().FindDN(ctx, "dc=example,dc=com", "mail", "bar@email").Return("uid=bar", nil)
+		client.EXPECT().DeleteAttributeValue(ctx, groupDN, "member", "uid=bar").Return(nil)
+
+		err := adapter.Remove(ctx, []string{"foo@email", "bar@email"})
+
+		assert.NoError(t, err)
+		assert.Empty(t, adapter.cache)
+	})
+
+	t.Run("Empty cache", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, _ := createMockedAdapter(t)
+
+		err := adapter.Remove(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, gosync.ErrCacheEmpty)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+		adapter.cache = map[string]string{"foo@email": "uid=foo"}
+
+		client.EXPECT().DeleteAttributeValue(ctx, groupDN, "member", "uid=foo").Return(errLDAP)
+
+		err := adapter.Remove(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, errLDAP)
+	})
+}
@@ -0,0 +1,262 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package group
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockILDAPClient is an autogenerated mock type for the iLDAPClient type
+type mockILDAPClient struct {
+	mock.Mock
+}
+
+type mockILDAPClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockILDAPClient) EXPECT() *mockILDAPClient_Expecter {
+	return &mockILDAPClient_Expecter{mock: &_m.Mock}
+}
+
+// AddAttributeValue provides a mock function with given fields: ctx, dn, name, value
+func (_m *mockILDAPClient) AddAttributeValue(ctx context.Context, dn string, name string, value string) error {
+	ret := _m.Called(ctx, dn, name, value)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, dn, name, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockILDAPClient_AddAttributeValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAttributeValue'
+type mockILDAPClient_AddAttributeValue_Call struct {
+	*mock.Call
+}
+
+// AddAttributeValue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dn string
+//   - name string
+//   - value string
+func (_e *mockILDAPClient_Expecter) AddAttributeValue(ctx interface{}, dn interface{}, name interface{}, value interface{}) *mockILDAPClient_AddAttributeValue_Call {
+	return &mockILDAPClient_AddAttributeValue_Call{Call: _e.mock.On("AddAttributeValue", ctx, dn, name, value)}
+}
+
+func (_c *mockILDAPClient_AddAttributeValue_Call) Run(run func(ctx context.Context, dn string, name string, value string)) *mockILDAPClient_AddAttributeValue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *mockILDAPClient_AddAttributeValue_Call) Return(_a0 error) *mockILDAPClient_AddAttributeValue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// DeleteAttributeValue provides a mock function with given fields: ctx, dn, name, value
+func (_m *mockILDAPClient) DeleteAttributeValue(ctx context.Context, dn string, name string, value string) error {
+	ret := _m.Called(ctx, dn, name, value)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, dn, name, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockILDAPClient_DeleteAttributeValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAttributeValue'
+type mockILDAPClient_DeleteAttributeValue_Call struct {
+	*mock.Call
+}
+
+// DeleteAttributeValue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dn string
+//   - name string
+//   - value string
+func (_e *mockILDAPClient_Expecter) DeleteAttributeValue(ctx interface{}, dn interface{}, name interface{}, value interface{}) *mockILDAPClient_DeleteAttributeValue_Call {
+	return &mockILDAPClient_DeleteAttributeValue_Call{Call: _e.mock.On("DeleteAttributeValue", ctx, dn, name, value)}
+}
+
+func (_c *mockILDAPClient_DeleteAttributeValue_Call) Run(run func(ctx context.Context, dn string, name string, value string)) *mockILDAPClient_DeleteAttributeValue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *mockILDAPClient_DeleteAttributeValue_Call) Return(_a0 error) *mockILDAPClient_DeleteAttributeValue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// FindDN provides a mock function with given fields: ctx, baseDN, name, value
+func (_m *mockILDAPClient) FindDN(ctx context.Context, baseDN string, name string, value string) (string, error) {
+	ret := _m.Called(ctx, baseDN, name, value)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = rf(ctx, baseDN, name, value)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, baseDN, name, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockILDAPClient_FindDN_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDN'
+type mockILDAPClient_FindDN_Call struct {
+	*mock.Call
+}
+
+// FindDN is a helper method to define mock.On call
+//   - ctx context.Context
+//   - baseDN string
+//   - name string
+//   - value string
+func (_e *mockILDAPClient_Expecter) FindDN(ctx interface{}, baseDN interface{}, name interface{}, value interface{}) *mockILDAPClient_FindDN_Call {
+	return &mockILDAPClient_FindDN_Call{Call: _e.mock.On("FindDN", ctx, baseDN, name, value)}
+}
+
+func (_c *mockILDAPClient_FindDN_Call) Run(run func(ctx context.Context, baseDN string, name string, value string)) *mockILDAPClient_FindDN_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *mockILDAPClient_FindDN_Call) Return(_a0 string, _a1 error) *mockILDAPClient_FindDN_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetAttribute provides a mock function with given fields: ctx, dn, name
+func (_m *mockILDAPClient) GetAttribute(ctx context.Context, dn string, name string) ([]string, error) {
+	ret := _m.Called(ctx, dn, name)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []string); ok {
+		r0 = rf(ctx, dn, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, dn, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockILDAPClient_GetAttribute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttribute'
+type mockILDAPClient_GetAttribute_Call struct {
+	*mock.Call
+}
+
+// GetAttribute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dn string
+//   - name string
+func (_e *mockILDAPClient_Expecter) GetAttribute(ctx interface{}, dn interface{}, name interface{}) *mockILDAPClient_GetAttribute_Call {
+	return &mockILDAPClient_GetAttribute_Call{Call: _e.mock.On("GetAttribute", ctx, dn, name)}
+}
+
+func (_c *mockILDAPClient_GetAttribute_Call) Run(run func(ctx context.Context, dn string, name string)) *mockILDAPClient_GetAttribute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockILDAPClient_GetAttribute_Call) Return(_a0 []string, _a1 error) *mockILDAPClient_GetAttribute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// SearchAttribute provides a mock function with given fields: ctx, baseDN, name, value, attribute
+func (_m *mockILDAPClient) SearchAttribute(ctx context.Context, baseDN string, name string, value string, attribute string) (map[string]string, error) {
+	ret := _m.Called(ctx, baseDN, name, value, attribute)
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) map[string]string); ok {
+		r0 = rf(ctx, baseDN, name, value, attribute)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, baseDN, name, value, attribute)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockILDAPClient_SearchAttribute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAttribute'
+type mockILDAPClient_SearchAttribute_Call struct {
+	*mock.Call
+}
+
+// SearchAttribute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - baseDN string
+//   - name string
+//   - value string
+//   - attribute string
+func (_e *mockILDAPClient_Expecter) SearchAttribute(ctx interface{}, baseDN interface{}, name interface{}, value interface{}, attribute interface{}) *mockILDAPClient_SearchAttribute_Call {
+	return &mockILDAPClient_SearchAttribute_Call{Call: _e.mock.On("SearchAttribute", ctx, baseDN, name, value, attribute)}
+}
+
+func (_c *mockILDAPClient_SearchAttribute_Call) Run(run func(ctx context.Context, baseDN string, name string, value string, attribute string)) *mockILDAPClient_SearchAttribute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *mockILDAPClient_SearchAttribute_Call) Return(_a0 map[string]string, _a1 error) *mockILDAPClient_SearchAttribute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+type mockConstructorTestingTnewMockILDAPClient interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// newMockILDAPClient creates a new instance of mockILDAPClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func newMockILDAPClient(t mockConstructorTestingTnewMockILDAPClient) *mockILDAPClient {
+	mock := &mockILDAPClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
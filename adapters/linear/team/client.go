@@ -0,0 +1,198 @@
+package team
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	gosync "github.com/ovotech/go-sync"
+)
+
+// ErrUnexpectedResponse is returned when the Linear API responds with an unexpected status code or errors.
+var ErrUnexpectedResponse = errors.New("unexpected response from linear")
+
+const endpoint = "https://api.linear.app/graphql"
+
+// membership is a user's membership of a Linear team.
+type membership struct {
+	ID   string `json:"id"` // ID of the membership.
+	User struct {
+		ID    string `json:"id"`
+		Email string `json:"email"`
+	} `json:"user"`
+}
+
+// graphQLError is an error returned by the Linear GraphQL API.
+type graphQLError struct {
+	Message    string `json:"message"`
+	Extensions struct {
+		Type string `json:"type"`
+	} `json:"extensions"`
+}
+
+// Client is a minimal Linear GraphQL API client, authenticated with an API key.
+type Client struct {
+	httpClient *http.Client
+	endpoint   string
+	apiKey     string
+}
+
+// NewClient creates a new Linear client, authenticated with a personal API key or OAuth token.
+func NewClient(apiKey string) *Client {
+	return &Client{
+		httpClient: http.DefaultClient,
+		endpoint:   endpoint,
+		apiKey:     apiKey,
+	}
+}
+
+// WithHTTPClient sets a custom HTTP client, e.g. to configure timeouts or proxies.
+func (c *Client) WithHTTPClient(httpClient *http.Client) *Client {
+	c.httpClient = httpClient
+
+	return c
+}
+
+// do runs a GraphQL query, and decodes the data into out. A forbidden response means the token is read-only, so
+// gosync.ErrReadOnly is returned.
+func (c *Client) do(ctx context.Context, query string, variables map[string]interface{}, out interface{}) error {
+	body, err := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
+	if err != nil {
+		return fmt.Errorf("marshal -> %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("newrequest -> %w", err)
+	}
+
+	req.Header.Set("Authorization", c.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("do -> %w", err)
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode == http.StatusForbidden:
+		return fmt.Errorf("do -> %w", gosync.ErrReadOnly)
+	case res.StatusCode < 200 || res.StatusCode > 299:
+		return fmt.Errorf("do -> %w: %s", ErrUnexpectedResponse, res.Status)
+	}
+
+	var response struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []graphQLError  `json:"errors"`
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return fmt.Errorf("decode -> %w", err)
+	}
+
+	if len(response.Errors) > 0 {
+		if strings.EqualFold(response.Errors[0].Extensions.Type, "forbidden") {
+			return fmt.Errorf("do(%s) -> %w", response.Errors[0].Message, gosync.ErrReadOnly)
+		}
+
+		return fmt.Errorf("do(%s) -> %w", response.Errors[0].Message, ErrUnexpectedResponse)
+	}
+
+	if err := json.Unmarshal(response.Data, out); err != nil {
+		return fmt.Errorf("unmarshal -> %w", err)
+	}
+
+	return nil
+}
+
+// GetTeamMemberships gets a page of memberships for a team, and the cursor for the next page if there is one.
+func (c *Client) GetTeamMemberships(ctx context.Context, teamID string, after string) ([]membership, string, error) {
+	const query = `query($teamId: String!, $after: String) {
+  team(id: $teamId) {
+    memberships(first: 100, after: $after) {
+      nodes { id user { id email } }
+      pageInfo { hasNextPage endCursor }
+    }
+  }
+}`
+
+	variables := map[string]interface{}{"teamId": teamID}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var data struct {
+		Team struct {
+			Memberships struct {
+				Nodes    []membership `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"memberships"`
+		} `json:"team"`
+	}
+
+	if err := c.do(ctx, query, variables, &data); err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if data.Team.Memberships.PageInfo.HasNextPage {
+		next = data.Team.Memberships.PageInfo.EndCursor
+	}
+
+	return data.Team.Memberships.Nodes, next, nil
+}
+
+// GetUserIDByEmail gets the ID of a user by their email, or an empty string if they don't exist.
+func (c *Client) GetUserIDByEmail(ctx context.Context, email string) (string, error) {
+	const query = `query($email: String!) {
+  users(filter: { email: { eq: $email } }) { nodes { id } }
+}`
+
+	var data struct {
+		Users struct {
+			Nodes []struct {
+				ID string `json:"id"`
+			} `json:"nodes"`
+		} `json:"users"`
+	}
+
+	if err := c.do(ctx, query, map[string]interface{}{"email": email}, &data); err != nil {
+		return "", err
+	}
+
+	if len(data.Users.Nodes) == 0 {
+		return "", nil
+	}
+
+	return data.Users.Nodes[0].ID, nil
+}
+
+// AddTeamMember adds a user to a team.
+func (c *Client) AddTeamMember(ctx context.Context, teamID string, userID string) error {
+	const query = `mutation($teamId: String!, $userId: String!) {
+  teamMembershipCreate(input: { teamId: $teamId, userId: $userId }) { success }
+}`
+
+	var data struct{}
+
+	return c.do(ctx, query, map[string]interface{}{"teamId": teamID, "userId": userID}, &data)
+}
+
+// RemoveTeamMember removes a user from a team, by deleting their membership.
+func (c *Client) RemoveTeamMember(ctx context.Context, membershipID string) error {
+	const query = `mutation($id: String!) {
+  teamMembershipDelete(id: $id) { success }
+}`
+
+	var data struct{}
+
+	return c.do(ctx, query, map[string]interface{}{"id": membershipID}, &data)
+}
@@ -0,0 +1,133 @@
+package team
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gosync "github.com/ovotech/go-sync"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestClient creates a client for a test server, which responds with the body returned by respond.
+func newTestClient(
+	t *testing.T,
+	status int,
+	respond func(variables map[string]interface{}) string,
+) (*Client, func()) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+
+		assert.Equal(t, "api-key", r.Header.Get("Authorization"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(respond(request.Variables)))
+	}))
+
+	client := NewClient("api-key")
+	client.endpoint = server.URL
+
+	return client, server.Close
+}
+
+//nolint:funlen
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("GetTeamMemberships", func(t *testing.T) {
+		t.Parallel()
+
+		client, closeFn := newTestClient(t, http.StatusOK, func(variables map[string]interface{}) string {
+			assert.Equal(t, "team-id", variables["teamId"])
+
+			if variables["after"] == nil {
+				return `{"data":{"team":{"memberships":{
+					"nodes":[{"id":"membership-foo","user":{"id":"user-foo","email":"foo@email"}}],
+					"pageInfo":{"hasNextPage":true,"endCursor":"page-2"}}}}}`
+			}
+
+			return `{"data":{"team":{"memberships":{"nodes":[],"pageInfo":{"hasNextPage":false,"endCursor":"x"}}}}}`
+		})
+		defer closeFn()
+
+		memberships, next, err := client.GetTeamMemberships(ctx, "team-id", "")
+		assert.NoError(t, err)
+		assert.Equal(t, []membership{newMembership("membership-foo", "user-foo", "foo@email")}, memberships)
+		assert.Equal(t, "page-2", next)
+
+		memberships, next, err = client.GetTeamMemberships(ctx, "team-id", next)
+		assert.NoError(t, err)
+		assert.Empty(t, memberships)
+		assert.Empty(t, next)
+	})
+
+	t.Run("GetUserIDByEmail", func(t *testing.T) {
+		t.Parallel()
+
+		client, closeFn := newTestClient(t, http.StatusOK, func(variables map[string]interface{}) string {
+			if variables["email"] == "foo@email" {
+				return `{"data":{"users":{"nodes":[{"id":"user-foo"}]}}}`
+			}
+
+			return `{"data":{"users":{"nodes":[]}}}`
+		})
+		defer closeFn()
+
+		id, err := client.GetUserIDByEmail(ctx, "foo@email")
+		assert.NoError(t, err)
+		assert.Equal(t, "user-foo", id)
+
+		id, err = client.GetUserIDByEmail(ctx, "bar@email")
+		assert.NoError(t, err)
+		assert.Empty(t, id)
+	})
+
+	t.Run("AddTeamMember/RemoveTeamMember", func(t *testing.T) {
+		t.Parallel()
+
+		client, closeFn := newTestClient(t, http.StatusOK, func(variables map[string]interface{}) string {
+			if variables["id"] != nil {
+				assert.Equal(t, "membership-foo", variables["id"])
+
+				return `{"data":{"teamMembershipDelete":{"success":true}}}`
+			}
+
+			assert.Equal(t, map[string]interface{}{"teamId": "team-id", "userId": "user-foo"}, variables)
+
+			return `{"data":{"teamMembershipCreate":{"success":true}}}`
+		})
+		defer closeFn()
+
+		assert.NoError(t, client.AddTeamMember(ctx, "team-id", "user-foo"))
+		assert.NoError(t, client.RemoveTeamMember(ctx, "membership-foo"))
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+
+		forbidden, closeForbidden := newTestClient(t, http.StatusOK, func(map[string]interface{}) string {
+			return `{"errors":[{"message":"not allowed","extensions":{"type":"forbidden"}}]}`
+		})
+		defer closeForbidden()
+
+		assert.ErrorIs(t, forbidden.RemoveTeamMember(ctx, "membership-foo"), gosync.ErrReadOnly)
+
+		failed, closeFailed := newTestClient(t, http.StatusBadRequest, func(map[string]interface{}) string {
+			return `{}`
+		})
+		defer closeFailed()
+
+		_, _, err := failed.GetTeamMemberships(ctx, "team-id", "")
+		assert.ErrorIs(t, err, ErrUnexpectedResponse)
+	})
+}
@@ -0,0 +1,214 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package team
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockILinearClient is an autogenerated mock type for the iLinearClient type
+type mockILinearClient struct {
+	mock.Mock
+}
+
+type mockILinearClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockILinearClient) EXPECT() *mockILinearClient_Expecter {
+	return &mockILinearClient_Expecter{mock: &_m.Mock}
+}
+
+// AddTeamMember provides a mock function with given fields: ctx, teamID, userID
+func (_m *mockILinearClient) AddTeamMember(ctx context.Context, teamID string, userID string) error {
+	ret := _m.Called(ctx, teamID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, teamID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockILinearClient_AddTeamMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTeamMember'
+type mockILinearClient_AddTeamMember_Call struct {
+	*mock.Call
+}
+
+// AddTeamMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamID string
+//   - userID string
+func (_e *mockILinearClient_Expecter) AddTeamMember(ctx interface{}, teamID interface{}, userID interface{}) *mockILinearClient_AddTeamMember_Call {
+	return &mockILinearClient_AddTeamMember_Call{Call: _e.mock.On("AddTeamMember", ctx, teamID, userID)}
+}
+
+func (_c *mockILinearClient_AddTeamMember_Call) Run(run func(ctx context.Context, teamID string, userID string)) *mockILinearClient_AddTeamMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockILinearClient_AddTeamMember_Call) Return(_a0 error) *mockILinearClient_AddTeamMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// GetTeamMemberships provides a mock function with given fields: ctx, teamID, after
+func (_m *mockILinearClient) GetTeamMemberships(ctx context.Context, teamID string, after string) ([]membership, string, error) {
+	ret := _m.Called(ctx, teamID, after)
+
+	var r0 []membership
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []membership); ok {
+		r0 = rf(ctx, teamID, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]membership)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) string); ok {
+		r1 = rf(ctx, teamID, after)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, teamID, after)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// mockILinearClient_GetTeamMemberships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamMemberships'
+type mockILinearClient_GetTeamMemberships_Call struct {
+	*mock.Call
+}
+
+// GetTeamMemberships is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamID string
+//   - after string
+func (_e *mockILinearClient_Expecter) GetTeamMemberships(ctx interface{}, teamID interface{}, after interface{}) *mockILinearClient_GetTeamMemberships_Call {
+	return &mockILinearClient_GetTeamMemberships_Call{Call: _e.mock.On("GetTeamMemberships", ctx, teamID, after)}
+}
+
+func (_c *mockILinearClient_GetTeamMemberships_Call) Run(run func(ctx context.Context, teamID string, after string)) *mockILinearClient_GetTeamMemberships_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockILinearClient_GetTeamMemberships_Call) Return(_a0 []membership, _a1 string, _a2 error) *mockILinearClient_GetTeamMemberships_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+// GetUserIDByEmail provides a mock function with given fields: ctx, email
+func (_m *mockILinearClient) GetUserIDByEmail(ctx context.Context, email string) (string, error) {
+	ret := _m.Called(ctx, email)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockILinearClient_GetUserIDByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIDByEmail'
+type mockILinearClient_GetUserIDByEmail_Call struct {
+	*mock.Call
+}
+
+// GetUserIDByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *mockILinearClient_Expecter) GetUserIDByEmail(ctx interface{}, email interface{}) *mockILinearClient_GetUserIDByEmail_Call {
+	return &mockILinearClient_GetUserIDByEmail_Call{Call: _e.mock.On("GetUserIDByEmail", ctx, email)}
+}
+
+func (_c *mockILinearClient_GetUserIDByEmail_Call) Run(run func(ctx context.Context, email string)) *mockILinearClient_GetUserIDByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockILinearClient_GetUserIDByEmail_Call) Return(_a0 string, _a1 error) *mockILinearClient_GetUserIDByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// RemoveTeamMember provides a mock function with given fields: ctx, membershipID
+func (_m *mockILinearClient) RemoveTeamMember(ctx context.Context, membershipID string) error {
+	ret := _m.Called(ctx, membershipID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, membershipID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockILinearClient_RemoveTeamMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTeamMember'
+type mockILinearClient_RemoveTeamMember_Call struct {
+	*mock.Call
+}
+
+// RemoveTeamMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - membershipID string
+func (_e *mockILinearClient_Expecter) RemoveTeamMember(ctx interface{}, membershipID interface{}) *mockILinearClient_RemoveTeamMember_Call {
+	return &mockILinearClient_RemoveTeamMember_Call{Call: _e.mock.On("RemoveTeamMember", ctx, membershipID)}
+}
+
+func (_c *mockILinearClient_RemoveTeamMember_Call) Run(run func(ctx context.Context, membershipID string)) *mockILinearClient_RemoveTeamMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockILinearClient_RemoveTeamMember_Call) Return(_a0 error) *mockILinearClient_RemoveTeamMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+type mockConstructorTestingTnewMockILinearClient interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// newMockILinearClient creates a new instance of mockILinearClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func newMockILinearClient(t mockConstructorTestingTnewMockILinearClient) *mockILinearClient {
+	mock := &mockILinearClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,175 @@
+/*
+Package team synchronises email addresses with a Linear team.
+
+In order to use this adapter, you'll need a Linear API key for a user that is able to manage the team's members.
+*/
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	gosync "github.com/ovotech/go-sync"
+)
+
+// Ensure the adapter type fully satisfies the ports.Adapter and ports.ConfiguredAdapter interfaces.
+var (
+	_ gosync.Adapter           = &Team{}
+	_ gosync.ConfiguredAdapter = &Team{}
+)
+
+// ErrUserNotFound is returned when an email can't be resolved to a Linear user.
+var ErrUserNotFound = errors.New("user not found")
+
+// iLinearClient is a subset of the Linear Client, and used to build mocks for easy testing.
+type iLinearClient interface {
+	GetTeamMemberships(ctx context.Context, teamID string, after string) ([]membership, string, error)
+	GetUserIDByEmail(ctx context.Context, email string) (string, error)
+	AddTeamMember(ctx context.Context, teamID string, userID string) error
+	RemoveTeamMember(ctx context.Context, membershipID string) error
+}
+
+type Team struct {
+	client iLinearClient
+	teamID string
+	// cache stores the email -> membership ID mapping for use with the Remove method.
+	cache  map[string]string
+	users  map[string]string // users caches the email -> user ID mapping for use with the Add method.
+	logger *log.Logger
+}
+
+// WithLogger sets a custom logger.
+func WithLogger(logger *log.Logger) func(*Team) {
+	return func(team *Team) {
+		team.logger = logger
+	}
+}
+
+// New instantiates a new Linear team adapter, for the team with the given ID.
+func New(client *Client, teamID string, optsFn ...func(*Team)) *Team {
+	team := &Team{
+		client: client,
+		teamID: teamID,
+		cache:  nil,
+		users:  make(map[string]string),
+		logger: log.New(os.Stderr, "[go-sync/linear/team] ", log.LstdFlags|log.Lshortfile|log.Lmsgprefix),
+	}
+
+	for _, fn := range optsFn {
+		fn(team)
+	}
+
+	return team
+}
+
+// Config returns the adapter's configuration, with the API key redacted.
+func (t *Team) Config() map[string]string {
+	return map[string]string{"team": t.teamID, "apiKey": gosync.Redacted}
+}
+
+// Get emails of Linear users in a team.
+func (t *Team) Get(ctx context.Context) ([]string, error) {
+	t.logger.Printf("Fetching accounts from Linear team %s", t.teamID)
+
+	// Initialise the cache.
+	t.cache = make(map[string]string)
+
+	var (
+		cursor string
+		emails = make([]string, 0)
+	)
+
+	for {
+		memberships, next, err := t.client.GetTeamMemberships(ctx, t.teamID, cursor)
+		if err != nil {
+			return nil, fmt.Errorf("linear.team.get.getteammemberships(%s) -> %w", t.teamID, err)
+		}
+
+		for _, membership := range memberships {
+			emails = append(emails, membership.User.Email)
+
+			// Add the email -> ID maps for use with the Add/Remove methods.
+			t.cache[membership.User.Email] = membership.ID
+			t.users[membership.User.Email] = membership.User.ID
+		}
+
+		cursor = next
+
+		if cursor == "" {
+			break
+		}
+	}
+
+	t.logger.Println("Fetched accounts successfully")
+
+	return emails, nil
+}
+
+// getUserID resolves the ID of a user from their email, and caches it for subsequent calls.
+func (t *Team) getUserID(ctx context.Context, email string) (string, error) {
+	if id, ok := t.users[email]; ok {
+		return id, nil
+	}
+
+	id, err := t.client.GetUserIDByEmail(ctx, email)
+	if err != nil {
+		return "", fmt.Errorf("getuseridbyemail(%s) -> %w", email, err)
+	}
+
+	if id == "" {
+		return "", fmt.Errorf("getuseridbyemail(%s) -> %w", email, ErrUserNotFound)
+	}
+
+	t.users[email] = id
+
+	return id, nil
+}
+
+// Add emails to a Linear team.
+func (t *Team) Add(ctx context.Context, emails []string) error {
+	t.logger.Printf("Adding %s to Linear team %s", emails, t.teamID)
+
+	for index, email := range emails {
+		userID, err := t.getUserID(ctx, email)
+		if err != nil {
+			return fmt.Errorf("linear.team.add -> %w", err)
+		}
+
+		err = t.client.AddTeamMember(ctx, t.teamID, userID)
+		if err != nil {
+			return fmt.Errorf("linear.team.add.addteammember(%s, %s) -> %w", t.teamID, email, err)
+		}
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
+	}
+
+	t.logger.Println("Finished adding accounts successfully")
+
+	return nil
+}
+
+// Remove emails from a Linear team.
+func (t *Team) Remove(ctx context.Context, emails []string) error {
+	t.logger.Printf("Removing %s from Linear team %s", emails, t.teamID)
+
+	// If the cache hasn't been generated, regenerate it.
+	if t.cache == nil {
+		return fmt.Errorf("linear.team.remove -> %w", gosync.ErrCacheEmpty)
+	}
+
+	for index, email := range emails {
+		err := t.client.RemoveTeamMember(ctx, t.cache[email])
+		if err != nil {
+			return fmt.Errorf("linear.team.remove.removeteammember(%s, %s) -> %w", t.teamID, email, err)
+		}
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
+	}
+
+	t.logger.Println("Finished removing accounts successfully")
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gosync "github.com/ovotech/go-sync"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMembership(id string, userID string, email string) membership {
+	m := membership{ID: id}
+	m.User.ID = userID
+	m.User.Email = email
+
+	return m
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	team := New(NewClient("api-key"), "team-id")
+
+	assert.Equal(t, "team-id", team.teamID)
+	assert.Nil(t, team.cache)
+}
+
+func TestTeam_Config(t *testing.T) {
+	t.Parallel()
+
+	team := New(NewClient("secret-api-key"), "team-id")
+
+	assert.Equal(t, map[string]string{"team": "team-id", "apiKey": gosync.Redacted}, team.Config())
+}
+
+func TestTeam_Get(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	client := newMockILinearClient(t)
+	team := New(NewClient(""), "team-id")
+	team.client = client
+
+	client.EXPECT().GetTeamMemberships(ctx, "team-id", "").Return([]membership{
+		newMembership("membership-foo", "user-foo", "foo@email"),
+	}, "page-2", nil)
+	client.EXPECT().GetTeamMemberships(ctx, "team-id", "page-2").Return([]membership{
+		newMembership("membership-bar", "user-bar", "bar@email"),
+	}, "", nil)
+
+	emails, err := team.Get(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"foo@email", "bar@email"}, emails)
+	assert.Equal(t, map[string]string{"foo@email": "membership-foo", "bar@email": "membership-bar"}, team.cache)
+	assert.Equal(t, "user-bar", team.users["bar@email"])
+}
+
+func TestTeam_Add(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		client := newMockILinearClient(t)
+		team := New(NewClient(""), "team-id")
+		team.client = client
+		team.users = map[string]string{"foo@email": "user-foo"}
+
+		// foo is already cached, so only bar needs resolving.
+		client.EXPECT().GetUserIDByEmail(ctx, "bar@email").Once().Return("user-bar", nil)
+		client.EXPECT().AddTeamMember(ctx, "team-id", "user-foo").Return(nil)
+		client.EXPECT().AddTeamMember(ctx, "team-id", "user-bar").Return(nil)
+
+		err := team.Add(ctx, []string{"foo@email", "bar@email"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "user-bar", team.users["bar@email"])
+	})
+
+	t.Run("User not found", func(t *testing.T) {
+		t.Parallel()
+
+		client := newMockILinearClient(t)
+		team := New(NewClient(""), "team-id")
+		team.client = client
+
+		client.EXPECT().GetUserIDByEmail(ctx, "foo@email").Return("", nil)
+
+		err := team.Add(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+
+	t.Run("Read only", func(t *testing.T) {
+		t.Parallel()
+
+		client := newMockILinearClient(t)
+		team := New(NewClient(""), "team-id")
+		team.client = client
+		team.users = map[string]string{"foo@email": "user-foo"}
+
+		client.EXPECT().AddTeamMember(ctx, "team-id", "user-foo").Return(gosync.ErrReadOnly)
+
+		err := team.Add(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, gosync.ErrReadOnly)
+	})
+}
+
+func TestTeam_Remove(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		client := newMockILinearClient(t)
+		team := New(NewClient(""), "team-id")
+		team.client = client
+		team.cache = map[string]string{"foo@email": "membership-foo", "bar@email": "membership-bar"}
+
+		client.EXPECT().RemoveTeamMember(ctx, "membership-foo").Return(nil)
+		client.EXPECT().RemoveTeamMember(ctx, "membership-bar").Return(nil)
+
+		err := team.Remove(ctx, []string{"foo@email", "bar@email"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Cache empty", func(t *testing.T) {
+		t.Parallel()
+
+		team := New(NewClient(""), "team-id")
+
+		err := team.Remove(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, gosync.ErrCacheEmpty)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		testErr := errors.New("foo") //nolint:goerr113
+
+		client := newMockILinearClient(t)
+		team := New(NewClient(""), "team-id")
+		team.client = client
+		team.cache = map[string]string{"foo@email": "membership-foo"}
+
+		client.EXPECT().RemoveTeamMember(ctx, "membership-foo").Return(testErr)
+
+		err := team.Remove(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, testErr)
+	})
+}
@@ -0,0 +1,172 @@
+package list
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ErrUnexpectedResponse is returned when the Mailchimp API responds with an unexpected status code.
+var ErrUnexpectedResponse = errors.New("unexpected response from mailchimp")
+
+// member is a member of a Mailchimp list (audience).
+type member struct {
+	EmailAddress string `json:"email_address"`
+	Status       string `json:"status"`
+}
+
+// batchError is a member that couldn't be added by a batch subscribe.
+type batchError struct {
+	EmailAddress string `json:"email_address"`
+	Error        string `json:"error"`
+	ErrorCode    string `json:"error_code"`
+}
+
+// Client is a minimal Mailchimp Marketing API v3 client, authenticated with an API key.
+type Client struct {
+	httpClient *http.Client
+	server     string
+	apiKey     string
+}
+
+// NewClient creates a new Mailchimp client for an API key. The key ends with the data centre of the account, e.g.
+// -us6, which is used to find the API server.
+func NewClient(apiKey string) *Client {
+	dataCentre := "us1"
+	if index := strings.LastIndex(apiKey, "-"); index != -1 {
+		dataCentre = apiKey[index+1:]
+	}
+
+	return &Client{
+		httpClient: http.DefaultClient,
+		server:     "https://" + dataCentre + ".api.mailchimp.com/3.0",
+		apiKey:     apiKey,
+	}
+}
+
+// WithHTTPClient sets a custom HTTP client, e.g. to configure timeouts or proxies.
+func (c *Client) WithHTTPClient(httpClient *http.Client) *Client {
+	c.httpClient = httpClient
+
+	return c
+}
+
+// subscriberHash returns the ID Mailchimp uses for a list member, the MD5 hash of their lowercase email.
+func subscriberHash(email string) string {
+	hash := md5.Sum([]byte(strings.ToLower(strings.TrimSpace(email)))) //nolint:gosec
+
+	return hex.EncodeToString(hash[:])
+}
+
+// do makes a request to the Mailchimp API, and decodes the response into out if it isn't nil.
+func (c *Client) do(ctx context.Context, method string, path string, body interface{}, out interface{}) (int, error) {
+	var reader io.Reader
+
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return 0, fmt.Errorf("marshal -> %w", err)
+		}
+
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.server+path, reader)
+	if err != nil {
+		return 0, fmt.Errorf("newrequest(%s, %s) -> %w", method, path, err)
+	}
+
+	// Mailchimp accepts any username with the API key as the password.
+	req.SetBasicAuth("go-sync", c.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("do(%s, %s) -> %w", method, path, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("do(%s, %s) -> %w: %s", method, path, ErrUnexpectedResponse, res.Status)
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+			return res.StatusCode, fmt.Errorf("decode(%s, %s) -> %w", method, path, err)
+		}
+	}
+
+	return res.StatusCode, nil
+}
+
+// GetListMembers gets a page of members of a list, and the total number of members.
+func (c *Client) GetListMembers(ctx context.Context, listID string, offset int, count int) ([]member, int, error) {
+	query := url.Values{
+		"offset": {strconv.Itoa(offset)},
+		"count":  {strconv.Itoa(count)},
+		"fields": {"members.email_address,members.status,total_items"},
+	}
+
+	var out struct {
+		Members    []member `json:"members"`
+		TotalItems int      `json:"total_items"`
+	}
+
+	path := "/lists/" + url.PathEscape(listID) + "/members?" + query.Encode()
+	if _, err := c.do(ctx, http.MethodGet, path, nil, &out); err != nil {
+		return nil, 0, err
+	}
+
+	return out.Members, out.TotalItems, nil
+}
+
+// BatchSubscribe adds up to 500 emails to a list with the given status, and returns the members that couldn't be
+// added. Existing members aren't updated.
+func (c *Client) BatchSubscribe(
+	ctx context.Context,
+	listID string,
+	emails []string,
+	status string,
+) ([]batchError, error) {
+	members := make([]member, 0, len(emails))
+	for _, email := range emails {
+		members = append(members, member{EmailAddress: email, Status: status})
+	}
+
+	body := map[string]interface{}{
+		"members":         members,
+		"update_existing": false,
+	}
+
+	var out struct {
+		Errors []batchError `json:"errors"`
+	}
+
+	if _, err := c.do(ctx, http.MethodPost, "/lists/"+url.PathEscape(listID), body, &out); err != nil {
+		return nil, err
+	}
+
+	return out.Errors, nil
+}
+
+// ArchiveListMember archives a member of a list, which removes them from the list but keeps their history. Archiving
+// an email that isn't in the list succeeds.
+func (c *Client) ArchiveListMember(ctx context.Context, listID string, email string) error {
+	path := "/lists/" + url.PathEscape(listID) + "/members/" + subscriberHash(email)
+
+	status, err := c.do(ctx, http.MethodDelete, path, nil, nil)
+	if status == http.StatusNotFound {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,118 @@
+package list
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "https://us6.api.mailchimp.com/3.0", NewClient("abc123-us6").server)
+	assert.Equal(t, "https://us1.api.mailchimp.com/3.0", NewClient("abc123").server)
+}
+
+func TestSubscriberHash(t *testing.T) {
+	t.Parallel()
+
+	// The MD5 hash of "urist.mcvankab@freddiesjokes.com", from the Mailchimp documentation.
+	assert.Equal(t, "62eeb292278cc15f5817cb78f7790b08", subscriberHash("Urist.McVankab@FreddiesJokes.com"))
+}
+
+//nolint:funlen
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("GetListMembers", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			username, password, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "go-sync", username)
+			assert.Equal(t, "key-us6", password)
+			assert.Equal(t, "/3.0/lists/list-id/members", r.URL.Path)
+			assert.Equal(t, "2", r.URL.Query().Get("offset"))
+			assert.Equal(t, "10", r.URL.Query().Get("count"))
+
+			_, _ = w.Write([]byte(`{"members":[{"email_address":"foo@email","status":"subscribed"}],"total_items":3}`))
+		}))
+		defer server.Close()
+
+		client := NewClient("key-us6")
+		client.server = server.URL + "/3.0"
+
+		members, total, err := client.GetListMembers(ctx, "list-id", 2, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []member{{EmailAddress: "foo@email", Status: "subscribed"}}, members)
+		assert.Equal(t, 3, total)
+	})
+
+	t.Run("BatchSubscribe", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Members        []member `json:"members"`
+				UpdateExisting bool     `json:"update_existing"`
+			}
+
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/3.0/lists/list-id", r.URL.Path)
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, []member{
+				{EmailAddress: "foo@email", Status: "pending"},
+				{EmailAddress: "invalid", Status: "pending"},
+			}, body.Members)
+			assert.False(t, body.UpdateExisting)
+
+			_, _ = w.Write([]byte(
+				`{"errors":[{"email_address":"invalid","error":"Invalid email","error_code":"ERROR_GENERIC"}]}`,
+			))
+		}))
+		defer server.Close()
+
+		client := NewClient("key-us6")
+		client.server = server.URL + "/3.0"
+
+		batchErrors, err := client.BatchSubscribe(ctx, "list-id", []string{"foo@email", "invalid"}, "pending")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []batchError{
+			{EmailAddress: "invalid", Error: "Invalid email", ErrorCode: "ERROR_GENERIC"},
+		}, batchErrors)
+	})
+
+	t.Run("ArchiveListMember", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+
+			switch r.URL.Path {
+			case "/3.0/lists/list-id/members/" + subscriberHash("foo@email"):
+				w.WriteHeader(http.StatusNoContent)
+			case "/3.0/lists/list-id/members/" + subscriberHash("missing@email"):
+				w.WriteHeader(http.StatusNotFound)
+			default:
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		}))
+		defer server.Close()
+
+		client := NewClient("key-us6")
+		client.server = server.URL + "/3.0"
+
+		assert.NoError(t, client.ArchiveListMember(ctx, "list-id", "foo@email"))
+		assert.NoError(t, client.ArchiveListMember(ctx, "list-id", "missing@email"))
+		assert.ErrorIs(t, client.ArchiveListMember(ctx, "list-id", "bar@email"), ErrUnexpectedResponse)
+	})
+}
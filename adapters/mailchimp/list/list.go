@@ -0,0 +1,175 @@
+/*
+Package list synchronises email addresses with a Mailchimp list (audience).
+
+In order to use this adapter, you'll need a Mailchimp API key for the account that owns the list.
+*/
+package list
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	gosync "github.com/ovotech/go-sync"
+)
+
+// Ensure the adapter type fully satisfies the ports.Adapter and ports.ConfiguredAdapter interfaces.
+var (
+	_ gosync.Adapter           = &List{}
+	_ gosync.ConfiguredAdapter = &List{}
+)
+
+// ErrMemberNotAdded is returned when Mailchimp rejects some of the emails in a batch, e.g. because they're invalid.
+var ErrMemberNotAdded = errors.New("member not added")
+
+const (
+	perPage   = 1000 // perPage is the maximum number of members Mailchimp returns per page.
+	batchSize = 500  // batchSize is the maximum number of members Mailchimp accepts per batch subscribe.
+)
+
+// iMailchimpClient is a subset of the Mailchimp Client, and used to build mocks for easy testing.
+type iMailchimpClient interface {
+	GetListMembers(ctx context.Context, listID string, offset int, count int) ([]member, int, error)
+	BatchSubscribe(ctx context.Context, listID string, emails []string, status string) ([]batchError, error)
+	ArchiveListMember(ctx context.Context, listID string, email string) error
+}
+
+type List struct {
+	client iMailchimpClient
+	listID string
+	status string // status of added members, either subscribed or pending for double opt-in.
+	logger *log.Logger
+}
+
+// WithDoubleOptIn sends added members a confirmation email, and only subscribes them to the list once they've
+// confirmed. Until then, they're returned by Get with a pending status, so aren't added again.
+func WithDoubleOptIn() func(*List) {
+	return func(list *List) {
+		list.status = "pending"
+	}
+}
+
+// WithLogger sets a custom logger.
+func WithLogger(logger *log.Logger) func(*List) {
+	return func(list *List) {
+		list.logger = logger
+	}
+}
+
+// New instantiates a new Mailchimp list adapter, for the list with the given ID.
+func New(client *Client, listID string, optsFn ...func(*List)) *List {
+	list := &List{
+		client: client,
+		listID: listID,
+		status: "subscribed",
+		logger: log.New(os.Stderr, "[go-sync/mailchimp/list] ", log.LstdFlags|log.Lshortfile|log.Lmsgprefix),
+	}
+
+	for _, fn := range optsFn {
+		fn(list)
+	}
+
+	return list
+}
+
+// Config returns the adapter's configuration, with the API key redacted.
+func (l *List) Config() map[string]string {
+	config := map[string]string{
+		"list":        l.listID,
+		"doubleOptIn": strconv.FormatBool(l.status == "pending"),
+	}
+
+	if client, ok := l.client.(*Client); ok {
+		config["server"] = client.server
+		config["apiKey"] = gosync.Redacted
+	}
+
+	return config
+}
+
+// Get emails of members of a Mailchimp list. Members who have unsubscribed are included, so they aren't subscribed
+// again by Add.
+func (l *List) Get(ctx context.Context) ([]string, error) {
+	l.logger.Printf("Fetching accounts from Mailchimp list %s", l.listID)
+
+	emails := make([]string, 0)
+
+	for offset := 0; ; offset += perPage {
+		members, total, err := l.client.GetListMembers(ctx, l.listID, offset, perPage)
+		if err != nil {
+			return nil, fmt.Errorf("mailchimp.list.get.getlistmembers(%s, %d) -> %w", l.listID, offset, err)
+		}
+
+		for _, member := range members {
+			emails = append(emails, member.EmailAddress)
+		}
+
+		if len(members) == 0 || offset+len(members) >= total {
+			break
+		}
+	}
+
+	l.logger.Println("Fetched accounts successfully")
+
+	return emails, nil
+}
+
+// Add emails to a Mailchimp list, in batches. If Mailchimp rejects some emails, the rest of the batch is still added,
+// and the rejected emails are returned in an ErrMemberNotAdded error once every batch has been sent.
+func (l *List) Add(ctx context.Context, emails []string) error {
+	l.logger.Printf("Adding %s to Mailchimp list %s", emails, l.listID)
+
+	var rejected []string
+
+	for start := 0; start < len(emails); start += batchSize {
+		end := start + batchSize
+		if end > len(emails) {
+			end = len(emails)
+		}
+
+		batchErrors, err := l.client.BatchSubscribe(ctx, l.listID, emails[start:end], l.status)
+		if err != nil {
+			return fmt.Errorf("mailchimp.list.add.batchsubscribe(%s, %d-%d) -> %w", l.listID, start, end, err)
+		}
+
+		for _, batchErr := range batchErrors {
+			// Members added since Get was called are already in the list.
+			if batchErr.ErrorCode == "ERROR_CONTACT_EXISTS" {
+				continue
+			}
+
+			rejected = append(rejected, fmt.Sprintf("%s (%s)", batchErr.EmailAddress, batchErr.Error))
+		}
+
+		gosync.ReportProgress(ctx, end, len(emails))
+	}
+
+	if len(rejected) > 0 {
+		return fmt.Errorf("mailchimp.list.add(%s) -> %w: %s", l.listID, ErrMemberNotAdded, strings.Join(rejected, ", "))
+	}
+
+	l.logger.Println("Finished adding accounts successfully")
+
+	return nil
+}
+
+// Remove emails from a Mailchimp list. Members are archived, so their history is kept if they're added again.
+func (l *List) Remove(ctx context.Context, emails []string) error {
+	l.logger.Printf("Removing %s from Mailchimp list %s", emails, l.listID)
+
+	for index, email := range emails {
+		if err := l.client.ArchiveListMember(ctx, l.listID, email); err != nil {
+			return fmt.Errorf("mailchimp.list.remove.archivelistmember(%s, %s) -> %w", l.listID, email, err)
+		}
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
+	}
+
+	l.logger.Println("Finished removing accounts successfully")
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package list
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	gosync "github.com/ovotech/go-sync"
+	"github.com/stretchr/testify/assert"
+)
+
+var errMailchimp = errors.New("an example error")
+
+func createMockedAdapter(t *testing.T, optsFn ...func(*List)) (*List, *mockIMailchimpClient) {
+	t.Helper()
+
+	client := newMockIMailchimpClient(t)
+	adapter := New(NewClient("key-us6"), "list-id", optsFn...)
+	adapter.client = client
+
+	return adapter, client
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	adapter := New(NewClient("secret-key-us6"), "list-id")
+
+	assert.Equal(t, "list-id", adapter.listID)
+	assert.Equal(t, "subscribed", adapter.status)
+	assert.Equal(t, map[string]string{
+		"list":        "list-id",
+		"doubleOptIn": "false",
+		"server":      "https://us6.api.mailchimp.com/3.0",
+		"apiKey":      gosync.Redacted,
+	}, adapter.Config())
+
+	assert.Equal(t, "pending", New(NewClient("key-us6"), "list-id", WithDoubleOptIn()).status)
+}
+
+func TestList_Get(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+
+		firstPage := make([]member, perPage)
+		for i := range firstPage {
+			firstPage[i] = member{EmailAddress: fmt.Sprintf("user%d@email", i), Status: "subscribed"}
+		}
+
+		client.EXPECT().GetListMembers(ctx, "list-id", 0, perPage).Return(firstPage, perPage+1, nil)
+		client.EXPECT().GetListMembers(ctx, "list-id", perPage, perPage).
+			Return([]member{{EmailAddress: "unsubscribed@email", Status: "unsubscribed"}}, perPage+1, nil)
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Len(t, emails, perPage+1)
+		assert.Equal(t, "user0@email", emails[0])
+		assert.Equal(t, "unsubscribed@email", emails[perPage])
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+
+		client.EXPECT().GetListMembers(ctx, "list-id", 0, perPage).Return(nil, 0, errMailchimp)
+
+		_, err := adapter.Get(ctx)
+
+		assert.ErrorIs(t, err, errMailchimp)
+	})
+}
+
+func TestList_Add(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Batches", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t, WithDoubleOptIn())
+
+		emails := make([]string, batchSize+1)
+		for i := range emails {
+			emails[i] = fmt.Sprintf("user%d@email", i)
+		}
+
+		client.EXPECT().BatchSubscribe(ctx, "list-id", emails[:batchSize], "pending").Return(nil, nil).Once()
+		client.EXPECT().BatchSubscribe(ctx, "list-id", emails[batchSize:], "pending").Return(nil, nil).Once()
+
+		assert.NoError(t, adapter.Add(ctx, emails))
+	})
+
+	t.Run("Rejected members", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+
+		client.EXPECT().BatchSubscribe(ctx, "list-id", []string{"foo@email", "existing@email", "invalid"}, "subscribed").
+			Return([]batchError{
+				{EmailAddress: "existing@email", Error: "already a member", ErrorCode: "ERROR_CONTACT_EXISTS"},
+				{EmailAddress: "invalid", Error: "Invalid email", ErrorCode: "ERROR_GENERIC"},
+			}, nil)
+
+		err := adapter.Add(ctx, []string{"foo@email", "existing@email", "invalid"})
+
+		assert.ErrorIs(t, err, ErrMemberNotAdded)
+		assert.ErrorContains(t, err, "invalid (Invalid email)")
+		assert.NotContains(t, err.Error(), "existing@email")
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+
+		client.EXPECT().BatchSubscribe(ctx, "list-id", []string{"foo@email"}, "subscribed").Return(nil, errMailchimp)
+
+		assert.ErrorIs(t, adapter.Add(ctx, []string{"foo@email"}), errMailchimp)
+	})
+}
+
+func TestList_Remove(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	adapter, client := createMockedAdapter(t)
+
+	client.EXPECT().ArchiveListMember(ctx, "list-id", "foo@email").Return(nil)
+	client.EXPECT().ArchiveListMember(ctx, "list-id", "bar@email").Return(errMailchimp)
+
+	assert.NoError(t, adapter.Remove(ctx, []string{"foo@email"}))
+	assert.ErrorIs(t, adapter.Remove(ctx, []string{"bar@email"}), errMailchimp)
+}
@@ -0,0 +1,181 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package list
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockIMailchimpClient is an autogenerated mock type for the iMailchimpClient type
+type mockIMailchimpClient struct {
+	mock.Mock
+}
+
+type mockIMailchimpClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockIMailchimpClient) EXPECT() *mockIMailchimpClient_Expecter {
+	return &mockIMailchimpClient_Expecter{mock: &_m.Mock}
+}
+
+// ArchiveListMember provides a mock function with given fields: ctx, listID, email
+func (_m *mockIMailchimpClient) ArchiveListMember(ctx context.Context, listID string, email string) error {
+	ret := _m.Called(ctx, listID, email)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, listID, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockIMailchimpClient_ArchiveListMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveListMember'
+type mockIMailchimpClient_ArchiveListMember_Call struct {
+	*mock.Call
+}
+
+// ArchiveListMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+//   - email string
+func (_e *mockIMailchimpClient_Expecter) ArchiveListMember(ctx interface{}, listID interface{}, email interface{}) *mockIMailchimpClient_ArchiveListMember_Call {
+	return &mockIMailchimpClient_ArchiveListMember_Call{Call: _e.mock.On("ArchiveListMember", ctx, listID, email)}
+}
+
+func (_c *mockIMailchimpClient_ArchiveListMember_Call) Run(run func(ctx context.Context, listID string, email string)) *mockIMailchimpClient_ArchiveListMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockIMailchimpClient_ArchiveListMember_Call) Return(_a0 error) *mockIMailchimpClient_ArchiveListMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// BatchSubscribe provides a mock function with given fields: ctx, listID, emails, status
+func (_m *mockIMailchimpClient) BatchSubscribe(ctx context.Context, listID string, emails []string, status string) ([]batchError, error) {
+	ret := _m.Called(ctx, listID, emails, status)
+
+	var r0 []batchError
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, string) []batchError); ok {
+		r0 = rf(ctx, listID, emails, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]batchError)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, string) error); ok {
+		r1 = rf(ctx, listID, emails, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockIMailchimpClient_BatchSubscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchSubscribe'
+type mockIMailchimpClient_BatchSubscribe_Call struct {
+	*mock.Call
+}
+
+// BatchSubscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+//   - emails []string
+//   - status string
+func (_e *mockIMailchimpClient_Expecter) BatchSubscribe(ctx interface{}, listID interface{}, emails interface{}, status interface{}) *mockIMailchimpClient_BatchSubscribe_Call {
+	return &mockIMailchimpClient_BatchSubscribe_Call{Call: _e.mock.On("BatchSubscribe", ctx, listID, emails, status)}
+}
+
+func (_c *mockIMailchimpClient_BatchSubscribe_Call) Run(run func(ctx context.Context, listID string, emails []string, status string)) *mockIMailchimpClient_BatchSubscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *mockIMailchimpClient_BatchSubscribe_Call) Return(_a0 []batchError, _a1 error) *mockIMailchimpClient_BatchSubscribe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetListMembers provides a mock function with given fields: ctx, listID, offset, count
+func (_m *mockIMailchimpClient) GetListMembers(ctx context.Context, listID string, offset int, count int) ([]member, int, error) {
+	ret := _m.Called(ctx, listID, offset, count)
+
+	var r0 []member
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []member); ok {
+		r0 = rf(ctx, listID, offset, count)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]member)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) int); ok {
+		r1 = rf(ctx, listID, offset, count)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, int, int) error); ok {
+		r2 = rf(ctx, listID, offset, count)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// mockIMailchimpClient_GetListMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListMembers'
+type mockIMailchimpClient_GetListMembers_Call struct {
+	*mock.Call
+}
+
+// GetListMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+//   - offset int
+//   - count int
+func (_e *mockIMailchimpClient_Expecter) GetListMembers(ctx interface{}, listID interface{}, offset interface{}, count interface{}) *mockIMailchimpClient_GetListMembers_Call {
+	return &mockIMailchimpClient_GetListMembers_Call{Call: _e.mock.On("GetListMembers", ctx, listID, offset, count)}
+}
+
+func (_c *mockIMailchimpClient_GetListMembers_Call) Run(run func(ctx context.Context, listID string, offset int, count int)) *mockIMailchimpClient_GetListMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *mockIMailchimpClient_GetListMembers_Call) Return(_a0 []member, _a1 int, _a2 error) *mockIMailchimpClient_GetListMembers_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+type mockConstructorTestingTnewMockIMailchimpClient interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// newMockIMailchimpClient creates a new instance of mockIMailchimpClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func newMockIMailchimpClient(t mockConstructorTestingTnewMockIMailchimpClient) *mockIMailchimpClient {
+	mock := &mockIMailchimpClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,244 @@
+/*
+Package channel synchronises email addresses with a Mattermost channel.
+
+In order to use this adapter, you'll need a Mattermost token for a user (or bot) that is a member of the channel, and
+able to manage its members.
+*/
+package channel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	gosync "github.com/ovotech/go-sync"
+)
+
+// Ensure the adapter type fully satisfies the ports.Adapter, ports.ReadOnlyAdapter and ports.ConfiguredAdapter
+// interfaces.
+var (
+	_ gosync.Adapter           = &Channel{}
+	_ gosync.ReadOnlyAdapter   = &Channel{}
+	_ gosync.ConfiguredAdapter = &Channel{}
+)
+
+// ErrUserNotFound is returned when an email can't be resolved to a Mattermost user.
+var ErrUserNotFound = errors.New("user not found")
+
+const perPage = 200
+
+// iMattermostClient is a subset of the Mattermost Client, and used to build mocks for easy testing.
+type iMattermostClient interface {
+	GetChannelMembers(ctx context.Context, channelID string, page int, perPage int) ([]user, error)
+	GetUserIDByEmail(ctx context.Context, email string) (string, error)
+	AddChannelMember(ctx context.Context, channelID string, userID string) error
+	RemoveChannelMember(ctx context.Context, channelID string, userID string) error
+}
+
+type Channel struct {
+	client    iMattermostClient
+	channelID string
+	// cache stores the email -> user ID mapping for use with the Add/Remove methods.
+	cache       map[string]string
+	readOnly    bool               // readOnly prevents Add/Remove, so the channel can only be used as a source.
+	rateLimiter gosync.RateLimiter // rateLimiter throttles calls in Add/Remove.
+	logger      *log.Logger
+}
+
+// WithLogger sets a custom logger.
+func WithLogger(logger *log.Logger) func(*Channel) {
+	return func(channel *Channel) {
+		channel.logger = logger
+	}
+}
+
+// WithReadOnly prevents Add/Remove from changing the channel, so it can only be used as a source.
+func WithReadOnly() func(*Channel) {
+	return func(channel *Channel) {
+		channel.readOnly = true
+	}
+}
+
+// WithRateLimiter throttles Add/Remove using a (possibly shared) rate limiter, as servers may have rate limiting
+// enabled. The limiter is waited on before each member is added or removed.
+func WithRateLimiter(limiter gosync.RateLimiter) func(*Channel) {
+	return func(channel *Channel) {
+		channel.rateLimiter = limiter
+	}
+}
+
+// New instantiates a new Mattermost channel adapter, for the channel with the given ID.
+func New(client *Client, channelID string, optsFn ...func(*Channel)) *Channel {
+	channel := &Channel{
+		client:      client,
+		channelID:   channelID,
+		cache:       nil,
+		readOnly:    false,
+		rateLimiter: nil,
+		logger:      log.New(os.Stderr, "[go-sync/mattermost/channel] ", log.LstdFlags|log.Lshortfile|log.Lmsgprefix),
+	}
+
+	for _, fn := range optsFn {
+		fn(channel)
+	}
+
+	return channel
+}
+
+// ReadOnly returns true if the adapter was created with WithReadOnly.
+func (c *Channel) ReadOnly() bool {
+	return c.readOnly
+}
+
+// Config returns the adapter's configuration, with the token redacted.
+func (c *Channel) Config() map[string]string {
+	config := map[string]string{
+		"channel":     c.channelID,
+		"readOnly":    strconv.FormatBool(c.readOnly),
+		"rateLimiter": strconv.FormatBool(c.rateLimiter != nil),
+	}
+
+	if client, ok := c.client.(*Client); ok {
+		config["server"] = client.server
+		config["token"] = gosync.Redacted
+	}
+
+	return config
+}
+
+// Get emails of Mattermost users in a channel.
+func (c *Channel) Get(ctx context.Context) ([]string, error) {
+	c.logger.Printf("Fetching accounts from Mattermost channel %s", c.channelID)
+
+	// Initialise the cache.
+	c.cache = make(map[string]string)
+
+	emails := make([]string, 0)
+
+	for page := 0; ; page++ {
+		users, err := c.client.GetChannelMembers(ctx, c.channelID, page, perPage)
+		if err != nil {
+			return nil, fmt.Errorf("mattermost.channel.get.getchannelmembers(%s, %d) -> %w", c.channelID, page, err)
+		}
+
+		for _, user := range users {
+			// Bots and deactivated users aren't managed by Go Sync.
+			if user.IsBot || user.DeleteAt != 0 {
+				continue
+			}
+
+			emails = append(emails, user.Email)
+
+			// Add the email -> ID map for use with the Add/Remove methods.
+			c.cache[user.Email] = user.ID
+		}
+
+		if len(users) < perPage {
+			break
+		}
+	}
+
+	c.logger.Println("Fetched accounts successfully")
+
+	return emails, nil
+}
+
+// getUserID resolves the ID of a user from their email, and caches it for subsequent calls.
+func (c *Channel) getUserID(ctx context.Context, email string) (string, error) {
+	if id, ok := c.cache[email]; ok {
+		return id, nil
+	}
+
+	id, err := c.client.GetUserIDByEmail(ctx, email)
+	if err != nil {
+		return "", fmt.Errorf("getuseridbyemail(%s) -> %w", email, err)
+	}
+
+	if id == "" {
+		return "", fmt.Errorf("getuseridbyemail(%s) -> %w", email, ErrUserNotFound)
+	}
+
+	if c.cache != nil {
+		c.cache[email] = id
+	}
+
+	return id, nil
+}
+
+// wait waits on the rate limiter, if one is set.
+func (c *Channel) wait(ctx context.Context) error {
+	if c.rateLimiter == nil {
+		return nil
+	}
+
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return fmt.Errorf("wait -> %w", err)
+	}
+
+	return nil
+}
+
+// Add emails to a Mattermost channel.
+func (c *Channel) Add(ctx context.Context, emails []string) error {
+	if c.readOnly {
+		return fmt.Errorf("mattermost.channel.add -> %w", gosync.ErrReadOnly)
+	}
+
+	c.logger.Printf("Adding %s to Mattermost channel %s", emails, c.channelID)
+
+	for index, email := range emails {
+		userID, err := c.getUserID(ctx, email)
+		if err != nil {
+			return fmt.Errorf("mattermost.channel.add -> %w", err)
+		}
+
+		if err = c.wait(ctx); err != nil {
+			return fmt.Errorf("mattermost.channel.add -> %w", err)
+		}
+
+		err = c.client.AddChannelMember(ctx, c.channelID, userID)
+		if err != nil {
+			return fmt.Errorf("mattermost.channel.add.addchannelmember(%s, %s) -> %w", c.channelID, email, err)
+		}
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
+	}
+
+	c.logger.Println("Finished adding accounts successfully")
+
+	return nil
+}
+
+// Remove emails from a Mattermost channel.
+func (c *Channel) Remove(ctx context.Context, emails []string) error {
+	if c.readOnly {
+		return fmt.Errorf("mattermost.channel.remove -> %w", gosync.ErrReadOnly)
+	}
+
+	c.logger.Printf("Removing %s from Mattermost channel %s", emails, c.channelID)
+
+	// If the cache hasn't been generated, regenerate it.
+	if c.cache == nil {
+		return fmt.Errorf("mattermost.channel.remove -> %w", gosync.ErrCacheEmpty)
+	}
+
+	for index, email := range emails {
+		if err := c.wait(ctx); err != nil {
+			return fmt.Errorf("mattermost.channel.remove -> %w", err)
+		}
+
+		err := c.client.RemoveChannelMember(ctx, c.channelID, c.cache[email])
+		if err != nil {
+			return fmt.Errorf("mattermost.channel.remove.removechannelmember(%s, %s) -> %w", c.channelID, email, err)
+		}
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
+	}
+
+	c.logger.Println("Finished removing accounts successfully")
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gosync "github.com/ovotech/go-sync"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingLimiter is a rate limiter that never blocks, and counts how many times it has been waited on.
+type countingLimiter struct {
+	waits int
+}
+
+func (c *countingLimiter) Wait(_ context.Context) error {
+	c.waits++
+
+	return nil
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	channel := New(NewClient("https://mattermost.example.com", "secret-token"), "channel-id")
+
+	assert.Equal(t, "channel-id", channel.channelID)
+	assert.False(t, channel.ReadOnly())
+	assert.Nil(t, channel.cache)
+}
+
+func TestChannel_Config(t *testing.T) {
+	t.Parallel()
+
+	channel := New(NewClient("https://mattermost.example.com/", "secret-token"), "channel-id", WithReadOnly())
+
+	assert.Equal(t, map[string]string{
+		"channel":     "channel-id",
+		"readOnly":    "true",
+		"rateLimiter": "false",
+		"server":      "https://mattermost.example.com",
+		"token":       gosync.Redacted,
+	}, channel.Config())
+}
+
+func TestChannel_Get(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	client := newMockIMattermostClient(t)
+	channel := New(NewClient("", ""), "channel-id")
+	channel.client = client
+
+	// A full first page means there may be more members.
+	firstPage := make([]user, perPage)
+	for i := range firstPage {
+		firstPage[i] = user{ID: "bot", Email: "bot@email", IsBot: true}
+	}
+
+	firstPage[0] = user{ID: "foo", Email: "foo@email"}
+
+	client.EXPECT().GetChannelMembers(ctx, "channel-id", 0, perPage).Return(firstPage, nil)
+	client.EXPECT().GetChannelMembers(ctx, "channel-id", 1, perPage).Return([]user{
+		{ID: "bar", Email: "bar@email"},
+		{ID: "deactivated", Email: "deactivated@email", DeleteAt: 1},
+	}, nil)
+
+	emails, err := channel.Get(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"foo@email", "bar@email"}, emails)
+	assert.Equal(t, map[string]string{"foo@email": "foo", "bar@email": "bar"}, channel.cache)
+}
+
+//nolint:funlen
+func TestChannel_Add(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := &countingLimiter{}
+
+		client := newMockIMattermostClient(t)
+		channel := New(NewClient("", ""), "channel-id", WithRateLimiter(limiter))
+		channel.client = client
+		channel.cache = map[string]string{"foo@email": "foo"}
+
+		// foo is already cached, so only bar needs resolving.
+		client.EXPECT().GetUserIDByEmail(ctx, "bar@email").Once().Return("bar", nil)
+		client.EXPECT().AddChannelMember(ctx, "channel-id", "foo").Return(nil)
+		client.EXPECT().AddChannelMember(ctx, "channel-id", "bar").Return(nil)
+
+		err := channel.Add(ctx, []string{"foo@email", "bar@email"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "bar", channel.cache["bar@email"])
+		assert.Equal(t, 2, limiter.waits)
+	})
+
+	t.Run("User not found", func(t *testing.T) {
+		t.Parallel()
+
+		client := newMockIMattermostClient(t)
+		channel := New(NewClient("", ""), "channel-id")
+		channel.client = client
+
+		client.EXPECT().GetUserIDByEmail(ctx, "foo@email").Return("", nil)
+
+		err := channel.Add(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+
+	t.Run("Read only", func(t *testing.T) {
+		t.Parallel()
+
+		client := newMockIMattermostClient(t)
+		channel := New(NewClient("", ""), "channel-id", WithReadOnly())
+		channel.client = client
+
+		err := channel.Add(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, gosync.ErrReadOnly)
+		assert.Zero(t, client.Calls)
+	})
+}
+
+func TestChannel_Remove(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := &countingLimiter{}
+
+		client := newMockIMattermostClient(t)
+		channel := New(NewClient("", ""), "channel-id", WithRateLimiter(limiter))
+		channel.client = client
+		channel.cache = map[string]string{"foo@email": "foo", "bar@email": "bar"}
+
+		client.EXPECT().RemoveChannelMember(ctx, "channel-id", "foo").Return(nil)
+		client.EXPECT().RemoveChannelMember(ctx, "channel-id", "bar").Return(nil)
+
+		err := channel.Remove(ctx, []string{"foo@email", "bar@email"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, limiter.waits)
+	})
+
+	t.Run("Cache empty", func(t *testing.T) {
+		t.Parallel()
+
+		channel := New(NewClient("", ""), "channel-id")
+
+		err := channel.Remove(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, gosync.ErrCacheEmpty)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		testErr := errors.New("foo") //nolint:goerr113
+
+		client := newMockIMattermostClient(t)
+		channel := New(NewClient("", ""), "channel-id")
+		channel.client = client
+		channel.cache = map[string]string{"foo@email": "foo"}
+
+		client.EXPECT().RemoveChannelMember(ctx, "channel-id", "foo").Return(testErr)
+
+		err := channel.Remove(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, testErr)
+	})
+
+	t.Run("Read only", func(t *testing.T) {
+		t.Parallel()
+
+		channel := New(NewClient("", ""), "channel-id", WithReadOnly())
+		channel.cache = map[string]string{"foo@email": "foo"}
+
+		err := channel.Remove(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, gosync.ErrReadOnly)
+	})
+}
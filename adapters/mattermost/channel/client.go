@@ -0,0 +1,141 @@
+package channel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	gosync "github.com/ovotech/go-sync"
+)
+
+// ErrUnexpectedResponse is returned when the Mattermost API responds with an unexpected status code.
+var ErrUnexpectedResponse = errors.New("unexpected response from mattermost")
+
+// user is a Mattermost user.
+type user struct {
+	ID       string `json:"id"`
+	Email    string `json:"email"`
+	IsBot    bool   `json:"is_bot"`
+	DeleteAt int64  `json:"delete_at"`
+}
+
+// Client is a minimal Mattermost API v4 client, authenticated with a personal access or bot token.
+type Client struct {
+	httpClient *http.Client
+	server     string
+	token      string
+}
+
+// NewClient creates a new Mattermost client for a server URL, e.g. https://mattermost.example.com.
+func NewClient(server string, token string) *Client {
+	return &Client{
+		httpClient: http.DefaultClient,
+		server:     strings.TrimSuffix(server, "/"),
+		token:      token,
+	}
+}
+
+// WithHTTPClient sets a custom HTTP client, e.g. to configure timeouts or proxies.
+func (c *Client) WithHTTPClient(httpClient *http.Client) *Client {
+	c.httpClient = httpClient
+
+	return c
+}
+
+// do makes a request to the Mattermost API, and decodes the response into out if it isn't nil. A forbidden response
+// to a write means the token can't manage the channel, so gosync.ErrReadOnly is returned.
+func (c *Client) do(ctx context.Context, method string, path string, body interface{}, out interface{}) (int, error) {
+	var reader io.Reader
+
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return 0, fmt.Errorf("marshal -> %w", err)
+		}
+
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.server+"/api/v4"+path, reader)
+	if err != nil {
+		return 0, fmt.Errorf("newrequest(%s, %s) -> %w", method, path, err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("do(%s, %s) -> %w", method, path, err)
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode == http.StatusForbidden && method != http.MethodGet:
+		return res.StatusCode, fmt.Errorf("do(%s, %s) -> %w", method, path, gosync.ErrReadOnly)
+	case res.StatusCode < 200 || res.StatusCode > 299:
+		return res.StatusCode, fmt.Errorf("do(%s, %s) -> %w: %s", method, path, ErrUnexpectedResponse, res.Status)
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+			return res.StatusCode, fmt.Errorf("decode(%s, %s) -> %w", method, path, err)
+		}
+	}
+
+	return res.StatusCode, nil
+}
+
+// GetChannelMembers gets a page of users in a channel.
+func (c *Client) GetChannelMembers(ctx context.Context, channelID string, page int, perPage int) ([]user, error) {
+	query := url.Values{
+		"in_channel": {channelID},
+		"page":       {strconv.Itoa(page)},
+		"per_page":   {strconv.Itoa(perPage)},
+	}
+
+	var users []user
+
+	if _, err := c.do(ctx, http.MethodGet, "/users?"+query.Encode(), nil, &users); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// GetUserIDByEmail gets the ID of a user by their email, or an empty string if they don't exist.
+func (c *Client) GetUserIDByEmail(ctx context.Context, email string) (string, error) {
+	var found user
+
+	status, err := c.do(ctx, http.MethodGet, "/users/email/"+url.PathEscape(email), nil, &found)
+	if status == http.StatusNotFound {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return found.ID, nil
+}
+
+// AddChannelMember adds a user to a channel.
+func (c *Client) AddChannelMember(ctx context.Context, channelID string, userID string) error {
+	_, err := c.do(ctx, http.MethodPost, "/channels/"+channelID+"/members", map[string]string{"user_id": userID}, nil)
+
+	return err
+}
+
+// RemoveChannelMember removes a user from a channel.
+func (c *Client) RemoveChannelMember(ctx context.Context, channelID string, userID string) error {
+	_, err := c.do(ctx, http.MethodDelete, "/channels/"+channelID+"/members/"+userID, nil, nil)
+
+	return err
+}
@@ -0,0 +1,108 @@
+package channel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gosync "github.com/ovotech/go-sync"
+	"github.com/stretchr/testify/assert"
+)
+
+//nolint:funlen
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("GetChannelMembers", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+			assert.Equal(t, "/api/v4/users", r.URL.Path)
+			assert.Equal(t, "channel-id", r.URL.Query().Get("in_channel"))
+			assert.Equal(t, "2", r.URL.Query().Get("page"))
+			assert.Equal(t, "10", r.URL.Query().Get("per_page"))
+
+			_, _ = w.Write([]byte(`[{"id":"foo","email":"foo@email","is_bot":false,"delete_at":0}]`))
+		}))
+		defer server.Close()
+
+		users, err := NewClient(server.URL+"/", "token").GetChannelMembers(ctx, "channel-id", 2, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []user{{ID: "foo", Email: "foo@email"}}, users)
+	})
+
+	t.Run("GetUserIDByEmail", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/v4/users/email/foo@email" {
+				w.WriteHeader(http.StatusNotFound)
+
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"id":"foo","email":"foo@email"}`))
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, "token")
+
+		id, err := client.GetUserIDByEmail(ctx, "foo@email")
+		assert.NoError(t, err)
+		assert.Equal(t, "foo", id)
+
+		id, err = client.GetUserIDByEmail(ctx, "bar@email")
+		assert.NoError(t, err)
+		assert.Empty(t, id)
+	})
+
+	t.Run("AddChannelMember/RemoveChannelMember", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPost:
+				var body map[string]string
+
+				assert.Equal(t, "/api/v4/channels/channel-id/members", r.URL.Path)
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, map[string]string{"user_id": "foo"}, body)
+
+				w.WriteHeader(http.StatusCreated)
+			case http.MethodDelete:
+				assert.Equal(t, "/api/v4/channels/channel-id/members/foo", r.URL.Path)
+			default:
+				t.Errorf("unexpected method %s", r.Method)
+			}
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, "token")
+
+		assert.NoError(t, client.AddChannelMember(ctx, "channel-id", "foo"))
+		assert.NoError(t, client.RemoveChannelMember(ctx, "channel-id", "foo"))
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, "token")
+
+		err := client.RemoveChannelMember(ctx, "channel-id", "foo")
+		assert.ErrorIs(t, err, gosync.ErrReadOnly)
+
+		_, err = client.GetChannelMembers(ctx, "channel-id", 0, 1)
+		assert.ErrorIs(t, err, ErrUnexpectedResponse)
+	})
+}
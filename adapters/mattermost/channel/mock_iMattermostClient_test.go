@@ -0,0 +1,209 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package channel
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockIMattermostClient is an autogenerated mock type for the iMattermostClient type
+type mockIMattermostClient struct {
+	mock.Mock
+}
+
+type mockIMattermostClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockIMattermostClient) EXPECT() *mockIMattermostClient_Expecter {
+	return &mockIMattermostClient_Expecter{mock: &_m.Mock}
+}
+
+// AddChannelMember provides a mock function with given fields: ctx, channelID, userID
+func (_m *mockIMattermostClient) AddChannelMember(ctx context.Context, channelID string, userID string) error {
+	ret := _m.Called(ctx, channelID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, channelID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockIMattermostClient_AddChannelMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddChannelMember'
+type mockIMattermostClient_AddChannelMember_Call struct {
+	*mock.Call
+}
+
+// AddChannelMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channelID string
+//   - userID string
+func (_e *mockIMattermostClient_Expecter) AddChannelMember(ctx interface{}, channelID interface{}, userID interface{}) *mockIMattermostClient_AddChannelMember_Call {
+	return &mockIMattermostClient_AddChannelMember_Call{Call: _e.mock.On("AddChannelMember", ctx, channelID, userID)}
+}
+
+func (_c *mockIMattermostClient_AddChannelMember_Call) Run(run func(ctx context.Context, channelID string, userID string)) *mockIMattermostClient_AddChannelMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockIMattermostClient_AddChannelMember_Call) Return(_a0 error) *mockIMattermostClient_AddChannelMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// GetChannelMembers provides a mock function with given fields: ctx, channelID, page, perPage
+func (_m *mockIMattermostClient) GetChannelMembers(ctx context.Context, channelID string, page int, perPage int) ([]user, error) {
+	ret := _m.Called(ctx, channelID, page, perPage)
+
+	var r0 []user
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []user); ok {
+		r0 = rf(ctx, channelID, page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, channelID, page, perPage)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockIMattermostClient_GetChannelMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChannelMembers'
+type mockIMattermostClient_GetChannelMembers_Call struct {
+	*mock.Call
+}
+
+// GetChannelMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channelID string
+//   - page int
+//   - perPage int
+func (_e *mockIMattermostClient_Expecter) GetChannelMembers(ctx interface{}, channelID interface{}, page interface{}, perPage interface{}) *mockIMattermostClient_GetChannelMembers_Call {
+	return &mockIMattermostClient_GetChannelMembers_Call{Call: _e.mock.On("GetChannelMembers", ctx, channelID, page, perPage)}
+}
+
+func (_c *mockIMattermostClient_GetChannelMembers_Call) Run(run func(ctx context.Context, channelID string, page int, perPage int)) *mockIMattermostClient_GetChannelMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *mockIMattermostClient_GetChannelMembers_Call) Return(_a0 []user, _a1 error) *mockIMattermostClient_GetChannelMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetUserIDByEmail provides a mock function with given fields: ctx, email
+func (_m *mockIMattermostClient) GetUserIDByEmail(ctx context.Context, email string) (string, error) {
+	ret := _m.Called(ctx, email)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockIMattermostClient_GetUserIDByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIDByEmail'
+type mockIMattermostClient_GetUserIDByEmail_Call struct {
+	*mock.Call
+}
+
+// GetUserIDByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *mockIMattermostClient_Expecter) GetUserIDByEmail(ctx interface{}, email interface{}) *mockIMattermostClient_GetUserIDByEmail_Call {
+	return &mockIMattermostClient_GetUserIDByEmail_Call{Call: _e.mock.On("GetUserIDByEmail", ctx, email)}
+}
+
+func (_c *mockIMattermostClient_GetUserIDByEmail_Call) Run(run func(ctx context.Context, email string)) *mockIMattermostClient_GetUserIDByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockIMattermostClient_GetUserIDByEmail_Call) Return(_a0 string, _a1 error) *mockIMattermostClient_GetUserIDByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// RemoveChannelMember provides a mock function with given fields: ctx, channelID, userID
+func (_m *mockIMattermostClient) RemoveChannelMember(ctx context.Context, channelID string, userID string) error {
+	ret := _m.Called(ctx, channelID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, channelID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockIMattermostClient_RemoveChannelMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveChannelMember'
+type mockIMattermostClient_RemoveChannelMember_Call struct {
+	*mock.Call
+}
+
+// RemoveChannelMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channelID string
+//   - userID string
+func (_e *mockIMattermostClient_Expecter) RemoveChannelMember(ctx interface{}, channelID interface{}, userID interface{}) *mockIMattermostClient_RemoveChannelMember_Call {
+	return &mockIMattermostClient_RemoveChannelMember_Call{Call: _e.mock.On("RemoveChannelMember", ctx, channelID, userID)}
+}
+
+func (_c *mockIMattermostClient_RemoveChannelMember_Call) Run(run func(ctx context.Context, channelID string, userID string)) *mockIMattermostClient_RemoveChannelMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockIMattermostClient_RemoveChannelMember_Call) Return(_a0 error) *mockIMattermostClient_RemoveChannelMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+type mockConstructorTestingTnewMockIMattermostClient interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// newMockIMattermostClient creates a new instance of mockIMattermostClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func newMockIMattermostClient(t mockConstructorTestingTnewMockIMattermostClient) *mockIMattermostClient {
+	mock := &mockIMattermostClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
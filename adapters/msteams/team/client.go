@@ -0,0 +1,149 @@
+package team
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrUnexpectedResponse is returned when the Microsoft Graph API responds with an unexpected status code.
+var ErrUnexpectedResponse = errors.New("unexpected response from microsoft graph")
+
+// graphURL is the Microsoft Graph v1.0 API.
+const graphURL = "https://graph.microsoft.com/v1.0"
+
+// user is a Microsoft Graph user, with only the properties used by the adapter.
+type user struct {
+	ID                string `json:"id"`
+	Mail              string `json:"mail"`
+	UserPrincipalName string `json:"userPrincipalName"`
+}
+
+// Client is a minimal Microsoft Graph API client for managing the members and owners of the group behind a team.
+type Client struct {
+	httpClient *http.Client
+	server     string
+}
+
+// NewClient creates a new Microsoft Graph client. The HTTP client must authenticate its requests, e.g. one built by
+// golang.org/x/oauth2/clientcredentials for an app registration with the GroupMember.ReadWrite.All and User.Read.All
+// application permissions.
+func NewClient(httpClient *http.Client) *Client {
+	return &Client{
+		httpClient: httpClient,
+		server:     graphURL,
+	}
+}
+
+// do makes a request to the Microsoft Graph API, and decodes the response into out if it isn't nil.
+func (c *Client) do(ctx context.Context, method string, endpoint string, body interface{}, out interface{}) error {
+	var reader io.Reader
+
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshal -> %w", err)
+		}
+
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return fmt.Errorf("newrequest(%s, %s) -> %w", method, endpoint, err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	// Required for $filter queries that match on mail or userPrincipalName.
+	req.Header.Set("ConsistencyLevel", "eventual")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("do(%s, %s) -> %w", method, endpoint, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("do(%s, %s) -> %w: %s", method, endpoint, ErrUnexpectedResponse, res.Status)
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+			return fmt.Errorf("decode(%s, %s) -> %w", method, endpoint, err)
+		}
+	}
+
+	return nil
+}
+
+// GetMembers gets a page of users with a role in a team's group, and the link to the next page, which is empty on the
+// last page. Pass an empty nextLink to get the first page. Members that aren't users, e.g. service principals, are
+// skipped.
+func (c *Client) GetMembers(ctx context.Context, teamID string, role Role, nextLink string) ([]user, string, error) {
+	if nextLink == "" {
+		query := url.Values{
+			"$select": {"id,mail,userPrincipalName"},
+			"$top":    {"999"},
+		}
+		nextLink = c.server + "/groups/" + url.PathEscape(teamID) + "/" + string(role) + "/microsoft.graph.user?" +
+			query.Encode()
+	}
+
+	var page struct {
+		Value    []user `json:"value"`
+		NextLink string `json:"@odata.nextLink"`
+	}
+
+	if err := c.do(ctx, http.MethodGet, nextLink, nil, &page); err != nil {
+		return nil, "", err
+	}
+
+	return page.Value, page.NextLink, nil
+}
+
+// GetUserIDByEmail gets the ID of a user by their mail or user principal name, or an empty string if they don't exist.
+func (c *Client) GetUserIDByEmail(ctx context.Context, email string) (string, error) {
+	// Single quotes are escaped by doubling them in OData string literals.
+	literal := "'" + strings.ReplaceAll(email, "'", "''") + "'"
+	query := url.Values{
+		"$filter": {"mail eq " + literal + " or userPrincipalName eq " + literal},
+		"$select": {"id"},
+		"$count":  {"true"},
+	}
+
+	var page struct {
+		Value []user `json:"value"`
+	}
+
+	if err := c.do(ctx, http.MethodGet, c.server+"/users?"+query.Encode(), nil, &page); err != nil {
+		return "", err
+	}
+
+	if len(page.Value) == 0 {
+		return "", nil
+	}
+
+	return page.Value[0].ID, nil
+}
+
+// AddMember gives a user a role in a team's group, by adding a reference to the user to the group's members or owners.
+func (c *Client) AddMember(ctx context.Context, teamID string, role Role, userID string) error {
+	ref := map[string]string{"@odata.id": c.server + "/directoryObjects/" + url.PathEscape(userID)}
+	path := "/groups/" + url.PathEscape(teamID) + "/" + string(role) + "/$ref"
+
+	return c.do(ctx, http.MethodPost, c.server+path, ref, nil)
+}
+
+// RemoveMember removes a user's role in a team's group, by deleting the reference to the user from the group's members
+// or owners.
+func (c *Client) RemoveMember(ctx context.Context, teamID string, role Role, userID string) error {
+	path := "/groups/" + url.PathEscape(teamID) + "/" + string(role) + "/" + url.PathEscape(userID) + "/$ref"
+
+	return c.do(ctx, http.MethodDelete, c.server+path, nil, nil)
+}
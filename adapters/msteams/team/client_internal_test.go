@@ -0,0 +1,136 @@
+package team
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestClient creates a client that sends requests to a test server.
+func newTestClient(server *httptest.Server) *Client {
+	client := NewClient(server.Client())
+	client.server = server.URL
+
+	return client
+}
+
+//nolint:funlen
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("GetMembers", func(t *testing.T) {
+		t.Parallel()
+
+		var server *httptest.Server
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/groups/group-id/owners/microsoft.graph.user", r.URL.Path)
+
+			if r.URL.Query().Get("$skiptoken") == "" {
+				assert.Equal(t, "id,mail,userPrincipalName", r.URL.Query().Get("$select"))
+
+				_, _ = w.Write([]byte(`{"value":[{"id":"foo","mail":"foo@email","userPrincipalName":"foo@tenant"}],` +
+					`"@odata.nextLink":"` + server.URL + `/groups/group-id/owners/microsoft.graph.user?$skiptoken=x"}`))
+
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"value":[{"id":"bar","mail":null,"userPrincipalName":"bar@tenant"}]}`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(server)
+
+		users, next, err := client.GetMembers(ctx, "group-id", Owners, "")
+		assert.NoError(t, err)
+		assert.Equal(t, []user{{ID: "foo", Mail: "foo@email", UserPrincipalName: "foo@tenant"}}, users)
+		assert.Equal(t, server.URL+"/groups/group-id/owners/microsoft.graph.user?$skiptoken=x", next)
+
+		users, next, err = client.GetMembers(ctx, "group-id", Owners, next)
+		assert.NoError(t, err)
+		assert.Equal(t, []user{{ID: "bar", UserPrincipalName: "bar@tenant"}}, users)
+		assert.Empty(t, next)
+	})
+
+	t.Run("GetUserIDByEmail", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/users", r.URL.Path)
+			assert.Equal(t, "eventual", r.Header.Get("ConsistencyLevel"))
+
+			if r.URL.Query().Get("$filter") != "mail eq 'o''foo@email' or userPrincipalName eq 'o''foo@email'" {
+				_, _ = w.Write([]byte(`{"value":[]}`))
+
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"value":[{"id":"foo"}]}`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(server)
+
+		id, err := client.GetUserIDByEmail(ctx, "o'foo@email")
+		assert.NoError(t, err)
+		assert.Equal(t, "foo", id)
+
+		id, err = client.GetUserIDByEmail(ctx, "bar@email")
+		assert.NoError(t, err)
+		assert.Empty(t, id)
+	})
+
+	t.Run("AddMember/RemoveMember", func(t *testing.T) {
+		t.Parallel()
+
+		var server *httptest.Server
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPost:
+				var body map[string]string
+
+				assert.Equal(t, "/groups/group-id/members/$ref", r.URL.Path)
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, map[string]string{"@odata.id": server.URL + "/directoryObjects/foo"}, body)
+
+				w.WriteHeader(http.StatusNoContent)
+			case http.MethodDelete:
+				assert.Equal(t, "/groups/group-id/members/foo/$ref", r.URL.Path)
+
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				t.Errorf("unexpected method %s", r.Method)
+			}
+		}))
+		defer server.Close()
+
+		client := newTestClient(server)
+
+		assert.NoError(t, client.AddMember(ctx, "group-id", Members, "foo"))
+		assert.NoError(t, client.RemoveMember(ctx, "group-id", Members, "foo"))
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		client := newTestClient(server)
+
+		err := client.RemoveMember(ctx, "group-id", Members, "foo")
+		assert.ErrorIs(t, err, ErrUnexpectedResponse)
+
+		_, _, err = client.GetMembers(ctx, "group-id", Owners, "")
+		assert.ErrorIs(t, err, ErrUnexpectedResponse)
+	})
+}
@@ -0,0 +1,218 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package team
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockIGraphClient is an autogenerated mock type for the iGraphClient type
+type mockIGraphClient struct {
+	mock.Mock
+}
+
+type mockIGraphClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockIGraphClient) EXPECT() *mockIGraphClient_Expecter {
+	return &mockIGraphClient_Expecter{mock: &_m.Mock}
+}
+
+// AddMember provides a mock function with given fields: ctx, teamID, role, userID
+func (_m *mockIGraphClient) AddMember(ctx context.Context, teamID string, role Role, userID string) error {
+	ret := _m.Called(ctx, teamID, role, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, Role, string) error); ok {
+		r0 = rf(ctx, teamID, role, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockIGraphClient_AddMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMember'
+type mockIGraphClient_AddMember_Call struct {
+	*mock.Call
+}
+
+// AddMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamID string
+//   - role Role
+//   - userID string
+func (_e *mockIGraphClient_Expecter) AddMember(ctx interface{}, teamID interface{}, role interface{}, userID interface{}) *mockIGraphClient_AddMember_Call {
+	return &mockIGraphClient_AddMember_Call{Call: _e.mock.On("AddMember", ctx, teamID, role, userID)}
+}
+
+func (_c *mockIGraphClient_AddMember_Call) Run(run func(ctx context.Context, teamID string, role Role, userID string)) *mockIGraphClient_AddMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(Role), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *mockIGraphClient_AddMember_Call) Return(_a0 error) *mockIGraphClient_AddMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// GetMembers provides a mock function with given fields: ctx, teamID, role, nextLink
+func (_m *mockIGraphClient) GetMembers(ctx context.Context, teamID string, role Role, nextLink string) ([]user, string, error) {
+	ret := _m.Called(ctx, teamID, role, nextLink)
+
+	var r0 []user
+	if rf, ok := ret.Get(0).(func(context.Context, string, Role, string) []user); ok {
+		r0 = rf(ctx, teamID, role, nextLink)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(context.Context, string, Role, string) string); ok {
+		r1 = rf(ctx, teamID, role, nextLink)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, Role, string) error); ok {
+		r2 = rf(ctx, teamID, role, nextLink)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// mockIGraphClient_GetMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMembers'
+type mockIGraphClient_GetMembers_Call struct {
+	*mock.Call
+}
+
+// GetMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamID string
+//   - role Role
+//   - nextLink string
+func (_e *mockIGraphClient_Expecter) GetMembers(ctx interface{}, teamID interface{}, role interface{}, nextLink interface{}) *mockIGraphClient_GetMembers_Call {
+	return &mockIGraphClient_GetMembers_Call{Call: _e.mock.On("GetMembers", ctx, teamID, role, nextLink)}
+}
+
+func (_c *mockIGraphClient_GetMembers_Call) Run(run func(ctx context.Context, teamID string, role Role, nextLink string)) *mockIGraphClient_GetMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(Role), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *mockIGraphClient_GetMembers_Call) Return(_a0 []user, _a1 string, _a2 error) *mockIGraphClient_GetMembers_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+// GetUserIDByEmail provides a mock function with given fields: ctx, email
+func (_m *mockIGraphClient) GetUserIDByEmail(ctx context.Context, email string) (string, error) {
+	ret := _m.Called(ctx, email)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockIGraphClient_GetUserIDByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIDByEmail'
+type mockIGraphClient_GetUserIDByEmail_Call struct {
+	*mock.Call
+}
+
+// GetUserIDByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *mockIGraphClient_Expecter) GetUserIDByEmail(ctx interface{}, email interface{}) *mockIGraphClient_GetUserIDByEmail_Call {
+	return &mockIGraphClient_GetUserIDByEmail_Call{Call: _e.mock.On("GetUserIDByEmail", ctx, email)}
+}
+
+func (_c *mockIGraphClient_GetUserIDByEmail_Call) Run(run func(ctx context.Context, email string)) *mockIGraphClient_GetUserIDByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockIGraphClient_GetUserIDByEmail_Call) Return(_a0 string, _a1 error) *mockIGraphClient_GetUserIDByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// RemoveMember provides a mock function with given fields: ctx, teamID, role, userID
+func (_m *mockIGraphClient) RemoveMember(ctx context.Context, teamID string, role Role, userID string) error {
+	ret := _m.Called(ctx, teamID, role, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, Role, string) error); ok {
+		r0 = rf(ctx, teamID, role, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockIGraphClient_RemoveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMember'
+type mockIGraphClient_RemoveMember_Call struct {
+	*mock.Call
+}
+
+// RemoveMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamID string
+//   - role Role
+//   - userID string
+func (_e *mockIGraphClient_Expecter) RemoveMember(ctx interface{}, teamID interface{}, role interface{}, userID interface{}) *mockIGraphClient_RemoveMember_Call {
+	return &mockIGraphClient_RemoveMember_Call{Call: _e.mock.On("RemoveMember", ctx, teamID, role, userID)}
+}
+
+func (_c *mockIGraphClient_RemoveMember_Call) Run(run func(ctx context.Context, teamID string, role Role, userID string)) *mockIGraphClient_RemoveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(Role), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *mockIGraphClient_RemoveMember_Call) Return(_a0 error) *mockIGraphClient_RemoveMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+type mockConstructorTestingTnewMockIGraphClient interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// newMockIGraphClient creates a new instance of mockIGraphClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func newMockIGraphClient(t mockConstructorTestingTnewMockIGraphClient) *mockIGraphClient {
+	mock := &mockIGraphClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,245 @@
+/*
+Package team synchronises email addresses with the members of a Microsoft Teams team.
+
+Every team is backed by a Microsoft 365 group with the same ID, so the adapter manages the group's members (or owners)
+with Microsoft Graph. In order to use this adapter, you'll need an app registration with the GroupMember.ReadWrite.All
+and User.Read.All application permissions, and an HTTP client authenticated as it.
+*/
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	gosync "github.com/ovotech/go-sync"
+)
+
+// Ensure the adapter type fully satisfies the ports.Adapter and ports.ConfiguredAdapter interfaces.
+var (
+	_ gosync.Adapter           = &Team{}
+	_ gosync.ConfiguredAdapter = &Team{}
+)
+
+// ErrUserNotFound is returned when an email can't be resolved to an Entra ID user.
+var ErrUserNotFound = errors.New("user not found")
+
+// Role is the role in a team that the adapter manages.
+type Role string
+
+const (
+	// Members are the team's members, excluding its owners.
+	Members Role = "members"
+	// Owners are the team's owners, who can manage the team's settings and members.
+	Owners Role = "owners"
+)
+
+// iGraphClient is a subset of the Microsoft Graph Client, and used to build mocks for easy testing.
+type iGraphClient interface {
+	GetMembers(ctx context.Context, teamID string, role Role, nextLink string) ([]user, string, error)
+	GetUserIDByEmail(ctx context.Context, email string) (string, error)
+	AddMember(ctx context.Context, teamID string, role Role, userID string) error
+	RemoveMember(ctx context.Context, teamID string, role Role, userID string) error
+}
+
+type Team struct {
+	client iGraphClient
+	teamID string
+	role   Role // role is the role in the team that's managed.
+	// owners stores the email -> user ID mapping of the team's owners, who are left alone when managing members.
+	owners map[string]string
+	// cache stores the email -> user ID mapping for use with the Add/Remove methods.
+	cache  map[string]string
+	logger *log.Logger
+}
+
+// WithRole sets the role in the team that's managed. Default is Members, which leaves the team's owners alone.
+func WithRole(role Role) func(*Team) {
+	return func(team *Team) {
+		team.role = role
+	}
+}
+
+// WithLogger sets a custom logger.
+func WithLogger(logger *log.Logger) func(*Team) {
+	return func(team *Team) {
+		team.logger = logger
+	}
+}
+
+// New instantiates a new Microsoft Teams adapter, for the team with the given ID.
+func New(client *Client, teamID string, optsFn ...func(*Team)) *Team {
+	team := &Team{
+		client: client,
+		teamID: teamID,
+		role:   Members,
+		owners: nil,
+		cache:  nil,
+		logger: log.New(os.Stderr, "[go-sync/msteams/team] ", log.LstdFlags|log.Lshortfile|log.Lmsgprefix),
+	}
+
+	for _, fn := range optsFn {
+		fn(team)
+	}
+
+	return team
+}
+
+// Config returns the adapter's configuration.
+func (t *Team) Config() map[string]string {
+	return map[string]string{
+		"team": t.teamID,
+		"role": string(t.role),
+	}
+}
+
+// email returns a user's mail attribute, or their user principal name if they don't have one.
+func email(user user) string {
+	if user.Mail != "" {
+		return user.Mail
+	}
+
+	return user.UserPrincipalName
+}
+
+// getUsers gets every user with a role in the team, following Graph's paging.
+func (t *Team) getUsers(ctx context.Context, role Role) ([]user, error) {
+	users := make([]user, 0)
+	nextLink := ""
+
+	for {
+		page, next, err := t.client.GetMembers(ctx, t.teamID, role, nextLink)
+		if err != nil {
+			return nil, fmt.Errorf("getmembers(%s, %s) -> %w", t.teamID, role, err)
+		}
+
+		users = append(users, page...)
+
+		if next == "" {
+			return users, nil
+		}
+
+		nextLink = next
+	}
+}
+
+// Get emails of users with the managed role in a team. Users without a mail attribute are returned by their user
+// principal name instead. Owners are also members of the team's group, so when managing members, they're excluded.
+func (t *Team) Get(ctx context.Context) ([]string, error) {
+	t.logger.Printf("Fetching %s from Microsoft Teams team %s", t.role, t.teamID)
+
+	// Initialise the cache.
+	t.cache = make(map[string]string)
+	t.owners = make(map[string]string)
+
+	users, err := t.getUsers(ctx, t.role)
+	if err != nil {
+		return nil, fmt.Errorf("msteams.team.get -> %w", err)
+	}
+
+	if t.role == Members {
+		owners, err := t.getUsers(ctx, Owners)
+		if err != nil {
+			return nil, fmt.Errorf("msteams.team.get -> %w", err)
+		}
+
+		for _, owner := range owners {
+			t.owners[email(owner)] = owner.ID
+		}
+	}
+
+	emails := make([]string, 0, len(users))
+
+	for _, user := range users {
+		if _, ok := t.owners[email(user)]; ok {
+			continue
+		}
+
+		emails = append(emails, email(user))
+
+		// Add the email -> ID map for use with the Add/Remove methods.
+		t.cache[email(user)] = user.ID
+	}
+
+	t.logger.Println("Fetched accounts successfully")
+
+	return emails, nil
+}
+
+// getUserID resolves the ID of a user from their email, and caches it for subsequent calls.
+func (t *Team) getUserID(ctx context.Context, email string) (string, error) {
+	if id, ok := t.cache[email]; ok {
+		return id, nil
+	}
+
+	id, err := t.client.GetUserIDByEmail(ctx, email)
+	if err != nil {
+		return "", fmt.Errorf("getuseridbyemail(%s) -> %w", email, err)
+	}
+
+	if id == "" {
+		return "", fmt.Errorf("getuseridbyemail(%s) -> %w", email, ErrUserNotFound)
+	}
+
+	if t.cache != nil {
+		t.cache[email] = id
+	}
+
+	return id, nil
+}
+
+// Add emails to a team with the managed role. When managing members, owners are skipped, as they're already members.
+func (t *Team) Add(ctx context.Context, emails []string) error {
+	t.logger.Printf("Adding %s to Microsoft Teams team %s as %s", emails, t.teamID, t.role)
+
+	for index, email := range emails {
+		if _, ok := t.owners[email]; ok && t.role == Members {
+			t.logger.Printf("Skipping %s, as they're already an owner", email)
+
+			continue
+		}
+
+		userID, err := t.getUserID(ctx, email)
+		if err != nil {
+			return fmt.Errorf("msteams.team.add -> %w", err)
+		}
+
+		err = t.client.AddMember(ctx, t.teamID, t.role, userID)
+		if err != nil {
+			return fmt.Errorf("msteams.team.add.addmember(%s, %s) -> %w", t.teamID, email, err)
+		}
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
+	}
+
+	t.logger.Println("Finished adding accounts successfully")
+
+	return nil
+}
+
+// Remove emails from the managed role in a team.
+func (t *Team) Remove(ctx context.Context, emails []string) error {
+	t.logger.Printf("Removing %s from Microsoft Teams team %s as %s", emails, t.teamID, t.role)
+
+	// If the cache hasn't been generated, regenerate it.
+	if t.cache == nil {
+		return fmt.Errorf("msteams.team.remove -> %w", gosync.ErrCacheEmpty)
+	}
+
+	for index, email := range emails {
+		err := t.client.RemoveMember(ctx, t.teamID, t.role, t.cache[email])
+		if err != nil {
+			return fmt.Errorf("msteams.team.remove.removemember(%s, %s) -> %w", t.teamID, email, err)
+		}
+
+		delete(t.cache, email)
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
+	}
+
+	t.logger.Println("Finished removing accounts successfully")
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	gosync "github.com/ovotech/go-sync"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockedAdapter(t *testing.T, optsFn ...func(*Team)) (*Team, *mockIGraphClient) {
+	t.Helper()
+
+	client := newMockIGraphClient(t)
+	team := New(NewClient(http.DefaultClient), "team-id", optsFn...)
+	team.client = client
+
+	return team, client
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	team := New(NewClient(http.DefaultClient), "team-id")
+
+	assert.Equal(t, "team-id", team.teamID)
+	assert.Nil(t, team.cache)
+	assert.Equal(t, map[string]string{"team": "team-id", "role": "members"}, team.Config())
+	assert.Equal(t, Owners, New(NewClient(http.DefaultClient), "team-id", WithRole(Owners)).role)
+}
+
+func TestTeam_Get(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Members", func(t *testing.T) {
+		t.Parallel()
+
+		team, client := createMockedAdapter(t)
+
+		client.EXPECT().GetMembers(ctx, "team-id", Members, "").Return([]user{
+			{ID: "foo", Mail: "foo@email", UserPrincipalName: "foo@tenant"},
+			{ID: "owner", Mail: "owner@email", UserPrincipalName: "owner@tenant"},
+		}, "https://graph/next", nil)
+		client.EXPECT().GetMembers(ctx, "team-id", Members, "https://graph/next").Return([]user{
+			{ID: "bar", Mail: "", UserPrincipalName: "bar@tenant"},
+		}, "", nil)
+		client.EXPECT().GetMembers(ctx, "team-id", Owners, "").Return([]user{
+			{ID: "owner", Mail: "owner@email", UserPrincipalName: "owner@tenant"},
+		}, "", nil)
+
+		emails, err := team.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email", "bar@tenant"}, emails)
+		assert.Equal(t, map[string]string{"foo@email": "foo", "bar@tenant": "bar"}, team.cache)
+		assert.Equal(t, map[string]string{"owner@email": "owner"}, team.owners)
+	})
+
+	t.Run("Owners", func(t *testing.T) {
+		t.Parallel()
+
+		team, client := createMockedAdapter(t, WithRole(Owners))
+
+		client.EXPECT().GetMembers(ctx, "team-id", Owners, "").Return([]user{
+			{ID: "owner", Mail: "owner@email", UserPrincipalName: "owner@tenant"},
+		}, "", nil)
+
+		emails, err := team.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"owner@email"}, emails)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		testErr := errors.New("foo") //nolint:goerr113
+
+		team, client := createMockedAdapter(t)
+
+		client.EXPECT().GetMembers(ctx, "team-id", Members, "").Return(nil, "", testErr)
+
+		_, err := team.Get(ctx)
+
+		assert.ErrorIs(t, err, testErr)
+	})
+}
+
+func TestTeam_Add(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		team, client := createMockedAdapter(t)
+		team.cache = map[string]string{"foo@email": "foo"}
+		team.owners = map[string]string{"owner@email": "owner"}
+
+		// foo is already cached, so only bar needs resolving, and owners are already members.
+		client.EXPECT().GetUserIDByEmail(ctx, "bar@email").Once().Return("bar", nil)
+		client.EXPECT().AddMember(ctx, "team-id", Members, "foo").Return(nil)
+		client.EXPECT().AddMember(ctx, "team-id", Members, "bar").Return(nil)
+
+		err := team.Add(ctx, []string{"foo@email", "bar@email", "owner@email"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "bar", team.cache["bar@email"])
+	})
+
+	t.Run("Owners", func(t *testing.T) {
+		t.Parallel()
+
+		team, client := createMockedAdapter(t, WithRole(Owners))
+
+		client.EXPECT().GetUserIDByEmail(ctx, "foo@email").Return("foo", nil)
+		client.EXPECT().AddMember(ctx, "team-id", Owners, "foo").Return(nil)
+
+		assert.NoError(t, team.Add(ctx, []string{"foo@email"}))
+	})
+
+	t.Run("User not found", func(t *testing.T) {
+		t.Parallel()
+
+		team, client := createMockedAdapter(t)
+
+		client.EXPECT().GetUserIDByEmail(ctx, "foo@email").Return("", nil)
+
+		err := team.Add(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
+
+func TestTeam_Remove(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		team, client := createMockedAdapter(t)
+		team.cache = map[string]string{"foo@email": "foo", "bar@email": "bar"}
+
+		client.EXPECT().RemoveMember(ctx, "team-id", Members, "foo").Return(nil)
+
+		err := team.Remove(ctx, []string{"foo@email"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"bar@email": "bar"}, team.cache)
+	})
+
+	t.Run("Cache empty", func(t *testing.T) {
+		t.Parallel()
+
+		team := New(NewClient(http.DefaultClient), "team-id")
+
+		err := team.Remove(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, gosync.ErrCacheEmpty)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		testErr := errors.New("foo") //nolint:goerr113
+
+		team, client := createMockedAdapter(t)
+		team.cache = map[string]string{"foo@email": "foo"}
+
+		client.EXPECT().RemoveMember(ctx, "team-id", Members, "foo").Return(testErr)
+
+		err := team.Remove(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, testErr)
+	})
+}
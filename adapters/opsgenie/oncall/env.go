@@ -0,0 +1,71 @@
+package oncall
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/opsgenie/opsgenie-go-sdk-v2/client"
+	gosync "github.com/ovotech/go-sync"
+)
+
+// Environment variables used to configure the adapter with NewFromEnv.
+const (
+	EnvAPIKey     = "OPSGENIE_API_KEY"     // Required.
+	EnvScheduleID = "OPSGENIE_SCHEDULE_ID" // Required.
+	EnvAPIURL     = "OPSGENIE_API_URL"     // Optional, e.g. api.eu.opsgenie.com. Defaults to api.opsgenie.com.
+	EnvDate       = "OPSGENIE_DATE"        // Optional RFC 3339 timestamp, see WithDate.
+	EnvCacheTTL   = "OPSGENIE_CACHE_TTL"   // Optional duration, e.g. 5m, see WithCacheTTL.
+)
+
+// NewFromEnv instantiates a new Opsgenie OnCall adapter, configured using environment variables.
+// New remains the primary way to build the adapter; this is a convenience for containerised deployments.
+func NewFromEnv(optsFn ...func(schedule *OnCall)) (*OnCall, error) {
+	var missing []string
+
+	apiKey, scheduleID := os.Getenv(EnvAPIKey), os.Getenv(EnvScheduleID)
+
+	if apiKey == "" {
+		missing = append(missing, EnvAPIKey)
+	}
+
+	if scheduleID == "" {
+		missing = append(missing, EnvScheduleID)
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("opsgenie.oncall.newfromenv -> %w: %s", gosync.ErrMissingEnv, strings.Join(missing, ", "))
+	}
+
+	// The environment is applied first, so options passed in take precedence.
+	var envOpts []func(*OnCall)
+
+	if value := os.Getenv(EnvDate); value != "" {
+		date, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("opsgenie.oncall.newfromenv(%s) -> %w", EnvDate, err)
+		}
+
+		envOpts = append(envOpts, WithDate(date))
+	}
+
+	if value := os.Getenv(EnvCacheTTL); value != "" {
+		ttl, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("opsgenie.oncall.newfromenv(%s) -> %w", EnvCacheTTL, err)
+		}
+
+		envOpts = append(envOpts, WithCacheTTL(ttl))
+	}
+
+	onCall, err := New(&client.Config{
+		ApiKey:         apiKey,
+		OpsGenieAPIURL: client.ApiUrl(os.Getenv(EnvAPIURL)),
+	}, scheduleID, append(envOpts, optsFn...)...)
+	if err != nil {
+		return nil, fmt.Errorf("opsgenie.oncall.newfromenv -> %w", err)
+	}
+
+	return onCall, nil
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package oncall
+
+import (
+	context "context"
+
+	team "github.com/opsgenie/opsgenie-go-sdk-v2/team"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockIOpsgenieTeam is an autogenerated mock type for the iOpsgenieTeam type
+type mockIOpsgenieTeam struct {
+	mock.Mock
+}
+
+type mockIOpsgenieTeam_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockIOpsgenieTeam) EXPECT() *mockIOpsgenieTeam_Expecter {
+	return &mockIOpsgenieTeam_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: _a0, request
+func (_m *mockIOpsgenieTeam) Get(_a0 context.Context, request *team.GetTeamRequest) (*team.GetTeamResult, error) {
+	ret := _m.Called(_a0, request)
+
+	var r0 *team.GetTeamResult
+	if rf, ok := ret.Get(0).(func(context.Context, *team.GetTeamRequest) *team.GetTeamResult); ok {
+		r0 = rf(_a0, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*team.GetTeamResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *team.GetTeamRequest) error); ok {
+		r1 = rf(_a0, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockIOpsgenieTeam_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type mockIOpsgenieTeam_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - request *team.GetTeamRequest
+func (_e *mockIOpsgenieTeam_Expecter) Get(_a0 interface{}, request interface{}) *mockIOpsgenieTeam_Get_Call {
+	return &mockIOpsgenieTeam_Get_Call{Call: _e.mock.On("Get", _a0, request)}
+}
+
+func (_c *mockIOpsgenieTeam_Get_Call) Run(run func(_a0 context.Context, request *team.GetTeamRequest)) *mockIOpsgenieTeam_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*team.GetTeamRequest))
+	})
+	return _c
+}
+
+func (_c *mockIOpsgenieTeam_Get_Call) Return(_a0 *team.GetTeamResult, _a1 error) *mockIOpsgenieTeam_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+type mockConstructorTestingTnewMockIOpsgenieTeam interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// newMockIOpsgenieTeam creates a new instance of mockIOpsgenieTeam. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func newMockIOpsgenieTeam(t mockConstructorTestingTnewMockIOpsgenieTeam) *mockIOpsgenieTeam {
+	mock := &mockIOpsgenieTeam{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
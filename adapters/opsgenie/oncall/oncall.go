@@ -2,43 +2,155 @@ package oncall
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/opsgenie/opsgenie-go-sdk-v2/client"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/og"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/schedule"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/team"
 	gosync "github.com/ovotech/go-sync"
 )
 
-// Ensure the adapter type fully satisfies the ports.Adapter interface.
-var _ gosync.Adapter = &OnCall{}
+// Ensure the adapter type fully satisfies the ports.Adapter, ports.ReadOnlyAdapter, ports.ConfiguredAdapter and
+// ports.Namer interfaces.
+var (
+	_ gosync.Adapter           = &OnCall{}
+	_ gosync.ReadOnlyAdapter   = &OnCall{}
+	_ gosync.ConfiguredAdapter = &OnCall{}
+	_ gosync.Namer             = &OnCall{}
+)
+
+// ErrNotEmail is reported as a warning when an on-call recipient isn't an email, e.g. a team in a rotation.
+var ErrNotEmail = errors.New("on-call recipient isn't an email")
 
 type iOpsgenieSchedule interface {
 	GetOnCalls(context context.Context, request *schedule.GetOnCallsRequest) (*schedule.GetOnCallsResult, error)
 }
 
+type iOpsgenieTeam interface {
+	Get(context context.Context, request *team.GetTeamRequest) (*team.GetTeamResult, error)
+}
+
 type OnCall struct {
-	client     iOpsgenieSchedule
-	scheduleID string
-	getTime    func() time.Time
-	logger     *log.Logger
+	// By default, only the users on-call in the schedule's rotations are returned. Set to true to also include everyone
+	// in the schedule's escalation chain, e.g. secondary on-call or team leads.
+	IncludeEscalations bool
+	client             iOpsgenieSchedule
+	teamClient         iOpsgenieTeam
+	scheduleIDs        []string
+	// identifierType is whether scheduleIDs are the schedules' IDs (default) or names.
+	identifierType schedule.Identifier
+	getTime        func() time.Time
+	// exactDate is set by WithDate/WithDateFunc, so the date is part of the cache key rather than defaulting to now.
+	exactDate      bool
+	static         []string // static emails are always returned by Get, alongside those on-call.
+	teamRecipients bool     // teamRecipients expands recipients that aren't emails into their team's members.
+	cacheTTL       time.Duration
+	cache          map[cacheKey]cacheEntry // cache of on-call results, used if cacheTTL is set.
+	cacheMu        sync.Mutex
+	now            func() time.Time // now is the clock used to expire the cache.
+	structured     gosync.StructuredLogger
+	logger         *log.Logger
+}
+
+// WithStaticEmails always includes a fixed set of emails alongside those currently on-call, e.g. incident
+// commanders. Get returns the on-call emails first, followed by any static emails not already on-call.
+func WithStaticEmails(emails ...string) func(*OnCall) {
+	return func(onCall *OnCall) {
+		onCall.static = append(onCall.static, emails...)
+	}
+}
+
+// WithScheduleName looks up the schedules by the names passed to New or NewMulti, rather than their IDs.
+func WithScheduleName() func(*OnCall) {
+	return func(onCall *OnCall) {
+		onCall.identifierType = schedule.Name
+	}
+}
+
+// WithTeamRecipients treats on-call recipients that aren't emails as the names of teams, e.g. a team added to a
+// rotation, and expands them into the emails of the team's members with an extra request per team. By default,
+// recipients that aren't emails are skipped, and reported as an ErrNotEmail warning.
+func WithTeamRecipients() func(*OnCall) {
+	return func(onCall *OnCall) {
+		onCall.teamRecipients = true
+	}
 }
 
-// New instantiates a new Opsgenie OnCall adapter.
+// WithDate looks up who is on-call at a fixed point in time, rather than now, e.g. to provision access ahead of a
+// shift starting.
+func WithDate(date time.Time) func(*OnCall) {
+	return WithDateFunc(func() time.Time { return date })
+}
+
+// WithDateFunc looks up who is on-call at the time returned by fn each time Get is called, e.g. tomorrow morning for
+// an adapter used by a long-running process. Default is time.Now.
+func WithDateFunc(fn func() time.Time) func(*OnCall) {
+	return func(onCall *OnCall) {
+		onCall.getTime = fn
+		onCall.exactDate = true
+	}
+}
+
+// WithCacheTTL caches the result of each on-call request for ttl after it's fetched, so repeated calls to Get return
+// the cached result rather than querying Opsgenie again, e.g. when syncing one schedule with many destinations. By
+// default, results are cached per schedule, as the date is always now. With WithDate or WithDateFunc, they're cached
+// per schedule and exact date. Default is 0, which disables caching.
+func WithCacheTTL(ttl time.Duration) func(*OnCall) {
+	return func(onCall *OnCall) {
+		onCall.cacheTTL = ttl
+	}
+}
+
+// WithStructuredLogger logs to a structured logger (e.g. a *slog.Logger) instead, with the adapter and schedule as
+// fields. The summary of each Get also includes the number of emails.
+func WithStructuredLogger(logger gosync.StructuredLogger) func(*OnCall) {
+	return func(onCall *OnCall) {
+		onCall.structured = logger
+		onCall.logger = gosync.NewLogLogger(logger, onCall.logFields()...)
+	}
+}
+
+// New instantiates a new Opsgenie OnCall adapter, for the schedule with the given ID or name.
 func New(opsgenieConfig *client.Config, scheduleID string, optsFn ...func(schedule *OnCall)) (*OnCall, error) {
+	return NewMulti(opsgenieConfig, []string{scheduleID}, optsFn...)
+}
+
+// NewMulti instantiates a new Opsgenie OnCall adapter for several schedules, e.g. primary and secondary. Get returns
+// everyone currently on-call in any of the schedules, without duplicates.
+func NewMulti(opsgenieConfig *client.Config, scheduleIDs []string, optsFn ...func(schedule *OnCall)) (*OnCall, error) {
 	scheduleClient, err := schedule.NewClient(opsgenieConfig)
 
 	if err != nil {
 		return nil, fmt.Errorf("opsgenie.oncall.new -> %w", err)
 	}
 
+	teamClient, err := team.NewClient(opsgenieConfig)
+	if err != nil {
+		return nil, fmt.Errorf("opsgenie.oncall.new -> %w", err)
+	}
+
 	onCallAdapter := &OnCall{
-		client:     scheduleClient,
-		scheduleID: scheduleID,
-		getTime:    time.Now,
-		logger:     log.New(os.Stderr, "[go-sync/opsgenie/oncall]", log.LstdFlags|log.Lshortfile|log.Lmsgprefix),
+		IncludeEscalations: false,
+		client:             scheduleClient,
+		teamClient:         teamClient,
+		scheduleIDs:        scheduleIDs,
+		identifierType:     schedule.Id,
+		getTime:            time.Now,
+		exactDate:          false,
+		teamRecipients:     false,
+		cacheTTL:           0,
+		cache:              make(map[cacheKey]cacheEntry),
+		now:                time.Now,
+		structured:         nil,
+		logger:             log.New(os.Stderr, "[go-sync/opsgenie/oncall]", log.LstdFlags|log.Lshortfile|log.Lmsgprefix),
 	}
 
 	for _, fn := range optsFn {
@@ -50,25 +162,242 @@ func New(opsgenieConfig *client.Config, scheduleID string, optsFn ...func(schedu
 
 // Get emails of users currently on-call in on-call.
 func (o *OnCall) Get(ctx context.Context) ([]string, error) {
-	o.logger.Printf("Fetching users currently on-call in Opsgenie schedule %s", o.scheduleID)
+	o.logger.Printf("Fetching users currently on-call in Opsgenie schedule %s", strings.Join(o.scheduleIDs, ", "))
 
 	date := o.getTime()
-	flat := true
-	onCallRequest := &schedule.GetOnCallsRequest{
-		Flat:                   &flat,
-		Date:                   &date,
-		ScheduleIdentifierType: schedule.Id,
-		ScheduleIdentifier:     o.scheduleID,
+	flat := !o.IncludeEscalations
+	lists := make([][]string, 0, len(o.scheduleIDs))
+
+	for _, scheduleID := range o.scheduleIDs {
+		onCallRequest := &schedule.GetOnCallsRequest{
+			Flat:                   &flat,
+			Date:                   &date,
+			ScheduleIdentifierType: o.identifierType,
+			ScheduleIdentifier:     scheduleID,
+		}
+
+		result, err := o.getOnCalls(ctx, onCallRequest)
+		if err != nil {
+			return nil, fmt.Errorf("opsgenie.oncall.get.getoncalls(%s) -> %w", scheduleID, err)
+		}
+
+		recipients := result.OnCallRecipients
+		if o.IncludeEscalations {
+			recipients = union(participantEmails(result.OnCallParticipants))
+		}
+
+		emails, err := o.recipientEmails(ctx, recipients)
+		if err != nil {
+			return nil, fmt.Errorf("opsgenie.oncall.get(%s) -> %w", scheduleID, err)
+		}
+
+		lists = append(lists, emails)
+	}
+
+	// Combine the schedules, so anyone on-call in more than one is only returned once.
+	var emails []string
+	if len(lists) == 1 {
+		emails = lists[0]
+	} else {
+		emails = union(lists...)
+	}
+
+	if len(o.static) > 0 {
+		emails = union(emails, o.static)
+	}
+
+	o.logSummary("Fetched on-call users successfully", len(emails))
+
+	return emails, nil
+}
+
+// recipientEmails returns the emails of on-call recipients. Recipients that aren't emails are expanded into their
+// team's members if WithTeamRecipients is set, or skipped with an ErrNotEmail warning otherwise.
+func (o *OnCall) recipientEmails(ctx context.Context, recipients []string) ([]string, error) {
+	emails := make([]string, 0, len(recipients))
+	expanded := false
+
+	for _, recipient := range recipients {
+		if strings.Contains(recipient, "@") {
+			emails = append(emails, recipient)
+
+			continue
+		}
+
+		if !o.teamRecipients {
+			o.logger.Printf("Skipping on-call recipient %s, as it isn't an email", recipient)
+			gosync.Warn(ctx, fmt.Errorf("opsgenie.oncall.get(%s) -> %w", recipient, ErrNotEmail))
+
+			continue
+		}
+
+		result, err := o.teamClient.Get(ctx, &team.GetTeamRequest{
+			IdentifierType:  team.Name,
+			IdentifierValue: recipient,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("team.get(%s) -> %w", recipient, err)
+		}
+
+		// Opsgenie usernames are emails.
+		for _, member := range result.Members {
+			emails = append(emails, member.User.Username)
+		}
+
+		expanded = true
+	}
+
+	// Someone may be on-call both directly and as a member of a team.
+	if expanded {
+		return union(emails), nil
+	}
+
+	return emails, nil
+}
+
+// cacheKey identifies an on-call request in the cache. The date is zero unless exactDate is set.
+type cacheKey struct {
+	scheduleID     string
+	identifierType schedule.Identifier
+	date           time.Time
+	flat           bool
+}
+
+// cacheEntry is a cached on-call result, which is used until it expires.
+type cacheEntry struct {
+	result  *schedule.GetOnCallsResult
+	expires time.Time
+}
+
+// getOnCalls gets the result of an on-call request, from the cache if caching is enabled and it hasn't expired.
+func (o *OnCall) getOnCalls(
+	ctx context.Context,
+	request *schedule.GetOnCallsRequest,
+) (*schedule.GetOnCallsResult, error) {
+	if o.cacheTTL <= 0 {
+		return o.client.GetOnCalls(ctx, request) //nolint:wrapcheck
+	}
+
+	key := cacheKey{
+		scheduleID:     request.ScheduleIdentifier,
+		identifierType: request.ScheduleIdentifierType,
+		flat:           *request.Flat,
 	}
 
-	result, err := o.client.GetOnCalls(ctx, onCallRequest)
+	if o.exactDate {
+		key.date = *request.Date
+	}
+
+	o.cacheMu.Lock()
+	defer o.cacheMu.Unlock()
+
+	now := o.now()
+
+	if entry, ok := o.cache[key]; ok && now.Before(entry.expires) {
+		return entry.result, nil
+	}
+
+	result, err := o.client.GetOnCalls(ctx, request)
 	if err != nil {
-		return nil, fmt.Errorf("opsgenie.oncall.get.getoncalls -> %w", err)
+		return nil, err //nolint:wrapcheck
+	}
+
+	// Each date has its own key, so drop expired entries to stop the cache growing in long-lived processes.
+	for cached, entry := range o.cache {
+		if !now.Before(entry.expires) {
+			delete(o.cache, cached)
+		}
+	}
+
+	o.cache[key] = cacheEntry{result: result, expires: now.Add(o.cacheTTL)}
+
+	return result, nil
+}
+
+// logFields returns the fields included with every message sent to a structured logger.
+func (o *OnCall) logFields() []any {
+	return []any{"adapter", "opsgenie/oncall", "schedule", strings.Join(o.scheduleIDs, ",")}
+}
+
+// logSummary logs the summary of a Get, including the number of emails if a structured logger is set.
+func (o *OnCall) logSummary(msg string, count int) {
+	if o.structured == nil {
+		o.logger.Println(msg)
+
+		return
 	}
 
-	o.logger.Println("Fetched on-call users successfully")
+	o.structured.Info(msg, append(o.logFields(), "emails", count)...)
+}
+
+// participantEmails flattens the participants of a non-flat on-call result into the emails of the users, including
+// those nested in escalations and teams.
+func participantEmails(participants []schedule.GetOnCallParticipant) []string {
+	var emails []string
+
+	for _, participant := range participants {
+		if participant.Type == og.User {
+			emails = append(emails, participant.Name)
+		}
+
+		for _, nested := range participant.OnCallParticipants {
+			if nested.Type == og.User {
+				emails = append(emails, nested.Name)
+			}
+		}
+	}
+
+	return emails
+}
+
+// union combines lists of emails in order, skipping (case-insensitive) duplicates.
+func union(lists ...[]string) []string {
+	var (
+		out  []string
+		seen = make(map[string]bool)
+	)
+
+	for _, list := range lists {
+		for _, email := range list {
+			key := strings.ToLower(email)
+			if seen[key] {
+				continue
+			}
+
+			seen[key] = true
+
+			out = append(out, email)
+		}
+	}
+
+	return out
+}
+
+// Name returns the adapter's name for logs and metrics, e.g. opsgenie/oncall:schedule-id.
+func (o *OnCall) Name() string {
+	return "opsgenie/oncall:" + strings.Join(o.scheduleIDs, ",")
+}
+
+// Config returns the adapter's configuration. The Opsgenie API key is never included.
+func (o *OnCall) Config() map[string]string {
+	identifierType := "id"
+	if o.identifierType == schedule.Name {
+		identifierType = "name"
+	}
+
+	return map[string]string{
+		"schedule":               strings.Join(o.scheduleIDs, ","),
+		"includeEscalations":     strconv.FormatBool(o.IncludeEscalations),
+		"scheduleIdentifierType": identifierType,
+		"staticEmails":           strings.Join(o.static, ","),
+		"teamRecipients":         strconv.FormatBool(o.teamRecipients),
+		"cacheTTL":               o.cacheTTL.String(),
+	}
+}
 
-	return result.OnCallRecipients, nil
+// ReadOnly is always true, as the on-call can only be used as a source.
+func (o *OnCall) ReadOnly() bool {
+	return true
 }
 
 // Add is not supported, as the on-call is readonly.
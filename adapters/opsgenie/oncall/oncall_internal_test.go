@@ -7,13 +7,27 @@ import (
 	"time"
 
 	"github.com/opsgenie/opsgenie-go-sdk-v2/client"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/og"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/schedule"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/team"
 	gosync "github.com/ovotech/go-sync"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var errGetOnCall = errors.New("an example error")
 
+// structuredRecorder is a gosync.StructuredLogger that records each message and its key-value pairs.
+type structuredRecorder struct {
+	messages []string
+	args     [][]any
+}
+
+func (s *structuredRecorder) Info(msg string, args ...any) {
+	s.messages = append(s.messages, msg)
+	s.args = append(s.args, args)
+}
+
 func createMockedAdapter(t *testing.T, mockedTime time.Time) (*OnCall, *mockIOpsgenieSchedule) {
 	t.Helper()
 
@@ -39,10 +53,49 @@ func TestNew(t *testing.T) {
 	adapter.client = scheduleClient
 
 	assert.NoError(t, err)
-	assert.Equal(t, "test", adapter.scheduleID)
+	assert.Equal(t, []string{"test"}, adapter.scheduleIDs)
 	assert.Zero(t, scheduleClient.Calls)
 }
 
+func TestNewMulti(t *testing.T) {
+	t.Parallel()
+
+	adapter, err := NewMulti(&client.Config{ApiKey: "test"}, []string{"primary", "secondary"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"primary", "secondary"}, adapter.scheduleIDs)
+}
+
+func TestOnCall_Name(t *testing.T) {
+	t.Parallel()
+
+	adapter, err := NewMulti(&client.Config{ApiKey: "secret-api-key"}, []string{"primary", "secondary"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "opsgenie/oncall:primary,secondary", adapter.Name())
+}
+
+func TestOnCall_Config(t *testing.T) {
+	t.Parallel()
+
+	adapter, err := New(&client.Config{ApiKey: "secret-api-key"}, "test", WithStaticEmails("foo@email.com"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"schedule":               "test",
+		"includeEscalations":     "false",
+		"scheduleIdentifierType": "id",
+		"staticEmails":           "foo@email.com",
+		"teamRecipients":         "false",
+		"cacheTTL":               "0s",
+	}, adapter.Config())
+
+	adapter, err = New(&client.Config{ApiKey: "secret-api-key"}, "Primary", WithScheduleName())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "name", adapter.Config()["scheduleIdentifierType"])
+}
+
 func TestOnCall_Get(t *testing.T) {
 	t.Parallel()
 
@@ -70,6 +123,269 @@ func TestOnCall_Get(t *testing.T) {
 		assert.Equal(t, []string{"foo@email.com", "bar@email.com"}, emails)
 	})
 
+	t.Run("include escalations", func(t *testing.T) {
+		t.Parallel()
+
+		notFlat := false
+
+		adapter, scheduleClient := createMockedAdapter(t, expectedTime)
+		adapter.IncludeEscalations = true
+
+		scheduleClient.EXPECT().GetOnCalls(ctx, &schedule.GetOnCallsRequest{
+			Flat:                   &notFlat,
+			Date:                   &expectedTime,
+			ScheduleIdentifierType: schedule.Id,
+			ScheduleIdentifier:     "test",
+		}).Return(&schedule.GetOnCallsResult{
+			OnCallParticipants: []schedule.GetOnCallParticipant{
+				{Type: og.User, Name: "foo@email.com"},
+				{Type: og.Escalation, Name: "escalation", OnCallParticipants: []schedule.OnCallParticipant{
+					{Type: og.User, Name: "bar@email.com"},
+					{Type: og.User, Name: "Foo@email.com"},
+				}},
+				{Type: og.Team, Name: "team", OnCallParticipants: []schedule.OnCallParticipant{
+					{Type: og.User, Name: "baz@email.com"},
+					{Type: og.User, Name: "bar@email.com"},
+				}},
+			},
+		}, nil).Once()
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email.com", "bar@email.com", "baz@email.com"}, emails)
+	})
+
+	t.Run("multiple schedules", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, scheduleClient := createMockedAdapter(t, expectedTime)
+		adapter.scheduleIDs = []string{"primary", "secondary"}
+
+		scheduleClient.EXPECT().GetOnCalls(ctx, &schedule.GetOnCallsRequest{
+			Flat:                   &flat,
+			Date:                   &expectedTime,
+			ScheduleIdentifierType: schedule.Id,
+			ScheduleIdentifier:     "primary",
+		}).Return(&schedule.GetOnCallsResult{OnCallRecipients: []string{"foo@email.com", "bar@email.com"}}, nil).Once()
+		scheduleClient.EXPECT().GetOnCalls(ctx, &schedule.GetOnCallsRequest{
+			Flat:                   &flat,
+			Date:                   &expectedTime,
+			ScheduleIdentifierType: schedule.Id,
+			ScheduleIdentifier:     "secondary",
+		}).Return(&schedule.GetOnCallsResult{OnCallRecipients: []string{"bar@email.com", "baz@email.com"}}, nil).Once()
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email.com", "bar@email.com", "baz@email.com"}, emails)
+		assert.Equal(t, "primary,secondary", adapter.Config()["schedule"])
+	})
+
+	t.Run("multiple schedules error", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, scheduleClient := createMockedAdapter(t, expectedTime)
+		adapter.scheduleIDs = []string{"primary", "secondary"}
+
+		scheduleClient.EXPECT().GetOnCalls(ctx, mock.Anything).
+			Return(&schedule.GetOnCallsResult{OnCallRecipients: []string{"foo@email.com"}}, nil).Once()
+		scheduleClient.EXPECT().GetOnCalls(ctx, mock.Anything).Return(nil, errGetOnCall).Once()
+
+		emails, err := adapter.Get(ctx)
+
+		assert.Nil(t, emails)
+		assert.ErrorIs(t, err, errGetOnCall)
+		assert.ErrorContains(t, err, "getoncalls(secondary)")
+	})
+
+	t.Run("schedule name", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, scheduleClient := createMockedAdapter(t, expectedTime)
+		WithScheduleName()(adapter)
+
+		scheduleClient.EXPECT().GetOnCalls(ctx, &schedule.GetOnCallsRequest{
+			Flat:                   &flat,
+			Date:                   &expectedTime,
+			ScheduleIdentifierType: schedule.Name,
+			ScheduleIdentifier:     "test",
+		}).Return(&schedule.GetOnCallsResult{OnCallRecipients: []string{"foo@email.com"}}, nil).Once()
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email.com"}, emails)
+	})
+
+	t.Run("date", func(t *testing.T) {
+		t.Parallel()
+
+		tomorrow := time.Date(2022, 10, 7, 9, 0, 0, 0, time.UTC)
+
+		adapter, scheduleClient := createMockedAdapter(t, expectedTime)
+		WithDate(tomorrow)(adapter)
+
+		scheduleClient.EXPECT().GetOnCalls(ctx, &schedule.GetOnCallsRequest{
+			Flat:                   &flat,
+			Date:                   &tomorrow,
+			ScheduleIdentifierType: schedule.Id,
+			ScheduleIdentifier:     "test",
+		}).Return(&schedule.GetOnCallsResult{OnCallRecipients: []string{"foo@email.com"}}, nil).Once()
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email.com"}, emails)
+	})
+
+	t.Run("date func", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int
+
+		adapter, scheduleClient := createMockedAdapter(t, expectedTime)
+		WithDateFunc(func() time.Time {
+			calls++
+
+			return expectedTime.AddDate(0, 0, calls)
+		})(adapter)
+
+		scheduleClient.EXPECT().GetOnCalls(ctx, mock.Anything).Return(&schedule.GetOnCallsResult{}, nil).Twice()
+
+		_, err := adapter.Get(ctx)
+		assert.NoError(t, err)
+
+		_, err = adapter.Get(ctx)
+		assert.NoError(t, err)
+
+		// The date is evaluated on each call to Get.
+		var dates []time.Time
+		for _, call := range scheduleClient.Calls {
+			request, _ := call.Arguments.Get(1).(*schedule.GetOnCallsRequest)
+			dates = append(dates, *request.Date)
+		}
+
+		assert.Equal(t, []time.Time{expectedTime.AddDate(0, 0, 1), expectedTime.AddDate(0, 0, 2)}, dates)
+	})
+
+	t.Run("static emails", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, scheduleClient := createMockedAdapter(t, expectedTime)
+		WithStaticEmails("commander@email.com", "Foo@email.com")(adapter)
+
+		scheduleClient.EXPECT().GetOnCalls(ctx, mock.Anything).Return(&schedule.GetOnCallsResult{
+			OnCallRecipients: []string{"foo@email.com", "bar@email.com", "foo@email.com"},
+		}, nil)
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email.com", "bar@email.com", "commander@email.com"}, emails)
+
+		// The order is stable between calls.
+		again, err := adapter.Get(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, emails, again)
+
+		// The adapter is still read-only.
+		assert.ErrorIs(t, adapter.Add(ctx, []string{"baz@email.com"}), gosync.ErrReadOnly)
+	})
+
+	t.Run("structured logger", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &structuredRecorder{}
+
+		adapter, scheduleClient := createMockedAdapter(t, expectedTime)
+		WithStructuredLogger(recorder)(adapter)
+
+		scheduleClient.EXPECT().GetOnCalls(ctx, mock.Anything).Return(&schedule.GetOnCallsResult{
+			OnCallRecipients: []string{"foo@email.com", "bar@email.com"},
+		}, nil)
+
+		_, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{
+			"Fetching users currently on-call in Opsgenie schedule test",
+			"Fetched on-call users successfully",
+		}, recorder.messages)
+		assert.Equal(t, [][]any{
+			{"adapter", "opsgenie/oncall", "schedule", "test"},
+			{"adapter", "opsgenie/oncall", "schedule", "test", "emails", 2},
+		}, recorder.args)
+	})
+
+	t.Run("recipients that aren't emails", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := gosync.ContextWithWarnings(context.Background())
+		adapter, scheduleClient := createMockedAdapter(t, expectedTime)
+
+		scheduleClient.EXPECT().GetOnCalls(ctx, mock.Anything).Return(&schedule.GetOnCallsResult{
+			OnCallRecipients: []string{"foo@email.com", "platform-team"},
+		}, nil)
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email.com"}, emails)
+
+		warnings := gosync.Warnings(ctx)
+		assert.Len(t, warnings, 1)
+		assert.ErrorIs(t, warnings[0], ErrNotEmail)
+		assert.ErrorContains(t, warnings[0], "platform-team")
+	})
+
+	t.Run("team recipients", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, scheduleClient := createMockedAdapter(t, expectedTime)
+		WithTeamRecipients()(adapter)
+
+		teamClient := newMockIOpsgenieTeam(t)
+		adapter.teamClient = teamClient
+
+		scheduleClient.EXPECT().GetOnCalls(ctx, mock.Anything).Return(&schedule.GetOnCallsResult{
+			OnCallRecipients: []string{"foo@email.com", "platform-team"},
+		}, nil)
+		teamClient.EXPECT().Get(ctx, &team.GetTeamRequest{
+			IdentifierType:  team.Name,
+			IdentifierValue: "platform-team",
+		}).Return(&team.GetTeamResult{Members: []team.Member{
+			{User: team.User{ID: "1", Username: "bar@email.com"}, Role: "admin"},
+			{User: team.User{ID: "2", Username: "foo@email.com"}, Role: "user"},
+		}}, nil)
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email.com", "bar@email.com"}, emails)
+		assert.Equal(t, "true", adapter.Config()["teamRecipients"])
+	})
+
+	t.Run("team recipients error", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, scheduleClient := createMockedAdapter(t, expectedTime)
+		WithTeamRecipients()(adapter)
+
+		teamClient := newMockIOpsgenieTeam(t)
+		adapter.teamClient = teamClient
+
+		scheduleClient.EXPECT().GetOnCalls(ctx, mock.Anything).Return(&schedule.GetOnCallsResult{
+			OnCallRecipients: []string{"platform-team"},
+		}, nil)
+		teamClient.EXPECT().Get(ctx, mock.Anything).Return(nil, errGetOnCall)
+
+		_, err := adapter.Get(ctx)
+
+		assert.ErrorIs(t, err, errGetOnCall)
+		assert.ErrorContains(t, err, "platform-team")
+	})
+
 	t.Run("error response", func(t *testing.T) {
 		t.Parallel()
 
@@ -89,6 +405,201 @@ func TestOnCall_Get(t *testing.T) {
 	})
 }
 
+//nolint:funlen
+func TestOnCall_CacheTTL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	expectedTime := time.Date(2022, 10, 6, 12, 0, 0, 0, time.UTC)
+	flat := true
+
+	request := func(scheduleID string) *schedule.GetOnCallsRequest {
+		return &schedule.GetOnCallsRequest{
+			Flat:                   &flat,
+			Date:                   &expectedTime,
+			ScheduleIdentifierType: schedule.Id,
+			ScheduleIdentifier:     scheduleID,
+		}
+	}
+
+	t.Run("cached until expiry", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, scheduleClient := createMockedAdapter(t, expectedTime)
+		WithCacheTTL(time.Minute)(adapter)
+
+		clock := expectedTime
+		adapter.now = func() time.Time { return clock }
+
+		scheduleClient.EXPECT().GetOnCalls(ctx, request("test")).Return(&schedule.GetOnCallsResult{
+			OnCallRecipients: []string{"foo@email.com"},
+		}, nil).Once()
+
+		emails, err := adapter.Get(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email.com"}, emails)
+
+		// Within the TTL, the cached result is returned without querying Opsgenie.
+		clock = clock.Add(59 * time.Second)
+
+		emails, err = adapter.Get(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email.com"}, emails)
+
+		// Once the TTL has passed, Opsgenie is queried again.
+		clock = clock.Add(time.Second)
+
+		scheduleClient.EXPECT().GetOnCalls(ctx, request("test")).Return(&schedule.GetOnCallsResult{
+			OnCallRecipients: []string{"bar@email.com"},
+		}, nil).Once()
+
+		emails, err = adapter.Get(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"bar@email.com"}, emails)
+	})
+
+	t.Run("keyed on the request", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, scheduleClient := createMockedAdapter(t, expectedTime)
+		WithCacheTTL(time.Minute)(adapter)
+		adapter.now = func() time.Time { return expectedTime }
+
+		scheduleClient.EXPECT().GetOnCalls(ctx, request("test")).Return(&schedule.GetOnCallsResult{
+			OnCallRecipients: []string{"foo@email.com"},
+		}, nil).Once()
+
+		_, err := adapter.Get(ctx)
+		assert.NoError(t, err)
+
+		// A different schedule isn't served from the cache.
+		adapter.scheduleIDs = []string{"other"}
+
+		scheduleClient.EXPECT().GetOnCalls(ctx, request("other")).Return(&schedule.GetOnCallsResult{
+			OnCallRecipients: []string{"bar@email.com"},
+		}, nil).Once()
+
+		emails, err := adapter.Get(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"bar@email.com"}, emails)
+	})
+
+	t.Run("expired entries are dropped", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, scheduleClient := createMockedAdapter(t, expectedTime)
+		WithCacheTTL(time.Minute)(adapter)
+
+		// The date moves with the clock, so each Get has its own key.
+		clock := expectedTime
+		adapter.now = func() time.Time { return clock }
+		WithDateFunc(func() time.Time { return clock })(adapter)
+
+		scheduleClient.EXPECT().GetOnCalls(ctx, mock.Anything).Return(&schedule.GetOnCallsResult{
+			OnCallRecipients: []string{"foo@email.com"},
+		}, nil).Times(5)
+
+		for window := 0; window < 5; window++ {
+			_, err := adapter.Get(ctx)
+			assert.NoError(t, err)
+			assert.Len(t, adapter.cache, 1)
+
+			clock = clock.Add(time.Minute)
+		}
+	})
+
+	t.Run("expires from when it was fetched", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, scheduleClient := createMockedAdapter(t, expectedTime)
+		WithCacheTTL(time.Minute)(adapter)
+
+		// Fetched 10 seconds before the end of a minute, with the date defaulting to now.
+		clock := expectedTime.Add(50 * time.Second)
+		adapter.now = func() time.Time { return clock }
+		adapter.getTime = func() time.Time { return clock }
+
+		scheduleClient.EXPECT().GetOnCalls(ctx, mock.Anything).Return(&schedule.GetOnCallsResult{
+			OnCallRecipients: []string{"foo@email.com"},
+		}, nil).Once()
+
+		_, err := adapter.Get(ctx)
+		assert.NoError(t, err)
+
+		// Crossing the minute boundary within the TTL still uses the cached result.
+		clock = clock.Add(20 * time.Second)
+
+		emails, err := adapter.Get(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email.com"}, emails)
+	})
+
+	t.Run("keyed on the exact date", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, scheduleClient := createMockedAdapter(t, expectedTime)
+		WithCacheTTL(time.Minute)(adapter)
+		adapter.now = func() time.Time { return expectedTime }
+
+		// Two dates in the same minute aren't served from the same entry.
+		date := expectedTime
+		WithDateFunc(func() time.Time { return date })(adapter)
+
+		scheduleClient.EXPECT().GetOnCalls(ctx, mock.Anything).Return(&schedule.GetOnCallsResult{
+			OnCallRecipients: []string{"foo@email.com"},
+		}, nil).Once()
+		scheduleClient.EXPECT().GetOnCalls(ctx, mock.Anything).Return(&schedule.GetOnCallsResult{
+			OnCallRecipients: []string{"bar@email.com"},
+		}, nil).Once()
+
+		emails, err := adapter.Get(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email.com"}, emails)
+
+		date = date.Add(10 * time.Second)
+
+		emails, err = adapter.Get(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"bar@email.com"}, emails)
+	})
+
+	t.Run("errors aren't cached", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, scheduleClient := createMockedAdapter(t, expectedTime)
+		WithCacheTTL(time.Minute)(adapter)
+		adapter.now = func() time.Time { return expectedTime }
+
+		scheduleClient.EXPECT().GetOnCalls(ctx, request("test")).Return(nil, errGetOnCall).Once()
+		scheduleClient.EXPECT().GetOnCalls(ctx, request("test")).Return(&schedule.GetOnCallsResult{
+			OnCallRecipients: []string{"foo@email.com"},
+		}, nil).Once()
+
+		_, err := adapter.Get(ctx)
+		assert.Error(t, err)
+
+		emails, err := adapter.Get(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email.com"}, emails)
+	})
+
+	t.Run("zero TTL disables caching", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, scheduleClient := createMockedAdapter(t, expectedTime)
+
+		scheduleClient.EXPECT().GetOnCalls(ctx, request("test")).Return(&schedule.GetOnCallsResult{
+			OnCallRecipients: []string{"foo@email.com"},
+		}, nil).Twice()
+
+		_, err := adapter.Get(ctx)
+		assert.NoError(t, err)
+
+		_, err = adapter.Get(ctx)
+		assert.NoError(t, err)
+	})
+}
+
 func TestOnCall_Add(t *testing.T) {
 	t.Parallel()
 
@@ -112,3 +623,65 @@ func TestOnCall_Remove(t *testing.T) {
 	assert.ErrorIs(t, err, gosync.ErrReadOnly)
 	assert.Zero(t, scheduleClient.Calls)
 }
+
+//nolint:paralleltest
+func TestNewFromEnv(t *testing.T) {
+	t.Run("Missing variables", func(t *testing.T) {
+		t.Setenv(EnvAPIKey, "")
+		t.Setenv(EnvScheduleID, "")
+
+		_, err := NewFromEnv()
+
+		assert.ErrorIs(t, err, gosync.ErrMissingEnv)
+		assert.ErrorContains(t, err, EnvAPIKey)
+		assert.ErrorContains(t, err, EnvScheduleID)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Setenv(EnvAPIKey, "test")
+		t.Setenv(EnvScheduleID, "test")
+		t.Setenv(EnvAPIURL, string(client.API_URL_EU))
+
+		adapter, err := NewFromEnv()
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"test"}, adapter.scheduleIDs)
+		assert.Zero(t, adapter.cacheTTL)
+		assert.False(t, adapter.exactDate)
+	})
+
+	t.Run("Date and cache TTL", func(t *testing.T) {
+		t.Setenv(EnvAPIKey, "test")
+		t.Setenv(EnvScheduleID, "test")
+		t.Setenv(EnvDate, "2024-01-02T09:00:00Z")
+		t.Setenv(EnvCacheTTL, "5m")
+
+		adapter, err := NewFromEnv()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5*time.Minute, adapter.cacheTTL)
+		assert.True(t, adapter.exactDate)
+		assert.Equal(t, time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC), adapter.getTime())
+
+		// Options passed in take precedence over the environment.
+		adapter, err = NewFromEnv(WithCacheTTL(time.Minute))
+
+		assert.NoError(t, err)
+		assert.Equal(t, time.Minute, adapter.cacheTTL)
+	})
+
+	t.Run("Invalid option", func(t *testing.T) {
+		t.Setenv(EnvAPIKey, "test")
+		t.Setenv(EnvScheduleID, "test")
+		t.Setenv(EnvDate, "tomorrow")
+
+		_, err := NewFromEnv()
+		assert.ErrorContains(t, err, EnvDate)
+
+		t.Setenv(EnvDate, "")
+		t.Setenv(EnvCacheTTL, "forever")
+
+		_, err = NewFromEnv()
+		assert.ErrorContains(t, err, EnvCacheTTL)
+	})
+}
@@ -0,0 +1,108 @@
+package oncall
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ErrUnexpectedResponse is returned when the PagerDuty API responds with an unexpected status code.
+var ErrUnexpectedResponse = errors.New("unexpected response from pagerduty")
+
+// apiURL is the PagerDuty REST API.
+const apiURL = "https://api.pagerduty.com"
+
+// onCall is an entry in PagerDuty's on-calls API, for a user on-call at an escalation level.
+type onCall struct {
+	User struct {
+		ID    string `json:"id"`
+		Email string `json:"email"`
+	} `json:"user"`
+	EscalationLevel int `json:"escalation_level"`
+}
+
+// onCallsQuery filters the on-calls API. Only entries matching every filter are returned.
+type onCallsQuery struct {
+	ScheduleIDs         []string
+	EscalationPolicyIDs []string
+	Time                time.Time
+}
+
+// Client is a minimal PagerDuty REST API v2 client, authenticated with an API key.
+//
+// It only exists because github.com/PagerDuty/go-pagerduty couldn't be fetched when this adapter was written, and
+// should be replaced by it. OnCall depends on iPagerDutyClient rather than Client, so the swap is contained here.
+type Client struct {
+	httpClient *http.Client
+	server     string
+	token      string
+}
+
+// NewClient creates a new PagerDuty client, authenticated with a (preferably read-only) REST API key.
+func NewClient(token string) *Client {
+	return &Client{
+		httpClient: http.DefaultClient,
+		server:     apiURL,
+		token:      token,
+	}
+}
+
+// WithHTTPClient sets a custom HTTP client, e.g. to configure timeouts or proxies.
+func (c *Client) WithHTTPClient(httpClient *http.Client) *Client {
+	c.httpClient = httpClient
+
+	return c
+}
+
+// GetOnCalls gets a page of users on-call at the time in the query, and whether there are more pages.
+func (c *Client) GetOnCalls(ctx context.Context, query onCallsQuery, offset int, limit int) ([]onCall, bool, error) {
+	values := url.Values{
+		"include[]": {"users"},
+		"since":     {query.Time.Format(time.RFC3339)},
+		"until":     {query.Time.Format(time.RFC3339)},
+		"offset":    {strconv.Itoa(offset)},
+		"limit":     {strconv.Itoa(limit)},
+	}
+
+	for _, id := range query.ScheduleIDs {
+		values.Add("schedule_ids[]", id)
+	}
+
+	for _, id := range query.EscalationPolicyIDs {
+		values.Add("escalation_policy_ids[]", id)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.server+"/oncalls?"+values.Encode(), nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("newrequest -> %w", err)
+	}
+
+	req.Header.Set("Authorization", "Token token="+c.token)
+	req.Header.Set("Accept", "application/vnd.pagerduty+json;version=2")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, false, fmt.Errorf("do -> %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, false, fmt.Errorf("do -> %w: %s", ErrUnexpectedResponse, res.Status)
+	}
+
+	var body struct {
+		OnCalls []onCall `json:"oncalls"`
+		More    bool     `json:"more"`
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, false, fmt.Errorf("decode -> %w", err)
+	}
+
+	return body.OnCalls, body.More, nil
+}
@@ -0,0 +1,76 @@
+package oncall
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//nolint:funlen
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	at := time.Date(2022, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("GetOnCalls", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+
+			assert.Equal(t, "Token token=token", r.Header.Get("Authorization"))
+			assert.Equal(t, "application/vnd.pagerduty+json;version=2", r.Header.Get("Accept"))
+			assert.Equal(t, "/oncalls", r.URL.Path)
+			assert.Equal(t, []string{"schedule-a", "schedule-b"}, query["schedule_ids[]"])
+			assert.Equal(t, []string{"policy"}, query["escalation_policy_ids[]"])
+			assert.Equal(t, "2022-06-01T09:00:00Z", query.Get("since"))
+			assert.Equal(t, "2022-06-01T09:00:00Z", query.Get("until"))
+			assert.Equal(t, "users", query.Get("include[]"))
+			assert.Equal(t, "100", query.Get("offset"))
+			assert.Equal(t, "10", query.Get("limit"))
+
+			_, _ = w.Write([]byte(`{"oncalls":[{"user":{"id":"P1","email":"foo@email"},"escalation_level":1}],` +
+				`"more":true}`))
+		}))
+		defer server.Close()
+
+		client := NewClient("token")
+		client.server = server.URL
+
+		onCalls, more, err := client.GetOnCalls(ctx, onCallsQuery{
+			ScheduleIDs:         []string{"schedule-a", "schedule-b"},
+			EscalationPolicyIDs: []string{"policy"},
+			Time:                at,
+		}, 100, 10)
+
+		assert.NoError(t, err)
+		assert.True(t, more)
+		assert.Len(t, onCalls, 1)
+		assert.Equal(t, "P1", onCalls[0].User.ID)
+		assert.Equal(t, "foo@email", onCalls[0].User.Email)
+		assert.Equal(t, 1, onCalls[0].EscalationLevel)
+	})
+
+	t.Run("Unexpected response", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		client := NewClient("token").WithHTTPClient(server.Client())
+		client.server = server.URL
+
+		onCalls, more, err := client.GetOnCalls(ctx, onCallsQuery{Time: at}, 0, 10)
+
+		assert.ErrorIs(t, err, ErrUnexpectedResponse)
+		assert.False(t, more)
+		assert.Nil(t, onCalls)
+	})
+}
@@ -0,0 +1,93 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package oncall
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockIPagerDutyClient is an autogenerated mock type for the iPagerDutyClient type
+type mockIPagerDutyClient struct {
+	mock.Mock
+}
+
+type mockIPagerDutyClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockIPagerDutyClient) EXPECT() *mockIPagerDutyClient_Expecter {
+	return &mockIPagerDutyClient_Expecter{mock: &_m.Mock}
+}
+
+// GetOnCalls provides a mock function with given fields: ctx, query, offset, limit
+func (_m *mockIPagerDutyClient) GetOnCalls(ctx context.Context, query onCallsQuery, offset int, limit int) ([]onCall, bool, error) {
+	ret := _m.Called(ctx, query, offset, limit)
+
+	var r0 []onCall
+	if rf, ok := ret.Get(0).(func(context.Context, onCallsQuery, int, int) []onCall); ok {
+		r0 = rf(ctx, query, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]onCall)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(context.Context, onCallsQuery, int, int) bool); ok {
+		r1 = rf(ctx, query, offset, limit)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, onCallsQuery, int, int) error); ok {
+		r2 = rf(ctx, query, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// mockIPagerDutyClient_GetOnCalls_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOnCalls'
+type mockIPagerDutyClient_GetOnCalls_Call struct {
+	*mock.Call
+}
+
+// GetOnCalls is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query onCallsQuery
+//   - offset int
+//   - limit int
+func (_e *mockIPagerDutyClient_Expecter) GetOnCalls(ctx interface{}, query interface{}, offset interface{}, limit interface{}) *mockIPagerDutyClient_GetOnCalls_Call {
+	return &mockIPagerDutyClient_GetOnCalls_Call{Call: _e.mock.On("GetOnCalls", ctx, query, offset, limit)}
+}
+
+func (_c *mockIPagerDutyClient_GetOnCalls_Call) Run(run func(ctx context.Context, query onCallsQuery, offset int, limit int)) *mockIPagerDutyClient_GetOnCalls_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(onCallsQuery), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *mockIPagerDutyClient_GetOnCalls_Call) Return(_a0 []onCall, _a1 bool, _a2 error) *mockIPagerDutyClient_GetOnCalls_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+type mockConstructorTestingTnewMockIPagerDutyClient interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// newMockIPagerDutyClient creates a new instance of mockIPagerDutyClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func newMockIPagerDutyClient(t mockConstructorTestingTnewMockIPagerDutyClient) *mockIPagerDutyClient {
+	mock := &mockIPagerDutyClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
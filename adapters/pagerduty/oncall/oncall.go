@@ -0,0 +1,164 @@
+/*
+Package oncall synchronises email addresses of users currently on-call in a PagerDuty schedule or escalation policy.
+
+In order to use this adapter, you'll need a PagerDuty REST API key, which only needs read access.
+*/
+package oncall
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	gosync "github.com/ovotech/go-sync"
+)
+
+// Ensure the adapter type fully satisfies the ports.Adapter, ports.ReadOnlyAdapter, ports.ConfiguredAdapter and
+// ports.Namer interfaces.
+var (
+	_ gosync.Adapter           = &OnCall{}
+	_ gosync.ReadOnlyAdapter   = &OnCall{}
+	_ gosync.ConfiguredAdapter = &OnCall{}
+	_ gosync.Namer             = &OnCall{}
+)
+
+const perPage = 100
+
+// iPagerDutyClient is a subset of the PagerDuty Client, and used to build mocks for easy testing.
+type iPagerDutyClient interface {
+	GetOnCalls(ctx context.Context, query onCallsQuery, offset int, limit int) ([]onCall, bool, error)
+}
+
+type OnCall struct {
+	client              iPagerDutyClient
+	scheduleIDs         []string
+	escalationPolicyIDs []string
+	getTime             func() time.Time
+	logger              *log.Logger
+}
+
+// WithLogger sets a custom logger.
+func WithLogger(logger *log.Logger) func(*OnCall) {
+	return func(onCall *OnCall) {
+		onCall.logger = logger
+	}
+}
+
+// New instantiates a new PagerDuty OnCall adapter, for the schedule with the given ID.
+func New(client *Client, scheduleID string, optsFn ...func(*OnCall)) *OnCall {
+	return newOnCall(client, []string{scheduleID}, nil, optsFn...)
+}
+
+// NewForEscalationPolicy instantiates a new PagerDuty OnCall adapter, for everyone currently on-call at any level of
+// the escalation policy with the given ID.
+func NewForEscalationPolicy(client *Client, escalationPolicyID string, optsFn ...func(*OnCall)) *OnCall {
+	return newOnCall(client, nil, []string{escalationPolicyID}, optsFn...)
+}
+
+func newOnCall(
+	client iPagerDutyClient,
+	scheduleIDs []string,
+	escalationPolicyIDs []string,
+	optsFn ...func(*OnCall),
+) *OnCall {
+	onCallAdapter := &OnCall{
+		client:              client,
+		scheduleIDs:         scheduleIDs,
+		escalationPolicyIDs: escalationPolicyIDs,
+		getTime:             time.Now,
+		logger:              log.New(os.Stderr, "[go-sync/pagerduty/oncall] ", log.LstdFlags|log.Lshortfile|log.Lmsgprefix),
+	}
+
+	for _, fn := range optsFn {
+		fn(onCallAdapter)
+	}
+
+	return onCallAdapter
+}
+
+// Get emails of users currently on-call.
+func (o *OnCall) Get(ctx context.Context) ([]string, error) {
+	o.logger.Printf("Fetching users currently on-call in PagerDuty %s", o.target())
+
+	query := onCallsQuery{
+		ScheduleIDs:         o.scheduleIDs,
+		EscalationPolicyIDs: o.escalationPolicyIDs,
+		Time:                o.getTime(),
+	}
+
+	var (
+		emails []string
+		seen   = make(map[string]bool)
+	)
+
+	for offset := 0; ; offset += perPage {
+		onCalls, more, err := o.client.GetOnCalls(ctx, query, offset, perPage)
+		if err != nil {
+			return nil, fmt.Errorf("pagerduty.oncall.get.getoncalls(%s) -> %w", o.target(), err)
+		}
+
+		for _, entry := range onCalls {
+			// A user on-call at several escalation levels, or in several layers of a schedule, is only returned once.
+			key := strings.ToLower(entry.User.Email)
+			if key == "" || seen[key] {
+				continue
+			}
+
+			seen[key] = true
+
+			emails = append(emails, entry.User.Email)
+		}
+
+		if !more || len(onCalls) == 0 {
+			break
+		}
+	}
+
+	o.logger.Println("Fetched on-call users successfully")
+
+	return emails, nil
+}
+
+// target describes the schedules or escalation policies looked up, for logs and errors.
+func (o *OnCall) target() string {
+	if len(o.escalationPolicyIDs) > 0 {
+		return "escalation policy " + strings.Join(o.escalationPolicyIDs, ", ")
+	}
+
+	return "schedule " + strings.Join(o.scheduleIDs, ", ")
+}
+
+// Name returns the adapter's name for logs and metrics, e.g. pagerduty/oncall:schedule-id.
+func (o *OnCall) Name() string {
+	if len(o.escalationPolicyIDs) > 0 {
+		return "pagerduty/oncall:" + strings.Join(o.escalationPolicyIDs, ",")
+	}
+
+	return "pagerduty/oncall:" + strings.Join(o.scheduleIDs, ",")
+}
+
+// Config returns the adapter's configuration. The PagerDuty API key is never included.
+func (o *OnCall) Config() map[string]string {
+	return map[string]string{
+		"schedule":         strings.Join(o.scheduleIDs, ","),
+		"escalationPolicy": strings.Join(o.escalationPolicyIDs, ","),
+	}
+}
+
+// ReadOnly is always true, as the on-call can only be used as a source.
+func (o *OnCall) ReadOnly() bool {
+	return true
+}
+
+// Add is not supported, as the on-call is readonly.
+func (o *OnCall) Add(_ context.Context, _ []string) error {
+	return gosync.ErrReadOnly
+}
+
+// Remove is not supported, as the on-call is readonly.
+func (o *OnCall) Remove(_ context.Context, _ []string) error {
+	return gosync.ErrReadOnly
+}
@@ -0,0 +1,162 @@
+package oncall
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gosync "github.com/ovotech/go-sync"
+	"github.com/stretchr/testify/assert"
+)
+
+var errGetOnCalls = errors.New("an example error")
+
+func createMockedAdapter(t *testing.T, mockedTime time.Time) (*OnCall, *mockIPagerDutyClient) {
+	t.Helper()
+
+	client := newMockIPagerDutyClient(t)
+	adapter := New(NewClient("test"), "schedule")
+	adapter.client = client
+	adapter.getTime = func() time.Time {
+		return mockedTime
+	}
+
+	return adapter, client
+}
+
+func entry(email string) onCall {
+	var out onCall
+
+	out.User.Email = email
+
+	return out
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	adapter := New(NewClient("test"), "schedule")
+
+	assert.Equal(t, []string{"schedule"}, adapter.scheduleIDs)
+	assert.Empty(t, adapter.escalationPolicyIDs)
+	assert.True(t, adapter.ReadOnly())
+}
+
+func TestNewForEscalationPolicy(t *testing.T) {
+	t.Parallel()
+
+	adapter := NewForEscalationPolicy(NewClient("test"), "policy")
+
+	assert.Empty(t, adapter.scheduleIDs)
+	assert.Equal(t, []string{"policy"}, adapter.escalationPolicyIDs)
+}
+
+func TestOnCall_Name(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "pagerduty/oncall:schedule", New(NewClient("test"), "schedule").Name())
+	assert.Equal(t, "pagerduty/oncall:policy", NewForEscalationPolicy(NewClient("test"), "policy").Name())
+}
+
+func TestOnCall_Config(t *testing.T) {
+	t.Parallel()
+
+	adapter := NewForEscalationPolicy(NewClient("secret-api-key"), "policy")
+
+	assert.Equal(t, map[string]string{
+		"schedule":         "",
+		"escalationPolicy": "policy",
+	}, adapter.Config())
+}
+
+//nolint:funlen
+func TestOnCall_Get(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	mockedTime := time.Date(2022, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t, mockedTime)
+
+		client.EXPECT().GetOnCalls(ctx, onCallsQuery{
+			ScheduleIDs:         []string{"schedule"},
+			EscalationPolicyIDs: nil,
+			Time:                mockedTime,
+		}, 0, perPage).Return([]onCall{entry("foo@email"), entry("bar@email")}, false, nil)
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email", "bar@email"}, emails)
+	})
+
+	t.Run("Escalation policy", func(t *testing.T) {
+		t.Parallel()
+
+		client := newMockIPagerDutyClient(t)
+		adapter := NewForEscalationPolicy(NewClient("test"), "policy")
+		adapter.client = client
+		adapter.getTime = func() time.Time { return mockedTime }
+
+		client.EXPECT().GetOnCalls(ctx, onCallsQuery{
+			ScheduleIDs:         nil,
+			EscalationPolicyIDs: []string{"policy"},
+			Time:                mockedTime,
+		}, 0, perPage).Return([]onCall{entry("foo@email")}, false, nil)
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email"}, emails)
+	})
+
+	t.Run("Pagination and duplicates", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t, mockedTime)
+
+		client.EXPECT().GetOnCalls(ctx, onCallsQuery{ScheduleIDs: []string{"schedule"}, Time: mockedTime}, 0, perPage).
+			Return([]onCall{entry("foo@email"), entry("")}, true, nil)
+		client.EXPECT().GetOnCalls(ctx, onCallsQuery{ScheduleIDs: []string{"schedule"}, Time: mockedTime}, perPage, perPage).
+			Return([]onCall{entry("FOO@email"), entry("bar@email")}, false, nil)
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email", "bar@email"}, emails)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t, mockedTime)
+
+		client.EXPECT().GetOnCalls(ctx, onCallsQuery{ScheduleIDs: []string{"schedule"}, Time: mockedTime}, 0, perPage).
+			Return(nil, false, errGetOnCalls)
+
+		emails, err := adapter.Get(ctx)
+
+		assert.ErrorIs(t, err, errGetOnCalls)
+		assert.Nil(t, emails)
+	})
+}
+
+func TestOnCall_Add(t *testing.T) {
+	t.Parallel()
+
+	adapter, _ := createMockedAdapter(t, time.Now())
+
+	assert.ErrorIs(t, adapter.Add(context.TODO(), []string{"foo@email"}), gosync.ErrReadOnly)
+}
+
+func TestOnCall_Remove(t *testing.T) {
+	t.Parallel()
+
+	adapter, _ := createMockedAdapter(t, time.Now())
+
+	assert.ErrorIs(t, adapter.Remove(context.TODO(), []string{"foo@email"}), gosync.ErrReadOnly)
+}
@@ -0,0 +1,154 @@
+package group
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	gosync "github.com/ovotech/go-sync"
+)
+
+// ErrUnexpectedResponse is returned when the ServiceNow API responds with an unexpected status code.
+var ErrUnexpectedResponse = errors.New("unexpected response from servicenow")
+
+const membershipTable = "/api/now/table/sys_user_grmember"
+
+// member is a group membership record, from the sys_user_grmember table.
+type member struct {
+	SysID  string `json:"sys_id"`     // SysID of the membership record.
+	UserID string `json:"user"`       // UserID is the sys_id of the user.
+	Email  string `json:"user.email"` // Email of the user.
+}
+
+// Client is a minimal ServiceNow Table API client, authenticated with basic auth.
+type Client struct {
+	httpClient *http.Client
+	instance   string
+	username   string
+	password   string
+}
+
+// NewClient creates a new ServiceNow client for an instance URL, e.g. https://my-company.service-now.com.
+func NewClient(instance string, username string, password string) *Client {
+	return &Client{
+		httpClient: http.DefaultClient,
+		instance:   strings.TrimSuffix(instance, "/"),
+		username:   username,
+		password:   password,
+	}
+}
+
+// WithHTTPClient sets a custom HTTP client, e.g. to configure timeouts or proxies.
+func (c *Client) WithHTTPClient(httpClient *http.Client) *Client {
+	c.httpClient = httpClient
+
+	return c
+}
+
+// do makes a request to the ServiceNow API, and decodes the result into out if it isn't nil.
+// A forbidden response to a write means the credentials are read-only, so gosync.ErrReadOnly is returned.
+func (c *Client) do(ctx context.Context, method string, path string, body interface{}, out interface{}) error {
+	var reader io.Reader
+
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshal -> %w", err)
+		}
+
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.instance+path, reader)
+	if err != nil {
+		return fmt.Errorf("newrequest(%s, %s) -> %w", method, path, err)
+	}
+
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("do(%s, %s) -> %w", method, path, err)
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode == http.StatusForbidden && method != http.MethodGet:
+		return fmt.Errorf("do(%s, %s) -> %w", method, path, gosync.ErrReadOnly)
+	case res.StatusCode < 200 || res.StatusCode > 299:
+		return fmt.Errorf("do(%s, %s) -> %w: %s", method, path, ErrUnexpectedResponse, res.Status)
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+			return fmt.Errorf("decode(%s, %s) -> %w", method, path, err)
+		}
+	}
+
+	return nil
+}
+
+// GetGroupMembers gets a page of membership records for a group.
+func (c *Client) GetGroupMembers(ctx context.Context, groupID string, offset int, limit int) ([]member, error) {
+	query := url.Values{
+		"sysparm_query":                  {"group=" + groupID},
+		"sysparm_fields":                 {"sys_id,user,user.email"},
+		"sysparm_exclude_reference_link": {"true"},
+		"sysparm_limit":                  {strconv.Itoa(limit)},
+		"sysparm_offset":                 {strconv.Itoa(offset)},
+	}
+
+	var response struct {
+		Result []member `json:"result"`
+	}
+
+	if err := c.do(ctx, http.MethodGet, membershipTable+"?"+query.Encode(), nil, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Result, nil
+}
+
+// GetUserIDByEmail gets the sys_id of a user by their email, or an empty string if they don't exist.
+func (c *Client) GetUserIDByEmail(ctx context.Context, email string) (string, error) {
+	query := url.Values{
+		"sysparm_query":  {"email=" + email},
+		"sysparm_fields": {"sys_id"},
+		"sysparm_limit":  {"1"},
+	}
+
+	var response struct {
+		Result []struct {
+			SysID string `json:"sys_id"`
+		} `json:"result"`
+	}
+
+	if err := c.do(ctx, http.MethodGet, "/api/now/table/sys_user?"+query.Encode(), nil, &response); err != nil {
+		return "", err
+	}
+
+	if len(response.Result) == 0 {
+		return "", nil
+	}
+
+	return response.Result[0].SysID, nil
+}
+
+// AddGroupMember adds a user to a group.
+func (c *Client) AddGroupMember(ctx context.Context, groupID string, userID string) error {
+	return c.do(ctx, http.MethodPost, membershipTable, map[string]string{"group": groupID, "user": userID}, nil)
+}
+
+// RemoveGroupMember removes a user from a group, by deleting their membership record.
+func (c *Client) RemoveGroupMember(ctx context.Context, memberID string) error {
+	return c.do(ctx, http.MethodDelete, membershipTable+"/"+memberID, nil, nil)
+}
@@ -0,0 +1,122 @@
+package group
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gosync "github.com/ovotech/go-sync"
+	"github.com/stretchr/testify/assert"
+)
+
+//nolint:funlen
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("GetGroupMembers", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user, pass, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "user", user)
+			assert.Equal(t, "pass", pass)
+
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, membershipTable, r.URL.Path)
+			assert.Equal(t, "group=group-id", r.URL.Query().Get("sysparm_query"))
+			assert.Equal(t, "10", r.URL.Query().Get("sysparm_offset"))
+			assert.Equal(t, "5", r.URL.Query().Get("sysparm_limit"))
+
+			_, _ = w.Write([]byte(`{"result":[{"sys_id":"member-foo","user":"user-foo","user.email":"foo@email"}]}`))
+		}))
+		defer server.Close()
+
+		members, err := NewClient(server.URL+"/", "user", "pass").GetGroupMembers(ctx, "group-id", 10, 5)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []member{{SysID: "member-foo", UserID: "user-foo", Email: "foo@email"}}, members)
+	})
+
+	t.Run("GetUserIDByEmail", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("sysparm_query") {
+			case "email=foo@email":
+				_, _ = w.Write([]byte(`{"result":[{"sys_id":"user-foo"}]}`))
+			default:
+				_, _ = w.Write([]byte(`{"result":[]}`))
+			}
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, "user", "pass")
+
+		id, err := client.GetUserIDByEmail(ctx, "foo@email")
+		assert.NoError(t, err)
+		assert.Equal(t, "user-foo", id)
+
+		id, err = client.GetUserIDByEmail(ctx, "bar@email")
+		assert.NoError(t, err)
+		assert.Empty(t, id)
+	})
+
+	t.Run("AddGroupMember", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]string
+
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]string{"group": "group-id", "user": "user-foo"}, body)
+
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		err := NewClient(server.URL, "user", "pass").AddGroupMember(ctx, "group-id", "user-foo")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("RemoveGroupMember", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+			assert.Equal(t, membershipTable+"/member-foo", r.URL.Path)
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		err := NewClient(server.URL, "user", "pass").RemoveGroupMember(ctx, "member-foo")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, "user", "pass")
+
+		// Read-only credentials can't write.
+		err := client.RemoveGroupMember(ctx, "member-foo")
+		assert.ErrorIs(t, err, gosync.ErrReadOnly)
+
+		// But can't read either, in which case the response is unexpected.
+		_, err = client.GetGroupMembers(ctx, "group-id", 0, 1)
+		assert.ErrorIs(t, err, ErrUnexpectedResponse)
+	})
+}
@@ -0,0 +1,184 @@
+/*
+Package group synchronises email addresses with a ServiceNow group.
+
+In order to use this adapter, you'll need a ServiceNow user with access to the sys_user and sys_user_grmember tables.
+*/
+package group
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	gosync "github.com/ovotech/go-sync"
+)
+
+// Ensure the adapter type fully satisfies the ports.Adapter and ports.ConfiguredAdapter interfaces.
+var (
+	_ gosync.Adapter           = &Group{}
+	_ gosync.ConfiguredAdapter = &Group{}
+)
+
+// ErrUserNotFound is returned when an email can't be resolved to a ServiceNow user.
+var ErrUserNotFound = errors.New("user not found")
+
+const pageLimit = 100
+
+// iServiceNowClient is a subset of the ServiceNow Client, and used to build mocks for easy testing.
+type iServiceNowClient interface {
+	GetGroupMembers(ctx context.Context, groupID string, offset int, limit int) ([]member, error)
+	GetUserIDByEmail(ctx context.Context, email string) (string, error)
+	AddGroupMember(ctx context.Context, groupID string, userID string) error
+	RemoveGroupMember(ctx context.Context, memberID string) error
+}
+
+type Group struct {
+	client  iServiceNowClient
+	groupID string
+	// cache stores the email -> membership sys_id mapping for use with the Remove method.
+	cache  map[string]string
+	users  map[string]string // users caches the email -> user sys_id mapping for use with the Add method.
+	logger *log.Logger
+}
+
+// WithLogger sets a custom logger.
+func WithLogger(logger *log.Logger) func(*Group) {
+	return func(group *Group) {
+		group.logger = logger
+	}
+}
+
+// New instantiates a new ServiceNow group adapter, for the group with the given sys_id.
+func New(client *Client, groupID string, optsFn ...func(*Group)) *Group {
+	group := &Group{
+		client:  client,
+		groupID: groupID,
+		cache:   nil,
+		users:   make(map[string]string),
+		logger:  log.New(os.Stderr, "[go-sync/servicenow/group] ", log.LstdFlags|log.Lshortfile|log.Lmsgprefix),
+	}
+
+	for _, fn := range optsFn {
+		fn(group)
+	}
+
+	return group
+}
+
+// Config returns the adapter's configuration, with the password redacted.
+func (g *Group) Config() map[string]string {
+	config := map[string]string{"group": g.groupID}
+
+	if client, ok := g.client.(*Client); ok {
+		config["instance"] = client.instance
+		config["username"] = client.username
+		config["password"] = gosync.Redacted
+	}
+
+	return config
+}
+
+// Get emails of ServiceNow users in a group.
+func (g *Group) Get(ctx context.Context) ([]string, error) {
+	g.logger.Printf("Fetching accounts from ServiceNow group %s", g.groupID)
+
+	// Initialise the cache.
+	g.cache = make(map[string]string)
+
+	emails := make([]string, 0)
+
+	for offset := 0; ; offset += pageLimit {
+		members, err := g.client.GetGroupMembers(ctx, g.groupID, offset, pageLimit)
+		if err != nil {
+			return nil, fmt.Errorf("servicenow.group.get.getgroupmembers(%s, %d) -> %w", g.groupID, offset, err)
+		}
+
+		for _, member := range members {
+			if member.Email == "" {
+				continue
+			}
+
+			emails = append(emails, member.Email)
+
+			// Add the email -> ID maps for use with the Add/Remove methods.
+			g.cache[member.Email] = member.SysID
+			g.users[member.Email] = member.UserID
+		}
+
+		if len(members) < pageLimit {
+			break
+		}
+	}
+
+	g.logger.Println("Fetched accounts successfully")
+
+	return emails, nil
+}
+
+// getUserID resolves the sys_id of a user from their email, and caches it for subsequent calls.
+func (g *Group) getUserID(ctx context.Context, email string) (string, error) {
+	if id, ok := g.users[email]; ok {
+		return id, nil
+	}
+
+	id, err := g.client.GetUserIDByEmail(ctx, email)
+	if err != nil {
+		return "", fmt.Errorf("getuseridbyemail(%s) -> %w", email, err)
+	}
+
+	if id == "" {
+		return "", fmt.Errorf("getuseridbyemail(%s) -> %w", email, ErrUserNotFound)
+	}
+
+	g.users[email] = id
+
+	return id, nil
+}
+
+// Add emails to a ServiceNow group.
+func (g *Group) Add(ctx context.Context, emails []string) error {
+	g.logger.Printf("Adding %s to ServiceNow group %s", emails, g.groupID)
+
+	for index, email := range emails {
+		userID, err := g.getUserID(ctx, email)
+		if err != nil {
+			return fmt.Errorf("servicenow.group.add -> %w", err)
+		}
+
+		err = g.client.AddGroupMember(ctx, g.groupID, userID)
+		if err != nil {
+			return fmt.Errorf("servicenow.group.add.addgroupmember(%s, %s) -> %w", g.groupID, email, err)
+		}
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
+	}
+
+	g.logger.Println("Finished adding accounts successfully")
+
+	return nil
+}
+
+// Remove emails from a ServiceNow group.
+func (g *Group) Remove(ctx context.Context, emails []string) error {
+	g.logger.Printf("Removing %s from ServiceNow group %s", emails, g.groupID)
+
+	// If the cache hasn't been generated, regenerate it.
+	if g.cache == nil {
+		return fmt.Errorf("servicenow.group.remove -> %w", gosync.ErrCacheEmpty)
+	}
+
+	for index, email := range emails {
+		err := g.client.RemoveGroupMember(ctx, g.cache[email])
+		if err != nil {
+			return fmt.Errorf("servicenow.group.remove.removegroupmember(%s, %s) -> %w", g.groupID, email, err)
+		}
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
+	}
+
+	g.logger.Println("Finished removing accounts successfully")
+
+	return nil
+}
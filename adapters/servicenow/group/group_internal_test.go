@@ -0,0 +1,170 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	gosync "github.com/ovotech/go-sync"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	group := New(NewClient("https://test.service-now.com", "user", "pass"), "group-id")
+
+	assert.Equal(t, "group-id", group.groupID)
+	assert.Nil(t, group.cache)
+}
+
+func TestGroup_Config(t *testing.T) {
+	t.Parallel()
+
+	group := New(NewClient("https://test.service-now.com", "user", "secret-password"), "group-id")
+
+	assert.Equal(t, map[string]string{
+		"group":    "group-id",
+		"instance": "https://test.service-now.com",
+		"username": "user",
+		"password": gosync.Redacted,
+	}, group.Config())
+}
+
+func TestGroup_Get(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	client := newMockIServiceNowClient(t)
+	group := New(NewClient("", "", ""), "group-id")
+	group.client = client
+
+	// A full first page means there may be more members.
+	firstPage := make([]member, 0, pageLimit)
+	for i := 0; i < pageLimit; i++ {
+		firstPage = append(firstPage, member{
+			SysID:  fmt.Sprintf("member-%d", i),
+			UserID: fmt.Sprintf("user-%d", i),
+			Email:  fmt.Sprintf("%d@email", i),
+		})
+	}
+
+	client.EXPECT().GetGroupMembers(ctx, "group-id", 0, pageLimit).Return(firstPage, nil)
+	client.EXPECT().GetGroupMembers(ctx, "group-id", pageLimit, pageLimit).Return([]member{
+		{SysID: "member-foo", UserID: "user-foo", Email: "foo@email"},
+		{SysID: "member-bar", UserID: "user-bar", Email: ""},
+	}, nil)
+
+	emails, err := group.Get(ctx)
+
+	assert.NoError(t, err)
+	assert.Len(t, emails, pageLimit+1)
+	assert.Contains(t, emails, "foo@email")
+	assert.Equal(t, "member-foo", group.cache["foo@email"])
+	assert.Equal(t, "user-foo", group.users["foo@email"])
+}
+
+func TestGroup_Add(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		client := newMockIServiceNowClient(t)
+		group := New(NewClient("", "", ""), "group-id")
+		group.client = client
+		group.users = map[string]string{"foo@email": "user-foo"}
+
+		// foo is already cached, so only bar needs resolving.
+		client.EXPECT().GetUserIDByEmail(ctx, "bar@email").Once().Return("user-bar", nil)
+		client.EXPECT().AddGroupMember(ctx, "group-id", "user-foo").Return(nil)
+		client.EXPECT().AddGroupMember(ctx, "group-id", "user-bar").Return(nil)
+
+		err := group.Add(ctx, []string{"foo@email", "bar@email"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "user-bar", group.users["bar@email"])
+	})
+
+	t.Run("User not found", func(t *testing.T) {
+		t.Parallel()
+
+		client := newMockIServiceNowClient(t)
+		group := New(NewClient("", "", ""), "group-id")
+		group.client = client
+
+		client.EXPECT().GetUserIDByEmail(ctx, "foo@email").Return("", nil)
+
+		err := group.Add(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+
+	t.Run("Read only", func(t *testing.T) {
+		t.Parallel()
+
+		client := newMockIServiceNowClient(t)
+		group := New(NewClient("", "", ""), "group-id")
+		group.client = client
+		group.users = map[string]string{"foo@email": "user-foo"}
+
+		client.EXPECT().AddGroupMember(ctx, "group-id", "user-foo").Return(gosync.ErrReadOnly)
+
+		err := group.Add(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, gosync.ErrReadOnly)
+	})
+}
+
+func TestGroup_Remove(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		client := newMockIServiceNowClient(t)
+		group := New(NewClient("", "", ""), "group-id")
+		group.client = client
+		group.cache = map[string]string{"foo@email": "member-foo", "bar@email": "member-bar"}
+
+		client.EXPECT().RemoveGroupMember(ctx, "member-foo").Return(nil)
+		client.EXPECT().RemoveGroupMember(ctx, "member-bar").Return(nil)
+
+		err := group.Remove(ctx, []string{"foo@email", "bar@email"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Cache empty", func(t *testing.T) {
+		t.Parallel()
+
+		group := New(NewClient("", "", ""), "group-id")
+
+		err := group.Remove(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, gosync.ErrCacheEmpty)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		testErr := errors.New("foo") //nolint:goerr113
+
+		client := newMockIServiceNowClient(t)
+		group := New(NewClient("", "", ""), "group-id")
+		group.client = client
+		group.cache = map[string]string{"foo@email": "member-foo"}
+
+		client.EXPECT().RemoveGroupMember(ctx, "member-foo").Return(testErr)
+
+		err := group.Remove(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, testErr)
+	})
+}
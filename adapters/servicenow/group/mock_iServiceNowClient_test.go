@@ -0,0 +1,208 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package group
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockIServiceNowClient is an autogenerated mock type for the iServiceNowClient type
+type mockIServiceNowClient struct {
+	mock.Mock
+}
+
+type mockIServiceNowClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockIServiceNowClient) EXPECT() *mockIServiceNowClient_Expecter {
+	return &mockIServiceNowClient_Expecter{mock: &_m.Mock}
+}
+
+// AddGroupMember provides a mock function with given fields: ctx, groupID, userID
+func (_m *mockIServiceNowClient) AddGroupMember(ctx context.Context, groupID string, userID string) error {
+	ret := _m.Called(ctx, groupID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, groupID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockIServiceNowClient_AddGroupMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddGroupMember'
+type mockIServiceNowClient_AddGroupMember_Call struct {
+	*mock.Call
+}
+
+// AddGroupMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID string
+//   - userID string
+func (_e *mockIServiceNowClient_Expecter) AddGroupMember(ctx interface{}, groupID interface{}, userID interface{}) *mockIServiceNowClient_AddGroupMember_Call {
+	return &mockIServiceNowClient_AddGroupMember_Call{Call: _e.mock.On("AddGroupMember", ctx, groupID, userID)}
+}
+
+func (_c *mockIServiceNowClient_AddGroupMember_Call) Run(run func(ctx context.Context, groupID string, userID string)) *mockIServiceNowClient_AddGroupMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockIServiceNowClient_AddGroupMember_Call) Return(_a0 error) *mockIServiceNowClient_AddGroupMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// GetGroupMembers provides a mock function with given fields: ctx, groupID, offset, limit
+func (_m *mockIServiceNowClient) GetGroupMembers(ctx context.Context, groupID string, offset int, limit int) ([]member, error) {
+	ret := _m.Called(ctx, groupID, offset, limit)
+
+	var r0 []member
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []member); ok {
+		r0 = rf(ctx, groupID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]member)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, groupID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockIServiceNowClient_GetGroupMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupMembers'
+type mockIServiceNowClient_GetGroupMembers_Call struct {
+	*mock.Call
+}
+
+// GetGroupMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID string
+//   - offset int
+//   - limit int
+func (_e *mockIServiceNowClient_Expecter) GetGroupMembers(ctx interface{}, groupID interface{}, offset interface{}, limit interface{}) *mockIServiceNowClient_GetGroupMembers_Call {
+	return &mockIServiceNowClient_GetGroupMembers_Call{Call: _e.mock.On("GetGroupMembers", ctx, groupID, offset, limit)}
+}
+
+func (_c *mockIServiceNowClient_GetGroupMembers_Call) Run(run func(ctx context.Context, groupID string, offset int, limit int)) *mockIServiceNowClient_GetGroupMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *mockIServiceNowClient_GetGroupMembers_Call) Return(_a0 []member, _a1 error) *mockIServiceNowClient_GetGroupMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetUserIDByEmail provides a mock function with given fields: ctx, email
+func (_m *mockIServiceNowClient) GetUserIDByEmail(ctx context.Context, email string) (string, error) {
+	ret := _m.Called(ctx, email)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockIServiceNowClient_GetUserIDByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIDByEmail'
+type mockIServiceNowClient_GetUserIDByEmail_Call struct {
+	*mock.Call
+}
+
+// GetUserIDByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *mockIServiceNowClient_Expecter) GetUserIDByEmail(ctx interface{}, email interface{}) *mockIServiceNowClient_GetUserIDByEmail_Call {
+	return &mockIServiceNowClient_GetUserIDByEmail_Call{Call: _e.mock.On("GetUserIDByEmail", ctx, email)}
+}
+
+func (_c *mockIServiceNowClient_GetUserIDByEmail_Call) Run(run func(ctx context.Context, email string)) *mockIServiceNowClient_GetUserIDByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockIServiceNowClient_GetUserIDByEmail_Call) Return(_a0 string, _a1 error) *mockIServiceNowClient_GetUserIDByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// RemoveGroupMember provides a mock function with given fields: ctx, memberID
+func (_m *mockIServiceNowClient) RemoveGroupMember(ctx context.Context, memberID string) error {
+	ret := _m.Called(ctx, memberID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, memberID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockIServiceNowClient_RemoveGroupMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveGroupMember'
+type mockIServiceNowClient_RemoveGroupMember_Call struct {
+	*mock.Call
+}
+
+// RemoveGroupMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - memberID string
+func (_e *mockIServiceNowClient_Expecter) RemoveGroupMember(ctx interface{}, memberID interface{}) *mockIServiceNowClient_RemoveGroupMember_Call {
+	return &mockIServiceNowClient_RemoveGroupMember_Call{Call: _e.mock.On("RemoveGroupMember", ctx, memberID)}
+}
+
+func (_c *mockIServiceNowClient_RemoveGroupMember_Call) Run(run func(ctx context.Context, memberID string)) *mockIServiceNowClient_RemoveGroupMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockIServiceNowClient_RemoveGroupMember_Call) Return(_a0 error) *mockIServiceNowClient_RemoveGroupMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+type mockConstructorTestingTnewMockIServiceNowClient interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// newMockIServiceNowClient creates a new instance of mockIServiceNowClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func newMockIServiceNowClient(t mockConstructorTestingTnewMockIServiceNowClient) *mockIServiceNowClient {
+	mock := &mockIServiceNowClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,117 @@
+package conversation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	gosync "github.com/ovotech/go-sync"
+)
+
+// WithCacheFile persists the email -> ID cache to a file, so Remove can be called in a different process to Get.
+// The users Remove must never kick (the Slack app itself, admins and excluded guests) are persisted alongside it, so
+// they're still protected. The cache is loaded from the file when the adapter is built, and written to it each time
+// Get, Add or Remove changes the cache. A missing or corrupt file is ignored, leaving the cache empty until Get is
+// called.
+func WithCacheFile(path string) func(*Conversation) {
+	return func(conversation *Conversation) {
+		conversation.cacheFile = path
+	}
+}
+
+// cacheFileContents is the format of the cache file. Files without a cache, including a bare email -> ID map written
+// by older versions, are ignored.
+type cacheFileContents struct {
+	Cache      map[string]string   `json:"cache"`
+	Duplicates map[string][]string `json:"duplicates,omitempty"`
+	Guests     map[string]bool     `json:"guests,omitempty"`
+	Admins     map[string]bool     `json:"admins,omitempty"`
+	SelfID     string              `json:"selfId,omitempty"`
+}
+
+// loadCache loads the cache from the cache file, if one is set. Any error leaves the cache as it was.
+func (c *Conversation) loadCache() {
+	if c.cacheFile == "" {
+		return
+	}
+
+	data, err := os.ReadFile(c.cacheFile)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			c.logger.Printf("Ignoring cache file %s, as it couldn't be read: %s", c.cacheFile, err)
+		}
+
+		return
+	}
+
+	var contents cacheFileContents
+
+	if err := json.Unmarshal(data, &contents); err != nil || contents.Cache == nil {
+		c.logger.Printf("Ignoring cache file %s, as it isn't a valid cache: %v", c.cacheFile, err)
+
+		return
+	}
+
+	c.cache = contents.Cache
+	c.duplicates = contents.Duplicates
+	c.guests = contents.Guests
+	c.admins = contents.Admins
+	c.selfID = contents.SelfID
+}
+
+// saveCache atomically writes the cache to the cache file, if one is set. As the sync itself has succeeded, failing to
+// write the file is reported as a warning rather than an error.
+func (c *Conversation) saveCache(ctx context.Context) {
+	if c.cacheFile == "" || c.cache == nil {
+		return
+	}
+
+	contents := cacheFileContents{
+		Cache:      c.cache,
+		Duplicates: c.duplicates,
+		Guests:     c.guests,
+		Admins:     c.admins,
+		SelfID:     c.selfID,
+	}
+
+	if err := writeFileAtomic(c.cacheFile, contents); err != nil {
+		c.logger.Printf("Failed to write cache file %s: %s", c.cacheFile, err)
+		gosync.Warn(ctx, fmt.Errorf("slack.conversation.savecache(%s) -> %w", c.cacheFile, err))
+	}
+}
+
+// writeFileAtomic writes v as JSON to a temporary file alongside path, then renames it over path, so a crash part way
+// through never leaves a partially written file.
+func writeFileAtomic(path string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshal -> %w", err)
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("createtemp -> %w", err)
+	}
+
+	// Clean up the temporary file if anything fails before it's renamed.
+	defer os.Remove(file.Name()) //nolint:errcheck
+
+	if _, err = file.Write(data); err != nil {
+		_ = file.Close()
+
+		return fmt.Errorf("write -> %w", err)
+	}
+
+	if err = file.Close(); err != nil {
+		return fmt.Errorf("close -> %w", err)
+	}
+
+	if err = os.Rename(file.Name(), path); err != nil {
+		return fmt.Errorf("rename -> %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package conversation
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gosync "github.com/ovotech/go-sync"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+//nolint:funlen
+func TestConversation_WithCacheFile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Round trip", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "cache.json")
+
+		slackClient := newMockISlackConversation(t)
+		adapter := New(&slack.Client{}, "C0TEST", WithCacheFile(path))
+		adapter.client = slackClient
+
+		assert.Nil(t, adapter.cache)
+
+		slackClient.EXPECT().AuthTestContext(ctx).Return(&slack.AuthTestResponse{UserID: "self"}, nil).Once()
+		slackClient.EXPECT().GetConversationInfoContext(ctx, mock.Anything, false).Return(&slack.Channel{}, nil)
+		slackClient.EXPECT().GetUsersInConversationContext(ctx, mock.Anything).Return([]string{"foo", "bar"}, "", nil)
+		slackClient.EXPECT().GetUsersInfoContext(ctx, "foo", "bar").Return(&[]slack.User{
+			{ID: "foo", Profile: slack.UserProfile{Email: "foo@email"}},
+			{ID: "bar", Profile: slack.UserProfile{Email: "bar@email"}},
+		}, nil)
+
+		_, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+
+		// A new adapter, e.g. in another process, can remove without calling Get.
+		slackClient = newMockISlackConversation(t)
+		adapter = New(&slack.Client{}, "C0TEST", WithCacheFile(path), WithRemoveDelay(0))
+		adapter.client = slackClient
+
+		assert.Equal(t, map[string]string{"foo@email": "foo", "bar@email": "bar"}, adapter.cache)
+
+		slackClient.EXPECT().KickUserFromConversationContext(ctx, "C0TEST", "foo").Return(nil).Once()
+
+		err = adapter.Remove(ctx, []string{"foo@email"})
+
+		assert.NoError(t, err)
+
+		// The removal is persisted too.
+		adapter = New(&slack.Client{}, "C0TEST", WithCacheFile(path))
+
+		assert.Equal(t, map[string]string{"bar@email": "bar"}, adapter.cache)
+
+		// No temporary files are left behind.
+		entries, err := os.ReadDir(filepath.Dir(path))
+
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+
+	t.Run("Protected users", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := gosync.ContextWithWarnings(ctx)
+		path := filepath.Join(t.TempDir(), "cache.json")
+
+		slackClient := newMockISlackConversation(t)
+		adapter := New(&slack.Client{}, "C0TEST", WithCacheFile(path))
+		adapter.client = slackClient
+		adapter.ExcludeSingleChannelGuests = true
+
+		slackClient.EXPECT().AuthTestContext(ctx).Return(&slack.AuthTestResponse{UserID: "self"}, nil).Once()
+		slackClient.EXPECT().GetConversationInfoContext(ctx, mock.Anything, false).
+			Return(&slack.Channel{GroupConversation: slack.GroupConversation{Creator: "creator"}}, nil)
+		slackClient.EXPECT().GetUsersInConversationContext(ctx, mock.Anything).
+			Return([]string{"foo", "admin", "creator", "guest"}, "", nil)
+		slackClient.EXPECT().GetUsersInfoContext(ctx, "foo", "admin", "creator", "guest").Return(&[]slack.User{
+			{ID: "foo", Profile: slack.UserProfile{Email: "foo@email"}},
+			{ID: "admin", IsAdmin: true, Profile: slack.UserProfile{Email: "admin@email"}},
+			{ID: "creator", Profile: slack.UserProfile{Email: "creator@email"}},
+			{ID: "guest", IsUltraRestricted: true, Profile: slack.UserProfile{Email: "guest@email"}},
+		}, nil)
+
+		_, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+
+		// A new adapter removes without calling Get, and still doesn't kick the admin, creator, guest or itself.
+		slackClient = newMockISlackConversation(t)
+		adapter = New(&slack.Client{}, "C0TEST", WithCacheFile(path), WithRemoveDelay(0))
+		adapter.client = slackClient
+		adapter.cache["self@email"] = "self"
+		adapter.cache["guest@email"] = "guest"
+
+		slackClient.EXPECT().KickUserFromConversationContext(ctx, "C0TEST", "foo").Return(nil).Once()
+
+		err = adapter.Remove(ctx, []string{"foo@email", "admin@email", "creator@email", "guest@email", "self@email"})
+
+		assert.NoError(t, err)
+		assert.Len(t, gosync.Warnings(ctx), 4)
+	})
+
+	t.Run("Missing file", func(t *testing.T) {
+		t.Parallel()
+
+		adapter := New(&slack.Client{}, "C0TEST", WithCacheFile(filepath.Join(t.TempDir(), "missing.json")))
+
+		err := adapter.Remove(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, gosync.ErrCacheEmpty)
+	})
+
+	t.Run("Corrupt file", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "cache.json")
+
+		assert.NoError(t, os.WriteFile(path, []byte("{not json"), 0o600))
+
+		adapter := New(&slack.Client{}, "C0TEST", WithCacheFile(path))
+
+		err := adapter.Remove(ctx, []string{"foo@email"})
+
+		assert.ErrorIs(t, err, gosync.ErrCacheEmpty)
+	})
+
+	t.Run("Unwritable file", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := gosync.ContextWithWarnings(ctx)
+
+		adapter := New(&slack.Client{}, "C0TEST", WithCacheFile(filepath.Join(t.TempDir(), "missing", "cache.json")))
+		adapter.cache = map[string]string{"foo@email": "foo"}
+
+		adapter.saveCache(ctx)
+
+		assert.Len(t, gosync.Warnings(ctx), 1)
+	})
+}
@@ -8,37 +8,167 @@ package conversation
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	gosync "github.com/ovotech/go-sync"
+	"github.com/ovotech/go-sync/internal/paginate"
 	"github.com/slack-go/slack"
 )
 
-// Ensure the adapter type fully satisfies the ports.Adapter interface.
-var _ gosync.Adapter = &Conversation{}
+// Ensure the adapter type fully satisfies the ports.Adapter, ports.ConfiguredAdapter and ports.Namer interfaces.
+var (
+	_ gosync.Adapter           = &Conversation{}
+	_ gosync.ConfiguredAdapter = &Conversation{}
+	_ gosync.Namer             = &Conversation{}
+)
+
+// ErrProtectedUser is reported as a warning when Remove skips a user that must never be kicked.
+var ErrProtectedUser = errors.New("user is protected from removal")
+
+// ErrCacheMiss is returned by Remove in strict mode, when an email wasn't in the conversation when Get was called.
+var ErrCacheMiss = errors.New("email not found in cache")
+
+// ErrDuplicateEmail is reported as a warning when Get finds more than one Slack user with the same email.
+var ErrDuplicateEmail = errors.New("email belongs to more than one slack user")
+
+// ErrMissingEmail is reported as a warning when Get skips a Slack user without an email, e.g. a guest account or
+// because the Slack app is missing the users:read.email scope.
+var ErrMissingEmail = errors.New("slack user has no email")
+
+// ErrUserNotFound is reported as a warning when Add skips an email that doesn't belong to a Slack user.
+var ErrUserNotFound = errors.New("no slack user with email")
+
+// ErrMissingScope is returned when the Slack app doesn't have an OAuth scope needed by a call. The error names the
+// call and the scopes it needs.
+var ErrMissingScope = errors.New("slack app is missing a scope")
+
+// ErrConversationNotFound is returned when a conversation name can't be resolved to a Slack conversation ID.
+var ErrConversationNotFound = errors.New("conversation not found")
+
+// Scopes needed by the Slack calls the adapter makes, for naming in ErrMissingScope.
+const (
+	readScopes   = "channels:read or groups:read"
+	manageScopes = "channels:manage or groups:write"
+)
+
+// conversationIDPattern matches Slack conversation IDs, e.g. C0123ABCD for channels or G0123ABCD for private channels.
+var conversationIDPattern = regexp.MustCompile(`^[CDG][A-Z0-9]+$`)
+
+// inviteLimit is the most users Slack allows to be invited to a conversation in a single call.
+const inviteLimit = 1000
+
+const (
+	// defaultRemoveDelay is how long Remove sleeps after each kick by default.
+	defaultRemoveDelay = time.Second
+	// defaultUsersInfoChunkSize is the most users whose info Get requests in a single call by default.
+	defaultUsersInfoChunkSize = 30
+	// defaultMaxRateLimitRetries is how many times a call is retried after Slack responds with rate_limited by default.
+	defaultMaxRateLimitRetries = 3
+	// defaultPageSize is how many users Get requests per page of conversation members by default.
+	defaultPageSize = 200
+	// maxPageSize is the most users Slack returns per page of conversation members.
+	maxPageSize = 1000
+)
+
+// duplicatePolicy specifies how Get handles more than one Slack user with the same email, e.g. merged accounts.
+type duplicatePolicy string
+
+const (
+	// KeepFirst keeps the first Slack user found with an email, and Remove only kicks that user.
+	KeepFirst duplicatePolicy = "KeepFirst"
+	// KeepLast keeps the last Slack user found with an email, and Remove only kicks that user.
+	KeepLast duplicatePolicy = "KeepLast"
+	// RemoveAll keeps every Slack user found with an email, and Remove kicks all of them.
+	RemoveAll duplicatePolicy = "RemoveAll"
+)
 
 // iSlackConversation is a subset of the Slack Client, and used to build mocks for easy testing.
 type iSlackConversation interface {
-	GetUsersInConversation(params *slack.GetUsersInConversationParameters) ([]string, string, error)
-	GetUsersInfo(users ...string) (*[]slack.User, error)
-	GetUserByEmail(email string) (*slack.User, error)
-	InviteUsersToConversation(channelID string, users ...string) (*slack.Channel, error)
-	KickUserFromConversation(channelID string, user string) error
+	GetConversationsContext(
+		ctx context.Context,
+		params *slack.GetConversationsParameters,
+	) ([]slack.Channel, string, error)
+	GetUsersInConversationContext(
+		ctx context.Context,
+		params *slack.GetUsersInConversationParameters,
+	) ([]string, string, error)
+	GetUsersInfoContext(ctx context.Context, users ...string) (*[]slack.User, error)
+	GetUserByEmailContext(ctx context.Context, email string) (*slack.User, error)
+	InviteUsersToConversationContext(ctx context.Context, channelID string, users ...string) (*slack.Channel, error)
+	KickUserFromConversationContext(ctx context.Context, channelID string, user string) error
+	AuthTestContext(ctx context.Context) (*slack.AuthTestResponse, error)
+	CreateConversationContext(ctx context.Context, channelName string, isPrivate bool) (*slack.Channel, error)
+	GetConversationInfoContext(ctx context.Context, channelID string, includeLocale bool) (*slack.Channel, error)
 }
 
 type Conversation struct {
 	// Slack may be configured to only allow admins to kick from public conversations, which will fail the entire sync
 	// job. Set to true to mute this error and continue synchronisation.
 	MuteRestrictedErrOnKickFromPublic bool
-	client                            iSlackConversation
-	conversationName                  string
+	// The Slack app is itself a member of the conversation, and shouldn't be managed by Go Sync. When true (default),
+	// the app's own user is excluded from Get and never kicked by Remove.
+	ExcludeSelf bool
+	// Workspace admins and owners, and the conversation's creator, are protected so a bad source can't lock them out.
+	// When true (default), they're still returned by Get, but never kicked by Remove.
+	ProtectAdmins bool
+	// Guests can be left unmanaged by Go Sync, so they're excluded from Get and never kicked by Remove. Slack marks
+	// single-channel guests with IsUltraRestricted, and multi-channel guests with IsRestricted (but not
+	// IsUltraRestricted). Both are false by default, so guests are managed like any other member.
+	ExcludeSingleChannelGuests bool
+	ExcludeMultiChannelGuests  bool
+	// If ctx is cancelled while Get is paginating, nothing is returned by default. Set to true to instead return the
+	// accounts fetched so far along with the context's error, for callers that can make use of partial data.
+	PartialResultsOnCancel bool
+	// Change which Slack user is removed when more than one has the same email. Default is KeepFirst.
+	DuplicatePolicy duplicatePolicy
+	// Remove looks up emails that aren't in the cache built by Get. Set to true to instead treat the cache as an
+	// authoritative snapshot, and fail with ErrCacheMiss, for deterministic behaviour based solely on the snapshot.
+	StrictCache bool
+	// If the adapter was given a conversation name that doesn't exist, Get fails with ErrConversationNotFound. Set
+	// CreateIfMissing to true to create it instead, as a private channel if CreatePrivate is also true. The Slack app
+	// needs the channels:manage scope to create public channels, or groups:write for private channels.
+	CreateIfMissing  bool
+	CreatePrivate    bool
+	client           iSlackConversation
+	conversationName string
+	// conversationID is the Slack ID of the conversation, resolved from conversationName if it's a name (e.g. #foo).
+	conversationID string
 	// cache stores the Slack ID -> email mapping for use with the Remove method.
-	cache  map[string]string
-	logger *log.Logger
+	cache map[string]string
+	// cacheFile is where the cache is persisted between processes, if set with WithCacheFile.
+	cacheFile string
+	// duplicates stores the IDs of any other Slack users with the same email, when the DuplicatePolicy is RemoveAll.
+	duplicates map[string][]string
+	// guests stores the Slack IDs of guests excluded by ExcludeSingleChannelGuests or ExcludeMultiChannelGuests.
+	guests map[string]bool
+	// admins stores the Slack IDs of the admins, owners and creator protected by ProtectAdmins.
+	admins      map[string]bool
+	selfID      string             // selfID is the Slack ID of the authenticated app, discovered via auth.test.
+	protected   map[string]bool    // protected are the Slack IDs and emails that Remove never kicks.
+	rateLimiter gosync.RateLimiter // rateLimiter throttles kicks in Remove, instead of sleeping.
+	maxRequeues int                // maxRequeues is how many times Remove retries a kick that failed transiently.
+	removeDelay time.Duration      // removeDelay is how long Remove sleeps after each kick.
+	// maxRateLimitRetries is how many times a call is retried after waiting for Slack's Retry-After duration.
+	maxRateLimitRetries int
+	// usersInfoChunkSize is the most users whose info Get requests in a single call.
+	usersInfoChunkSize int
+	// pageSize is how many users Get requests per page of conversation members.
+	pageSize int
+	// softRemove is called instead of kicking users, if set with WithSoftRemove.
+	softRemove func(ctx context.Context, channelID string, userID string) error
+	// sleep waits between kicks, or until ctx is done, and can be replaced in tests.
+	sleep      func(ctx context.Context, d time.Duration) error
+	structured gosync.StructuredLogger
+	logger     *log.Logger
 }
 
 // WithLogger sets a custom logger.
@@ -48,13 +178,120 @@ func WithLogger(logger *log.Logger) func(*Conversation) {
 	}
 }
 
+// WithStructuredLogger logs to a structured logger (e.g. a *slog.Logger) instead, with the adapter and conversation
+// as fields. Summaries of each Get/Add/Remove also include the number of emails.
+func WithStructuredLogger(logger gosync.StructuredLogger) func(*Conversation) {
+	return func(conversation *Conversation) {
+		conversation.structured = logger
+		conversation.logger = gosync.NewLogLogger(logger, conversation.logFields()...)
+	}
+}
+
+// WithRateLimiter throttles Remove using a (possibly shared) rate limiter. By default, Remove sleeps for 1 second after
+// each kick; with a rate limiter set, the sleep is skipped and the limiter is waited on before each kick instead, so
+// calls aren't throttled twice.
+func WithRateLimiter(limiter gosync.RateLimiter) func(*Conversation) {
+	return func(conversation *Conversation) {
+		conversation.rateLimiter = limiter
+	}
+}
+
+// WithRemoveDelay sets how long Remove sleeps after each kick, to avoid Slack's rate limits. Default is 1 second,
+// and 0 disables the sleep. Add invites users in as few calls as possible, so isn't delayed.
+func WithRemoveDelay(delay time.Duration) func(*Conversation) {
+	return func(conversation *Conversation) {
+		conversation.removeDelay = delay
+	}
+}
+
+// WithUsersInfoChunkSize sets the most users whose info Get requests from Slack in a single call, as Slack limits
+// how many can be requested at once. Default is 30.
+func WithUsersInfoChunkSize(size int) func(*Conversation) {
+	return func(conversation *Conversation) {
+		if size > 0 {
+			conversation.usersInfoChunkSize = size
+		}
+	}
+}
+
+// WithPageSize sets how many users Get requests per page of conversation members. Larger pages need fewer calls for
+// big conversations. Default is 200, and sizes outside of Slack's 1-1000 range are clamped.
+func WithPageSize(size int) func(*Conversation) {
+	return func(conversation *Conversation) {
+		switch {
+		case size < 1:
+			conversation.pageSize = 1
+		case size > maxPageSize:
+			conversation.pageSize = maxPageSize
+		default:
+			conversation.pageSize = size
+		}
+	}
+}
+
+// WithProtectedUsers pins users that Remove must never kick (e.g. workspace admins or other bots), by Slack ID or
+// email. Protected users are skipped and reported as an ErrProtectedUser warning, like the Slack app's own user.
+func WithProtectedUsers(users ...string) func(*Conversation) {
+	return func(conversation *Conversation) {
+		for _, user := range users {
+			conversation.protected[strings.ToLower(user)] = true
+		}
+	}
+}
+
+// WithMaxRateLimitRetries sets how many times a call to Slack is retried after it responds with rate_limited. Each
+// retry waits for the Retry-After duration given by Slack, or until ctx is done. Default is 3, and 0 disables retries.
+func WithMaxRateLimitRetries(maxRetries int) func(*Conversation) {
+	return func(conversation *Conversation) {
+		conversation.maxRateLimitRetries = maxRetries
+	}
+}
+
+// WithSoftRemove calls fn instead of kicking users in Remove, for conversations where members can't be kicked but
+// should have some other action taken (e.g. posting a message or opening a ticket). Users are removed from the cache
+// as if they had been kicked, and the delay between kicks still applies.
+func WithSoftRemove(fn func(ctx context.Context, channelID string, userID string) error) func(*Conversation) {
+	return func(conversation *Conversation) {
+		conversation.softRemove = fn
+	}
+}
+
+// WithMaxRequeues sets how many times Remove retries kicking a user after a transient error (e.g. rate limiting or a
+// Slack server error). Rather than retrying immediately, the user is requeued to the end of the batch, so one blip
+// doesn't hold up the rest. Default is 1, and 0 fails on the first error.
+func WithMaxRequeues(maxRequeues int) func(*Conversation) {
+	return func(conversation *Conversation) {
+		conversation.maxRequeues = maxRequeues
+	}
+}
+
 // New instantiates a new Slack conversation adapter.
 func New(client *slack.Client, channelName string, optsFn ...func(conversation *Conversation)) *Conversation {
 	conversation := &Conversation{
 		MuteRestrictedErrOnKickFromPublic: false,
+		ExcludeSelf:                       true,
+		ProtectAdmins:                     true,
+		ExcludeSingleChannelGuests:        false,
+		ExcludeMultiChannelGuests:         false,
+		PartialResultsOnCancel:            false,
+		DuplicatePolicy:                   KeepFirst,
+		StrictCache:                       false,
+		CreateIfMissing:                   false,
+		CreatePrivate:                     false,
 		client:                            client,
 		conversationName:                  channelName,
 		cache:                             nil,
+		cacheFile:                         "",
+		protected:                         make(map[string]bool),
+		rateLimiter:                       nil,
+		softRemove:                        nil,
+		maxRequeues:                       1,
+		maxRateLimitRetries:               defaultMaxRateLimitRetries,
+		removeDelay:                       defaultRemoveDelay,
+		usersInfoChunkSize:                defaultUsersInfoChunkSize,
+		pageSize:                          defaultPageSize,
+		sleep:                             sleepContext,
+		structured:                        nil,
 		logger: log.New(
 			os.Stderr,
 			"[go-sync/slack/conversation] ",
@@ -66,101 +303,733 @@ func New(client *slack.Client, channelName string, optsFn ...func(conversation *
 		fn(conversation)
 	}
 
+	conversation.loadCache()
+
 	return conversation
 }
 
-// getListOfSlackUsernames gets a list of Slack users in a conversation, and paginates through the results.
-func (c *Conversation) getListOfSlackUsernames() ([]string, error) {
-	var (
-		cursor string
-		users  []string
-		err    error
-	)
+// NewWithHTTPClient instantiates a new Slack conversation adapter, with a Slack client for token that makes its calls
+// with httpClient, e.g. to use a corporate proxy, custom TLS configuration or timeouts. Every call to Slack is passed
+// the context of the sync, so its deadline and cancellation are honoured by httpClient too.
+func NewWithHTTPClient(
+	token string,
+	httpClient *http.Client,
+	channelName string,
+	optsFn ...func(conversation *Conversation),
+) *Conversation {
+	return New(slack.New(token, slack.OptionHTTPClient(httpClient)), channelName, optsFn...)
+}
+
+// logFields returns the fields included with every message sent to a structured logger.
+func (c *Conversation) logFields() []any {
+	return []any{"adapter", "slack/conversation", "conversation", c.conversationName}
+}
+
+// logSummary logs the summary of a Get/Add/Remove, including the number of emails if a structured logger is set.
+func (c *Conversation) logSummary(msg string, count int) {
+	if c.structured == nil {
+		c.logger.Println(msg)
+
+		return
+	}
+
+	c.structured.Info(msg, append(c.logFields(), "emails", count)...)
+}
+
+// sleepContext sleeps for d, or until ctx is done, in which case the context's error is returned.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err() //nolint:wrapcheck
+	case <-timer.C:
+		return nil
+	}
+}
+
+// getSelfID discovers the Slack ID of the authenticated app, and caches it for subsequent calls.
+func (c *Conversation) getSelfID(ctx context.Context) (string, error) {
+	if c.selfID == "" {
+		response, err := c.client.AuthTestContext(ctx)
+		if err != nil {
+			return "", fmt.Errorf("authtest -> %w", scoped("auth.test", "users:read", err))
+		}
+
+		c.selfID = response.UserID
+	}
+
+	return c.selfID, nil
+}
+
+// getConversationID resolves the Slack ID of the conversation, and caches it for subsequent calls. If the adapter was
+// given a name (e.g. #engineering) rather than an ID, the conversations in the workspace are searched for it.
+func (c *Conversation) getConversationID(ctx context.Context) (string, error) {
+	if c.conversationID != "" {
+		return c.conversationID, nil
+	}
+
+	name := strings.TrimPrefix(c.conversationName, "#")
+
+	if name == c.conversationName && conversationIDPattern.MatchString(name) {
+		c.conversationID = name
+
+		return c.conversationID, nil
+	}
+
+	// Stop paginating as soon as the conversation is found.
+	found, err := paginate.Collect(func(cursor string) ([]slack.Channel, string, error) {
+		params := &slack.GetConversationsParameters{
+			Cursor:          cursor,
+			ExcludeArchived: true,
+			Limit:           200, //nolint:gomnd
+			Types:           []string{"public_channel", "private_channel"},
+		}
+
+		channels, next, err := c.client.GetConversationsContext(ctx, params)
+		if err != nil {
+			return nil, "", err //nolint:wrapcheck
+		}
+
+		for _, channel := range channels {
+			if channel.Name == name {
+				return []slack.Channel{channel}, "", nil
+			}
+		}
+
+		return nil, next, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("getconversations(%s) -> %w", name, scoped("conversations.list", readScopes, err))
+	}
+
+	if len(found) > 0 {
+		c.conversationID = found[0].ID
+
+		return c.conversationID, nil
+	}
+
+	if c.CreateIfMissing {
+		return c.createConversation(ctx, name)
+	}
+
+	return "", fmt.Errorf("getconversations(%s) -> %w", name, ErrConversationNotFound)
+}
+
+// createConversation creates a conversation that couldn't be found, if CreateIfMissing is set, and caches its ID.
+func (c *Conversation) createConversation(ctx context.Context, name string) (string, error) {
+	c.logger.Printf("Conversation %s not found, creating it", name)
+
+	channel, err := c.client.CreateConversationContext(ctx, name, c.CreatePrivate)
+
+	switch {
+	case err == nil:
+		c.conversationID = channel.ID
+
+		return c.conversationID, nil
+	case hasSlackError(err, "missing_scope"):
+		scope := "channels:manage"
+		if c.CreatePrivate {
+			scope = "groups:write"
+		}
+
+		return "", fmt.Errorf("createconversation(%s) -> %w", name, scoped("conversations.create", scope, err))
+	case hasSlackError(err, "name_taken"):
+		return "", fmt.Errorf(
+			"createconversation(%s) -> %w: the conversation already exists, but is archived or the slack app isn't a member",
+			name,
+			err,
+		)
+	default:
+		return "", fmt.Errorf("createconversation(%s) -> %w", name, err)
+	}
+}
+
+// retryRateLimited calls fn, and if Slack responds with rate_limited, waits for the Retry-After duration and calls it
+// again, up to maxRateLimitRetries times.
+func (c *Conversation) retryRateLimited(ctx context.Context, fn func() error) error {
+	for attempt := 0; ; attempt++ {
+		err := fn()
+
+		var rateLimitedErr *slack.RateLimitedError
+		if err == nil || attempt >= c.maxRateLimitRetries || !errors.As(err, &rateLimitedErr) {
+			return err
+		}
+
+		c.logger.Printf("Rate limited by Slack, retrying in %s", rateLimitedErr.RetryAfter)
+
+		if err := c.sleep(ctx, rateLimitedErr.RetryAfter); err != nil {
+			return err
+		}
+	}
+}
+
+// getListOfSlackUsers gets the Slack users in a conversation, paginating through the results and fetching the users'
+// info a page at a time. If ctx is cancelled part way through, the users fetched so far are returned with the error.
+func (c *Conversation) getListOfSlackUsers(ctx context.Context) ([]slack.User, error) {
+	page := 0
+
+	users, err := paginate.Collect(func(cursor string) ([]slack.User, string, error) {
+		page++
+
+		if ctx.Err() != nil {
+			return nil, "", fmt.Errorf("getusersinconversation(%s) -> %w", c.conversationName, ctx.Err())
+		}
 
-	for {
 		params := &slack.GetUsersInConversationParameters{
-			ChannelID: c.conversationName,
+			ChannelID: c.conversationID,
 			Cursor:    cursor,
-			Limit:     50, //nolint:gomnd
+			Limit:     c.pageSize,
 		}
 
-		var pageOfUsers []string
+		var (
+			pageOfUsers []string
+			next        string
+			pageUsers   []slack.User
+		)
+
+		err := c.retryRateLimited(ctx, func() error {
+			var callErr error
+
+			pageOfUsers, next, callErr = c.client.GetUsersInConversationContext(ctx, params)
 
-		pageOfUsers, cursor, err = c.client.GetUsersInConversation(params)
+			return callErr //nolint:wrapcheck
+		})
 		if err != nil {
-			return nil, fmt.Errorf("getusersinconversation(%s) -> %w", c.conversationName, err)
+			return nil, "", fmt.Errorf(
+				"getusersinconversation(%s) -> %w",
+				c.conversationName,
+				scoped("conversations.members", readScopes, err),
+			)
 		}
 
-		users = append(users, pageOfUsers...)
+		// Slack limits how many users' info can be requested in a single call.
+		for start := 0; start < len(pageOfUsers); start += c.usersInfoChunkSize {
+			end := start + c.usersInfoChunkSize
+			if end > len(pageOfUsers) {
+				end = len(pageOfUsers)
+			}
 
-		if cursor == "" {
-			break
+			var info *[]slack.User
+
+			err = c.retryRateLimited(ctx, func() error {
+				var callErr error
+
+				info, callErr = c.client.GetUsersInfoContext(ctx, pageOfUsers[start:end]...)
+
+				return callErr //nolint:wrapcheck
+			})
+			if err != nil {
+				return pageUsers, "", fmt.Errorf(
+					"getusersinfo(page %d, offset %d) -> %w",
+					page,
+					start,
+					scoped("users.info", "users:read", err),
+				)
+			}
+
+			pageUsers = append(pageUsers, *info...)
 		}
+
+		return pageUsers, next, nil
+	})
+	if err != nil {
+		return users, err //nolint:wrapcheck
 	}
 
 	return users, nil
 }
 
+// cacheUser adds the email -> ID map of a user for use with the Remove method, and returns false if the email has
+// already been seen. Duplicates are handled using the DuplicatePolicy.
+func (c *Conversation) cacheUser(ctx context.Context, user slack.User) bool {
+	email := user.Profile.Email
+
+	existing, ok := c.cache[email]
+	if !ok {
+		c.cache[email] = user.ID
+
+		return true
+	}
+
+	c.logger.Printf("Found more than one Slack user with email %s, using %s policy", email, c.DuplicatePolicy)
+	gosync.Warn(ctx, fmt.Errorf(
+		"slack.conversation.get(%s, %s, %s) -> %w",
+		email,
+		existing,
+		user.ID,
+		ErrDuplicateEmail,
+	))
+
+	switch c.DuplicatePolicy {
+	case KeepFirst:
+	case KeepLast:
+		c.cache[email] = user.ID
+	case RemoveAll:
+		c.duplicates[email] = append(c.duplicates[email], user.ID)
+	}
+
+	return false
+}
+
 // Get emails of Slack users in a conversation.
-func (c *Conversation) Get(_ context.Context) ([]string, error) {
+func (c *Conversation) Get(ctx context.Context) ([]string, error) {
 	c.logger.Printf("Fetching accounts from Slack conversation %s", c.conversationName)
 
+	if _, err := c.getConversationID(ctx); err != nil {
+		return nil, fmt.Errorf("slack.conversation.get.getconversationid -> %w", err)
+	}
+
 	// Initialise the cache.
 	c.cache = make(map[string]string)
+	c.duplicates = make(map[string][]string)
+	c.guests = make(map[string]bool)
+	c.admins = make(map[string]bool)
 
-	slackUsers, err := c.getListOfSlackUsernames()
-	if err != nil {
-		return nil, fmt.Errorf("slack.conversation.get.getlistofslackusernames -> %w", err)
+	var selfID string
+
+	if c.ExcludeSelf {
+		id, err := c.getSelfID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("slack.conversation.get.getselfid -> %w", err)
+		}
+
+		selfID = id
 	}
 
-	users, err := c.client.GetUsersInfo(slackUsers...)
-	if err != nil {
-		return nil, fmt.Errorf("slack.conversation.get.getusersinfo -> %w", err)
+	var creator string
+
+	if c.ProtectAdmins {
+		info, err := c.client.GetConversationInfoContext(ctx, c.conversationID, false)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"slack.conversation.get.getconversationinfo(%s) -> %w",
+				c.conversationName,
+				scoped("conversations.info", readScopes, err),
+			)
+		}
+
+		creator = info.Creator
 	}
 
-	emails := make([]string, 0, len(*users))
+	users, err := c.getListOfSlackUsers(ctx)
+	if err != nil && (!c.PartialResultsOnCancel || ctx.Err() == nil) {
+		return nil, fmt.Errorf("slack.conversation.get.getlistofslackusers -> %w", err)
+	}
 
-	for _, user := range *users {
-		if !user.IsBot {
-			emails = append(emails, user.Profile.Email)
+	emails := make([]string, 0, len(users))
+
+	for _, user := range users {
+		if selfID != "" && user.ID == selfID {
+			continue
+		}
 
-			// Add the email -> ID map for use with Remove method.
-			c.cache[user.Profile.Email] = user.ID
+		if user.IsBot {
+			continue
 		}
+
+		// Deactivated accounts are still listed as members, but can't be managed, and may no longer have an email.
+		if user.Deleted {
+			c.logger.Printf("Skipping %s, as their account is deactivated", user.ID)
+
+			continue
+		}
+
+		if c.isExcludedGuest(user) {
+			c.logger.Printf("Skipping %s, as guests are excluded", user.ID)
+			c.guests[user.ID] = true
+
+			continue
+		}
+
+		if user.Profile.Email == "" {
+			c.logger.Printf("Skipping %s, as they don't have an email", user.ID)
+			gosync.Warn(ctx, fmt.Errorf("slack.conversation.get(%s) -> %w", user.ID, ErrMissingEmail))
+
+			continue
+		}
+
+		if c.isAdmin(user) || (c.ProtectAdmins && user.ID == creator) {
+			c.admins[user.ID] = true
+		}
+
+		if c.cacheUser(ctx, user) {
+			emails = append(emails, user.Profile.Email)
+		}
+	}
+
+	if err != nil {
+		c.logger.Printf("Context cancelled, returning %d accounts fetched so far", len(emails))
+
+		return emails, fmt.Errorf("slack.conversation.get.getlistofslackusers -> %w", err)
 	}
 
-	c.logger.Println("Fetched accounts successfully")
+	c.saveCache(ctx)
+	c.logSummary("Fetched accounts successfully", len(emails))
 
 	return emails, nil
 }
 
-// Add emails to a Slack conversation.
-func (c *Conversation) Add(_ context.Context, emails []string) error {
+// Name returns the adapter's name for logs and metrics, e.g. slack/conversation:#general.
+func (c *Conversation) Name() string {
+	return "slack/conversation:" + c.conversationName
+}
+
+// Config returns the adapter's configuration. The Slack token is never included.
+func (c *Conversation) Config() map[string]string {
+	protected := make([]string, 0, len(c.protected))
+	for user := range c.protected {
+		protected = append(protected, user)
+	}
+
+	sort.Strings(protected)
+
+	return map[string]string{
+		"conversation":                      c.conversationName,
+		"muteRestrictedErrOnKickFromPublic": strconv.FormatBool(c.MuteRestrictedErrOnKickFromPublic),
+		"excludeSelf":                       strconv.FormatBool(c.ExcludeSelf),
+		"protectAdmins":                     strconv.FormatBool(c.ProtectAdmins),
+		"excludeSingleChannelGuests":        strconv.FormatBool(c.ExcludeSingleChannelGuests),
+		"excludeMultiChannelGuests":         strconv.FormatBool(c.ExcludeMultiChannelGuests),
+		"partialResultsOnCancel":            strconv.FormatBool(c.PartialResultsOnCancel),
+		"duplicatePolicy":                   string(c.DuplicatePolicy),
+		"strictCache":                       strconv.FormatBool(c.StrictCache),
+		"createIfMissing":                   strconv.FormatBool(c.CreateIfMissing),
+		"createPrivate":                     strconv.FormatBool(c.CreatePrivate),
+		"maxRequeues":                       strconv.Itoa(c.maxRequeues),
+		"maxRateLimitRetries":               strconv.Itoa(c.maxRateLimitRetries),
+		"rateLimiter":                       strconv.FormatBool(c.rateLimiter != nil),
+		"removeDelay":                       c.removeDelay.String(),
+		"usersInfoChunkSize":                strconv.Itoa(c.usersInfoChunkSize),
+		"pageSize":                          strconv.Itoa(c.pageSize),
+		"softRemove":                        strconv.FormatBool(c.softRemove != nil),
+		"protectedUsers":                    strings.Join(protected, ","),
+	}
+}
+
+// Reset clears the email -> ID cache built by Get and the identities resolved alongside it, and deletes the cache file
+// if one is set, e.g. to minimise how long sensitive data is kept in a long-lived process. Remove requires the cache,
+// so only call this once the adapter is no longer needed, or call Get again first.
+func (c *Conversation) Reset() {
+	for email := range c.cache {
+		delete(c.cache, email)
+	}
+
+	for email := range c.duplicates {
+		delete(c.duplicates, email)
+	}
+
+	c.cache = nil
+	c.duplicates = nil
+	c.guests = nil
+	c.admins = nil
+	c.selfID = ""
+
+	if c.cacheFile != "" {
+		if err := os.Remove(c.cacheFile); err != nil && !errors.Is(err, os.ErrNotExist) {
+			c.logger.Printf("Failed to delete cache file %s: %s", c.cacheFile, err)
+		}
+	}
+}
+
+// GetByDomain gets emails of Slack users in a conversation, grouped by their (lowercase) email domain, e.g. for
+// auditing external users. Emails are sorted within each domain.
+func (c *Conversation) GetByDomain(ctx context.Context) (map[string][]string, error) {
+	emails, err := c.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("slack.conversation.getbydomain -> %w", err)
+	}
+
+	domains := make(map[string][]string)
+
+	for _, email := range emails {
+		domain := ""
+		if index := strings.LastIndex(email, "@"); index != -1 {
+			domain = strings.ToLower(email[index+1:])
+		}
+
+		domains[domain] = append(domains[domain], email)
+	}
+
+	for _, bucket := range domains {
+		sort.Strings(bucket)
+	}
+
+	return domains, nil
+}
+
+// Add emails to a Slack conversation. Emails already in the cache built by Get are skipped, as they're already in the
+// conversation, so re-running a sync doesn't invite them again.
+func (c *Conversation) Add(ctx context.Context, emails []string) error {
 	c.logger.Printf("Adding %s to Slack conversation %s", emails, c.conversationName)
 
-	slackIds := make([]string, len(emails))
+	emails = c.withoutMembers(emails)
+	if len(emails) == 0 {
+		c.logger.Println("Skipping add, as all accounts are already in the conversation")
+
+		return nil
+	}
+
+	if _, err := c.getConversationID(ctx); err != nil {
+		return fmt.Errorf("slack.conversation.add.getconversationid -> %w", err)
+	}
+
+	emails, slackIds, err := c.lookupUsers(ctx, emails)
+	if err != nil {
+		return fmt.Errorf("slack.conversation.add -> %w", err)
+	}
+
+	// Slack only allows inviting a limited number of users per call.
+	for start := 0; start < len(slackIds); start += inviteLimit {
+		end := start + inviteLimit
+		if end > len(slackIds) {
+			end = len(slackIds)
+		}
 
-	for index, email := range emails {
-		user, err := c.client.GetUserByEmail(email)
+		err := c.invite(ctx, slackIds[start:end])
 		if err != nil {
-			return fmt.Errorf("slack.conversation.add.getuserbyemail(%s) -> %w", email, err)
+			return fmt.Errorf(
+				"slack.conversation.add.inviteuserstoconversation(%s, %d-%d) -> %w",
+				c.conversationName,
+				start,
+				end,
+				err,
+			)
+		}
+
+		// Update the cache as each chunk succeeds, so a later failure doesn't lose the users already invited.
+		if c.cache != nil {
+			for index := start; index < end; index++ {
+				c.cache[emails[index]] = slackIds[index]
+			}
 		}
 
-		slackIds[index] = user.ID
+		gosync.ReportProgress(ctx, end, len(slackIds))
 	}
 
-	_, err := c.client.InviteUsersToConversation(c.conversationName, slackIds...)
-	if err != nil {
-		return fmt.Errorf("slack.conversation.add.inviteuserstoconversation(%s, ...) -> %w", c.conversationName, err)
+	c.saveCache(ctx)
+	c.logSummary("Finished adding accounts successfully", len(emails))
+
+	return nil
+}
+
+// lookupUsers resolves the Slack IDs of emails, and returns the emails that were found alongside their IDs. Emails
+// without a Slack user are skipped and reported as warnings, rather than failing the whole add.
+func (c *Conversation) lookupUsers(ctx context.Context, emails []string) ([]string, []string, error) {
+	var (
+		found    = make([]string, 0, len(emails))
+		slackIds = make([]string, 0, len(emails))
+		skipped  []string
+	)
+
+	for _, email := range emails {
+		user, err := c.client.GetUserByEmailContext(ctx, email)
+		if err != nil && hasSlackError(err, "users_not_found") {
+			gosync.Warn(ctx, fmt.Errorf("slack.conversation.add(%s) -> %w", email, ErrUserNotFound))
+
+			skipped = append(skipped, email)
+
+			continue
+		}
+
+		if err != nil {
+			return nil, nil, fmt.Errorf(
+				"getuserbyemail(%s) -> %w",
+				email,
+				scoped("users.lookupByEmail", "users:read.email", err),
+			)
+		}
+
+		found = append(found, email)
+		slackIds = append(slackIds, user.ID)
+	}
+
+	if len(skipped) > 0 {
+		c.logger.Printf("Skipping %s, as they don't have Slack accounts", skipped)
+	}
+
+	return found, slackIds, nil
+}
+
+// withoutMembers returns the emails that aren't already in the cache, i.e. weren't in the conversation when Get was
+// called and haven't been added since.
+func (c *Conversation) withoutMembers(emails []string) []string {
+	if c.cache == nil {
+		return emails
+	}
+
+	out := make([]string, 0, len(emails))
+
+	for _, email := range emails {
+		if id, ok := c.cache[email]; ok {
+			c.logger.Printf("Skipping %s (%s), as they are already in the conversation", email, id)
+
+			continue
+		}
+
+		out = append(out, email)
+	}
+
+	return out
+}
+
+// invite invites users to the conversation. If Slack reports that some are already in the conversation, the whole
+// call is rejected, so the users are re-invited one at a time and only genuine failures are returned.
+func (c *Conversation) invite(ctx context.Context, ids []string) error {
+	err := c.retryRateLimited(ctx, func() error {
+		_, callErr := c.client.InviteUsersToConversationContext(ctx, c.conversationID, ids...)
+
+		return callErr //nolint:wrapcheck
+	})
+	if err == nil || !isAlreadyInChannel(err) {
+		return scoped("conversations.invite", manageScopes, err)
+	}
+
+	if len(ids) == 1 {
+		c.logger.Printf("Skipping %s, as they are already in the conversation", ids[0])
+
+		return nil
 	}
 
-	c.logger.Println("Finished adding accounts successfully")
+	c.logger.Println("Some users are already in the conversation, inviting one at a time")
+
+	for _, id := range ids {
+		err = c.retryRateLimited(ctx, func() error {
+			_, callErr := c.client.InviteUsersToConversationContext(ctx, c.conversationID, id)
+
+			return callErr //nolint:wrapcheck
+		})
+		if err == nil {
+			continue
+		}
+
+		if !isAlreadyInChannel(err) {
+			return fmt.Errorf("%s -> %w", id, scoped("conversations.invite", manageScopes, err))
+		}
+
+		c.logger.Printf("Skipping %s, as they are already in the conversation", id)
+	}
 
 	return nil
 }
 
+// isAlreadyInChannel returns true if a Slack API error is because a user is already in the conversation.
+func isAlreadyInChannel(err error) bool {
+	return hasSlackError(err, "already_in_channel")
+}
+
+// isAlreadyRemoved returns true if a kick failed because the user is deactivated or no longer in the conversation.
+func isAlreadyRemoved(err error) bool {
+	return hasSlackError(err, "not_in_channel") || hasSlackError(err, "user_not_found")
+}
+
+// hasSlackError returns true if a Slack API error has the given error code, e.g. users_not_found.
+func hasSlackError(err error, code string) bool {
+	var slackErr slack.SlackErrorResponse
+	if errors.As(err, &slackErr) {
+		return slackErr.Err == code
+	}
+
+	return strings.Contains(err.Error(), code)
+}
+
+// missingScopeError is a missing_scope error from Slack, with the call that failed and the scopes it needs. errors.Is
+// matches both ErrMissingScope and the Slack error, as only one error can be wrapped with %w in Go 1.18.
+type missingScopeError struct {
+	call   string
+	scopes string
+	err    error
+}
+
+// scoped wraps err in a missingScopeError if Slack responded with missing_scope, and otherwise returns it unchanged.
+func scoped(call, scopes string, err error) error {
+	if err == nil || !hasSlackError(err, "missing_scope") {
+		return err
+	}
+
+	return &missingScopeError{call: call, scopes: scopes, err: err}
+}
+
+func (e *missingScopeError) Error() string {
+	return fmt.Sprintf("%s -> %s: the slack app needs the %s scope", e.call, e.err, e.scopes)
+}
+
+// Unwrap returns the Slack error.
+func (e *missingScopeError) Unwrap() error {
+	return e.err
+}
+
+// Is reports whether target is ErrMissingScope.
+func (e *missingScopeError) Is(target error) bool {
+	return target == ErrMissingScope //nolint:errorlint,goerr113
+}
+
+// isTransient returns true if a Slack API error is likely to succeed if retried.
+func isTransient(err error) bool {
+	var (
+		rateLimitedErr *slack.RateLimitedError
+		statusCodeErr  slack.StatusCodeError
+	)
+
+	if errors.As(err, &rateLimitedErr) {
+		return true
+	}
+
+	return errors.As(err, &statusCodeErr) && statusCodeErr.Retryable()
+}
+
+// getCachedID gets the Slack ID of an email from the cache, falling back to looking it up unless in strict mode.
+func (c *Conversation) getCachedID(ctx context.Context, email string) (string, error) {
+	if id, ok := c.cache[email]; ok {
+		return id, nil
+	}
+
+	if c.StrictCache {
+		return "", fmt.Errorf("getcachedid(%s) -> %w", email, ErrCacheMiss)
+	}
+
+	user, err := c.client.GetUserByEmailContext(ctx, email)
+	if err != nil {
+		return "", fmt.Errorf("getuserbyemail(%s) -> %w", email, scoped("users.lookupByEmail", "users:read.email", err))
+	}
+
+	if c.isExcludedGuest(*user) {
+		if c.guests == nil {
+			c.guests = make(map[string]bool)
+		}
+
+		c.guests[user.ID] = true
+	}
+
+	if c.isAdmin(*user) {
+		if c.admins == nil {
+			c.admins = make(map[string]bool)
+		}
+
+		c.admins[user.ID] = true
+	}
+
+	return user.ID, nil
+}
+
+// isAdmin returns true if a Slack user is a workspace admin or owner, and ProtectAdmins is set.
+func (c *Conversation) isAdmin(user slack.User) bool {
+	return c.ProtectAdmins && (user.IsAdmin || user.IsOwner || user.IsPrimaryOwner)
+}
+
+// isExcludedGuest returns true if a Slack user is a guest that shouldn't be managed. Single-channel guests are also
+// marked IsRestricted by Slack, so IsUltraRestricted is checked first.
+func (c *Conversation) isExcludedGuest(user slack.User) bool {
+	if user.IsUltraRestricted {
+		return c.ExcludeSingleChannelGuests
+	}
+
+	return user.IsRestricted && c.ExcludeMultiChannelGuests
+}
+
 // Remove emails from a Slack conversation.
-func (c *Conversation) Remove(_ context.Context, emails []string) error {
+func (c *Conversation) Remove(ctx context.Context, emails []string) error {
 	c.logger.Printf("Removing %s from Slack conversation %s", emails, c.conversationName)
 
 	// If the cache hasn't been generated, regenerate it.
@@ -168,28 +1037,141 @@ func (c *Conversation) Remove(_ context.Context, emails []string) error {
 		return fmt.Errorf("slack.conversation.remove -> %w", gosync.ErrCacheEmpty)
 	}
 
+	if _, err := c.getConversationID(ctx); err != nil {
+		return fmt.Errorf("slack.conversation.remove.getconversationid -> %w", err)
+	}
+
+	// Resolve the app's own user before kicking anyone, even if Get hasn't been called, so it can never kick itself.
+	if c.ExcludeSelf {
+		if _, err := c.getSelfID(ctx); err != nil {
+			return fmt.Errorf("slack.conversation.remove.getselfid -> %w", err)
+		}
+	}
+
+	var (
+		queue     = make([]string, 0, len(emails))
+		emailOf   = make(map[string]string)
+		requeues  = make(map[string]int)
+		processed = 0
+		kick      = c.client.KickUserFromConversationContext
+		call      = "kickuserfromconversation"
+	)
+
+	if c.softRemove != nil {
+		kick, call = c.softRemove, "softremove"
+	}
+
 	for _, email := range emails {
-		err := c.client.KickUserFromConversation(c.conversationName, c.cache[email])
+		if c.protected[strings.ToLower(email)] {
+			c.logger.Printf("Skipping removal of %s, as they are protected", email)
+			gosync.Warn(ctx, fmt.Errorf("slack.conversation.remove(%s) -> %w", email, ErrProtectedUser))
+
+			continue
+		}
+
+		id, err := c.getCachedID(ctx, email)
+		if err != nil {
+			return fmt.Errorf("slack.conversation.remove -> %w", err)
+		}
+
+		queue = append(queue, id)
+		queue = append(queue, c.duplicates[email]...)
+
+		emailOf[id] = email
+	}
+
+	total := len(queue)
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		// Never kick the Slack app out of the conversation.
+		if c.ExcludeSelf && c.selfID != "" && id == c.selfID {
+			c.logger.Printf("Skipping removal of %s, as it is the Slack app's own user", id)
+			gosync.Warn(ctx, fmt.Errorf("slack.conversation.remove(%s) -> %w", id, ErrProtectedUser))
+
+			processed++
+			gosync.ReportProgress(ctx, processed, total)
+
+			continue
+		}
+
+		if c.protected[strings.ToLower(id)] || c.guests[id] || c.admins[id] {
+			c.logger.Printf("Skipping removal of %s, as they are protected", id)
+			gosync.Warn(ctx, fmt.Errorf("slack.conversation.remove(%s) -> %w", id, ErrProtectedUser))
+
+			processed++
+			gosync.ReportProgress(ctx, processed, total)
+
+			continue
+		}
+
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.Wait(ctx); err != nil {
+				return fmt.Errorf("slack.conversation.remove.wait -> %w", err)
+			}
+		}
+
+		err := c.retryRateLimited(ctx, func() error { return kick(ctx, c.conversationID, id) })
+
+		// Users that have been deactivated or have already left can't be kicked, but aren't members either way.
+		if err != nil && isAlreadyRemoved(err) {
+			c.logger.Printf("Skipping %s, as they're deactivated or no longer in the conversation", id)
+
+			err = nil
+		}
+
 		if err != nil {
 			if c.MuteRestrictedErrOnKickFromPublic && strings.Contains(err.Error(), "restricted_action") {
 				c.logger.Println("Cannot kick from public channel, but error is muted by configuration - continuing")
+				gosync.Warn(ctx, fmt.Errorf(
+					"slack.conversation.remove.%s(%s, %s) -> %w",
+					call,
+					c.conversationName,
+					id,
+					err,
+				))
 
 				return nil
 			}
 
+			if isTransient(err) && requeues[id] < c.maxRequeues {
+				c.logger.Printf("Transient error removing %s, retrying at the end of the batch: %s", id, err)
+
+				requeues[id]++
+				queue = append(queue, id)
+
+				continue
+			}
+
 			return fmt.Errorf(
-				"slack.conversation.remove.kickuserfromconversation(%s, %s) -> %w",
+				"slack.conversation.remove.%s(%s, %s) -> %w",
+				call,
 				c.conversationName,
-				c.cache[email],
-				err,
+				id,
+				scoped("conversations.kick", manageScopes, err),
 			)
 		}
 
-		// To prevent rate limiting, sleep for 1 second after each kick.
-		time.Sleep(1 * time.Second)
+		// Duplicate accounts aren't keyed by their own ID, so leave their email alone when they're removed.
+		if email, ok := emailOf[id]; ok {
+			delete(c.cache, email)
+		}
+
+		processed++
+		gosync.ReportProgress(ctx, processed, total)
+
+		// To prevent rate limiting, sleep after each kick, unless a rate limiter is handling it.
+		if c.rateLimiter == nil && c.removeDelay > 0 {
+			if err := c.sleep(ctx, c.removeDelay); err != nil {
+				return fmt.Errorf("slack.conversation.remove.sleep -> %w", err)
+			}
+		}
 	}
 
-	c.logger.Println("Finished removing accounts successfully")
+	c.saveCache(ctx)
+	c.logSummary("Finished removing accounts successfully", processed)
 
 	return nil
 }
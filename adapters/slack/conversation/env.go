@@ -0,0 +1,77 @@
+package conversation
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	gosync "github.com/ovotech/go-sync"
+	"github.com/slack-go/slack"
+)
+
+// Environment variables used to configure the adapter with NewFromEnv.
+const (
+	EnvToken                             = "SLACK_TOKEN"                                   // Required.
+	EnvConversation                      = "SLACK_CONVERSATION"                            // Required.
+	EnvMuteRestrictedErrOnKickFromPublic = "SLACK_MUTE_RESTRICTED_ERR_ON_KICK_FROM_PUBLIC" // Optional boolean.
+	EnvExcludeSelf                       = "SLACK_EXCLUDE_SELF"                            // Optional boolean.
+	EnvExcludeSingleChannelGuests        = "SLACK_EXCLUDE_SINGLE_CHANNEL_GUESTS"           // Optional boolean.
+	EnvExcludeMultiChannelGuests         = "SLACK_EXCLUDE_MULTI_CHANNEL_GUESTS"            // Optional boolean.
+	EnvCacheFile                         = "SLACK_CACHE_FILE"                              // Optional, see WithCacheFile.
+)
+
+// NewFromEnv instantiates a new Slack conversation adapter, configured using environment variables.
+// New remains the primary way to build the adapter; this is a convenience for containerised deployments.
+func NewFromEnv(optsFn ...func(conversation *Conversation)) (*Conversation, error) {
+	var missing []string
+
+	token, conversationName := os.Getenv(EnvToken), os.Getenv(EnvConversation)
+
+	if token == "" {
+		missing = append(missing, EnvToken)
+	}
+
+	if conversationName == "" {
+		missing = append(missing, EnvConversation)
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("slack.conversation.newfromenv -> %w: %s", gosync.ErrMissingEnv, strings.Join(missing, ", "))
+	}
+
+	conversation := New(slack.New(token), conversationName)
+
+	// Parsed in a fixed order, so the same invalid variable is always reported first.
+	for _, option := range []struct {
+		env   string
+		field *bool
+	}{
+		{EnvMuteRestrictedErrOnKickFromPublic, &conversation.MuteRestrictedErrOnKickFromPublic},
+		{EnvExcludeSelf, &conversation.ExcludeSelf},
+		{EnvExcludeSingleChannelGuests, &conversation.ExcludeSingleChannelGuests},
+		{EnvExcludeMultiChannelGuests, &conversation.ExcludeMultiChannelGuests},
+	} {
+		if value, ok := os.LookupEnv(option.env); ok {
+			parsed, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("slack.conversation.newfromenv(%s) -> %w", option.env, err)
+			}
+
+			*option.field = parsed
+		}
+	}
+
+	if cacheFile := os.Getenv(EnvCacheFile); cacheFile != "" {
+		WithCacheFile(cacheFile)(conversation)
+	}
+
+	for _, fn := range optsFn {
+		fn(conversation)
+	}
+
+	// The options are applied after New, so load the cache file now that it's known.
+	conversation.loadCache()
+
+	return conversation, nil
+}
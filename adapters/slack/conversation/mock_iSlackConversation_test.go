@@ -3,6 +3,8 @@
 package conversation
 
 import (
+	context "context"
+
 	slack "github.com/slack-go/slack"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -20,13 +22,209 @@ func (_m *mockISlackConversation) EXPECT() *mockISlackConversation_Expecter {
 	return &mockISlackConversation_Expecter{mock: &_m.Mock}
 }
 
-// GetUserByEmail provides a mock function with given fields: email
-func (_m *mockISlackConversation) GetUserByEmail(email string) (*slack.User, error) {
-	ret := _m.Called(email)
+// AuthTestContext provides a mock function with given fields: ctx
+func (_m *mockISlackConversation) AuthTestContext(ctx context.Context) (*slack.AuthTestResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *slack.AuthTestResponse
+	if rf, ok := ret.Get(0).(func(context.Context) *slack.AuthTestResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*slack.AuthTestResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockISlackConversation_AuthTestContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuthTestContext'
+type mockISlackConversation_AuthTestContext_Call struct {
+	*mock.Call
+}
+
+// AuthTestContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *mockISlackConversation_Expecter) AuthTestContext(ctx interface{}) *mockISlackConversation_AuthTestContext_Call {
+	return &mockISlackConversation_AuthTestContext_Call{Call: _e.mock.On("AuthTestContext", ctx)}
+}
+
+func (_c *mockISlackConversation_AuthTestContext_Call) Run(run func(ctx context.Context)) *mockISlackConversation_AuthTestContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *mockISlackConversation_AuthTestContext_Call) Return(_a0 *slack.AuthTestResponse, _a1 error) *mockISlackConversation_AuthTestContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// CreateConversationContext provides a mock function with given fields: ctx, channelName, isPrivate
+func (_m *mockISlackConversation) CreateConversationContext(ctx context.Context, channelName string, isPrivate bool) (*slack.Channel, error) {
+	ret := _m.Called(ctx, channelName, isPrivate)
+
+	var r0 *slack.Channel
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) *slack.Channel); ok {
+		r0 = rf(ctx, channelName, isPrivate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*slack.Channel)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, channelName, isPrivate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockISlackConversation_CreateConversationContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateConversationContext'
+type mockISlackConversation_CreateConversationContext_Call struct {
+	*mock.Call
+}
+
+// CreateConversationContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channelName string
+//   - isPrivate bool
+func (_e *mockISlackConversation_Expecter) CreateConversationContext(ctx interface{}, channelName interface{}, isPrivate interface{}) *mockISlackConversation_CreateConversationContext_Call {
+	return &mockISlackConversation_CreateConversationContext_Call{Call: _e.mock.On("CreateConversationContext", ctx, channelName, isPrivate)}
+}
+
+func (_c *mockISlackConversation_CreateConversationContext_Call) Run(run func(ctx context.Context, channelName string, isPrivate bool)) *mockISlackConversation_CreateConversationContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *mockISlackConversation_CreateConversationContext_Call) Return(_a0 *slack.Channel, _a1 error) *mockISlackConversation_CreateConversationContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetConversationInfoContext provides a mock function with given fields: ctx, channelID, includeLocale
+func (_m *mockISlackConversation) GetConversationInfoContext(ctx context.Context, channelID string, includeLocale bool) (*slack.Channel, error) {
+	ret := _m.Called(ctx, channelID, includeLocale)
+
+	var r0 *slack.Channel
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) *slack.Channel); ok {
+		r0 = rf(ctx, channelID, includeLocale)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*slack.Channel)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, channelID, includeLocale)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockISlackConversation_GetConversationInfoContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConversationInfoContext'
+type mockISlackConversation_GetConversationInfoContext_Call struct {
+	*mock.Call
+}
+
+// GetConversationInfoContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channelID string
+//   - includeLocale bool
+func (_e *mockISlackConversation_Expecter) GetConversationInfoContext(ctx interface{}, channelID interface{}, includeLocale interface{}) *mockISlackConversation_GetConversationInfoContext_Call {
+	return &mockISlackConversation_GetConversationInfoContext_Call{Call: _e.mock.On("GetConversationInfoContext", ctx, channelID, includeLocale)}
+}
+
+func (_c *mockISlackConversation_GetConversationInfoContext_Call) Run(run func(ctx context.Context, channelID string, includeLocale bool)) *mockISlackConversation_GetConversationInfoContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *mockISlackConversation_GetConversationInfoContext_Call) Return(_a0 *slack.Channel, _a1 error) *mockISlackConversation_GetConversationInfoContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetConversationsContext provides a mock function with given fields: ctx, params
+func (_m *mockISlackConversation) GetConversationsContext(ctx context.Context, params *slack.GetConversationsParameters) ([]slack.Channel, string, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []slack.Channel
+	if rf, ok := ret.Get(0).(func(context.Context, *slack.GetConversationsParameters) []slack.Channel); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]slack.Channel)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(context.Context, *slack.GetConversationsParameters) string); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *slack.GetConversationsParameters) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// mockISlackConversation_GetConversationsContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConversationsContext'
+type mockISlackConversation_GetConversationsContext_Call struct {
+	*mock.Call
+}
+
+// GetConversationsContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *slack.GetConversationsParameters
+func (_e *mockISlackConversation_Expecter) GetConversationsContext(ctx interface{}, params interface{}) *mockISlackConversation_GetConversationsContext_Call {
+	return &mockISlackConversation_GetConversationsContext_Call{Call: _e.mock.On("GetConversationsContext", ctx, params)}
+}
+
+func (_c *mockISlackConversation_GetConversationsContext_Call) Run(run func(ctx context.Context, params *slack.GetConversationsParameters)) *mockISlackConversation_GetConversationsContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*slack.GetConversationsParameters))
+	})
+	return _c
+}
+
+func (_c *mockISlackConversation_GetConversationsContext_Call) Return(_a0 []slack.Channel, _a1 string, _a2 error) *mockISlackConversation_GetConversationsContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+// GetUserByEmailContext provides a mock function with given fields: ctx, email
+func (_m *mockISlackConversation) GetUserByEmailContext(ctx context.Context, email string) (*slack.User, error) {
+	ret := _m.Called(ctx, email)
 
 	var r0 *slack.User
-	if rf, ok := ret.Get(0).(func(string) *slack.User); ok {
-		r0 = rf(email)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *slack.User); ok {
+		r0 = rf(ctx, email)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*slack.User)
@@ -34,8 +232,8 @@ func (_m *mockISlackConversation) GetUserByEmail(email string) (*slack.User, err
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(email)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -43,36 +241,37 @@ func (_m *mockISlackConversation) GetUserByEmail(email string) (*slack.User, err
 	return r0, r1
 }
 
-// mockISlackConversation_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
-type mockISlackConversation_GetUserByEmail_Call struct {
+// mockISlackConversation_GetUserByEmailContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmailContext'
+type mockISlackConversation_GetUserByEmailContext_Call struct {
 	*mock.Call
 }
 
-// GetUserByEmail is a helper method to define mock.On call
+// GetUserByEmailContext is a helper method to define mock.On call
+//   - ctx context.Context
 //   - email string
-func (_e *mockISlackConversation_Expecter) GetUserByEmail(email interface{}) *mockISlackConversation_GetUserByEmail_Call {
-	return &mockISlackConversation_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", email)}
+func (_e *mockISlackConversation_Expecter) GetUserByEmailContext(ctx interface{}, email interface{}) *mockISlackConversation_GetUserByEmailContext_Call {
+	return &mockISlackConversation_GetUserByEmailContext_Call{Call: _e.mock.On("GetUserByEmailContext", ctx, email)}
 }
 
-func (_c *mockISlackConversation_GetUserByEmail_Call) Run(run func(email string)) *mockISlackConversation_GetUserByEmail_Call {
+func (_c *mockISlackConversation_GetUserByEmailContext_Call) Run(run func(ctx context.Context, email string)) *mockISlackConversation_GetUserByEmailContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *mockISlackConversation_GetUserByEmail_Call) Return(_a0 *slack.User, _a1 error) *mockISlackConversation_GetUserByEmail_Call {
+func (_c *mockISlackConversation_GetUserByEmailContext_Call) Return(_a0 *slack.User, _a1 error) *mockISlackConversation_GetUserByEmailContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// GetUsersInConversation provides a mock function with given fields: params
-func (_m *mockISlackConversation) GetUsersInConversation(params *slack.GetUsersInConversationParameters) ([]string, string, error) {
-	ret := _m.Called(params)
+// GetUsersInConversationContext provides a mock function with given fields: ctx, params
+func (_m *mockISlackConversation) GetUsersInConversationContext(ctx context.Context, params *slack.GetUsersInConversationParameters) ([]string, string, error) {
+	ret := _m.Called(ctx, params)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func(*slack.GetUsersInConversationParameters) []string); ok {
-		r0 = rf(params)
+	if rf, ok := ret.Get(0).(func(context.Context, *slack.GetUsersInConversationParameters) []string); ok {
+		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -80,15 +279,15 @@ func (_m *mockISlackConversation) GetUsersInConversation(params *slack.GetUsersI
 	}
 
 	var r1 string
-	if rf, ok := ret.Get(1).(func(*slack.GetUsersInConversationParameters) string); ok {
-		r1 = rf(params)
+	if rf, ok := ret.Get(1).(func(context.Context, *slack.GetUsersInConversationParameters) string); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Get(1).(string)
 	}
 
 	var r2 error
-	if rf, ok := ret.Get(2).(func(*slack.GetUsersInConversationParameters) error); ok {
-		r2 = rf(params)
+	if rf, ok := ret.Get(2).(func(context.Context, *slack.GetUsersInConversationParameters) error); ok {
+		r2 = rf(ctx, params)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -96,42 +295,44 @@ func (_m *mockISlackConversation) GetUsersInConversation(params *slack.GetUsersI
 	return r0, r1, r2
 }
 
-// mockISlackConversation_GetUsersInConversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersInConversation'
-type mockISlackConversation_GetUsersInConversation_Call struct {
+// mockISlackConversation_GetUsersInConversationContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersInConversationContext'
+type mockISlackConversation_GetUsersInConversationContext_Call struct {
 	*mock.Call
 }
 
-// GetUsersInConversation is a helper method to define mock.On call
+// GetUsersInConversationContext is a helper method to define mock.On call
+//   - ctx context.Context
 //   - params *slack.GetUsersInConversationParameters
-func (_e *mockISlackConversation_Expecter) GetUsersInConversation(params interface{}) *mockISlackConversation_GetUsersInConversation_Call {
-	return &mockISlackConversation_GetUsersInConversation_Call{Call: _e.mock.On("GetUsersInConversation", params)}
+func (_e *mockISlackConversation_Expecter) GetUsersInConversationContext(ctx interface{}, params interface{}) *mockISlackConversation_GetUsersInConversationContext_Call {
+	return &mockISlackConversation_GetUsersInConversationContext_Call{Call: _e.mock.On("GetUsersInConversationContext", ctx, params)}
 }
 
-func (_c *mockISlackConversation_GetUsersInConversation_Call) Run(run func(params *slack.GetUsersInConversationParameters)) *mockISlackConversation_GetUsersInConversation_Call {
+func (_c *mockISlackConversation_GetUsersInConversationContext_Call) Run(run func(ctx context.Context, params *slack.GetUsersInConversationParameters)) *mockISlackConversation_GetUsersInConversationContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*slack.GetUsersInConversationParameters))
+		run(args[0].(context.Context), args[1].(*slack.GetUsersInConversationParameters))
 	})
 	return _c
 }
 
-func (_c *mockISlackConversation_GetUsersInConversation_Call) Return(_a0 []string, _a1 string, _a2 error) *mockISlackConversation_GetUsersInConversation_Call {
+func (_c *mockISlackConversation_GetUsersInConversationContext_Call) Return(_a0 []string, _a1 string, _a2 error) *mockISlackConversation_GetUsersInConversationContext_Call {
 	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-// GetUsersInfo provides a mock function with given fields: users
-func (_m *mockISlackConversation) GetUsersInfo(users ...string) (*[]slack.User, error) {
+// GetUsersInfoContext provides a mock function with given fields: ctx, users
+func (_m *mockISlackConversation) GetUsersInfoContext(ctx context.Context, users ...string) (*[]slack.User, error) {
 	_va := make([]interface{}, len(users))
 	for _i := range users {
 		_va[_i] = users[_i]
 	}
 	var _ca []interface{}
+	_ca = append(_ca, ctx)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 *[]slack.User
-	if rf, ok := ret.Get(0).(func(...string) *[]slack.User); ok {
-		r0 = rf(users...)
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) *[]slack.User); ok {
+		r0 = rf(ctx, users...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]slack.User)
@@ -139,8 +340,8 @@ func (_m *mockISlackConversation) GetUsersInfo(users ...string) (*[]slack.User,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(...string) error); ok {
-		r1 = rf(users...)
+	if rf, ok := ret.Get(1).(func(context.Context, ...string) error); ok {
+		r1 = rf(ctx, users...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -148,50 +349,51 @@ func (_m *mockISlackConversation) GetUsersInfo(users ...string) (*[]slack.User,
 	return r0, r1
 }
 
-// mockISlackConversation_GetUsersInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersInfo'
-type mockISlackConversation_GetUsersInfo_Call struct {
+// mockISlackConversation_GetUsersInfoContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersInfoContext'
+type mockISlackConversation_GetUsersInfoContext_Call struct {
 	*mock.Call
 }
 
-// GetUsersInfo is a helper method to define mock.On call
+// GetUsersInfoContext is a helper method to define mock.On call
+//   - ctx context.Context
 //   - users ...string
-func (_e *mockISlackConversation_Expecter) GetUsersInfo(users ...interface{}) *mockISlackConversation_GetUsersInfo_Call {
-	return &mockISlackConversation_GetUsersInfo_Call{Call: _e.mock.On("GetUsersInfo",
-		append([]interface{}{}, users...)...)}
+func (_e *mockISlackConversation_Expecter) GetUsersInfoContext(ctx interface{}, users ...interface{}) *mockISlackConversation_GetUsersInfoContext_Call {
+	return &mockISlackConversation_GetUsersInfoContext_Call{Call: _e.mock.On("GetUsersInfoContext",
+		append([]interface{}{ctx}, users...)...)}
 }
 
-func (_c *mockISlackConversation_GetUsersInfo_Call) Run(run func(users ...string)) *mockISlackConversation_GetUsersInfo_Call {
+func (_c *mockISlackConversation_GetUsersInfoContext_Call) Run(run func(ctx context.Context, users ...string)) *mockISlackConversation_GetUsersInfoContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]string, len(args)-0)
-		for i, a := range args[0:] {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
 			if a != nil {
 				variadicArgs[i] = a.(string)
 			}
 		}
-		run(variadicArgs...)
+		run(args[0].(context.Context), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *mockISlackConversation_GetUsersInfo_Call) Return(_a0 *[]slack.User, _a1 error) *mockISlackConversation_GetUsersInfo_Call {
+func (_c *mockISlackConversation_GetUsersInfoContext_Call) Return(_a0 *[]slack.User, _a1 error) *mockISlackConversation_GetUsersInfoContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// InviteUsersToConversation provides a mock function with given fields: channelID, users
-func (_m *mockISlackConversation) InviteUsersToConversation(channelID string, users ...string) (*slack.Channel, error) {
+// InviteUsersToConversationContext provides a mock function with given fields: ctx, channelID, users
+func (_m *mockISlackConversation) InviteUsersToConversationContext(ctx context.Context, channelID string, users ...string) (*slack.Channel, error) {
 	_va := make([]interface{}, len(users))
 	for _i := range users {
 		_va[_i] = users[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, channelID)
+	_ca = append(_ca, ctx, channelID)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 *slack.Channel
-	if rf, ok := ret.Get(0).(func(string, ...string) *slack.Channel); ok {
-		r0 = rf(channelID, users...)
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...string) *slack.Channel); ok {
+		r0 = rf(ctx, channelID, users...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*slack.Channel)
@@ -199,8 +401,8 @@ func (_m *mockISlackConversation) InviteUsersToConversation(channelID string, us
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, ...string) error); ok {
-		r1 = rf(channelID, users...)
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...string) error); ok {
+		r1 = rf(ctx, channelID, users...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -208,44 +410,45 @@ func (_m *mockISlackConversation) InviteUsersToConversation(channelID string, us
 	return r0, r1
 }
 
-// mockISlackConversation_InviteUsersToConversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InviteUsersToConversation'
-type mockISlackConversation_InviteUsersToConversation_Call struct {
+// mockISlackConversation_InviteUsersToConversationContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InviteUsersToConversationContext'
+type mockISlackConversation_InviteUsersToConversationContext_Call struct {
 	*mock.Call
 }
 
-// InviteUsersToConversation is a helper method to define mock.On call
+// InviteUsersToConversationContext is a helper method to define mock.On call
+//   - ctx context.Context
 //   - channelID string
 //   - users ...string
-func (_e *mockISlackConversation_Expecter) InviteUsersToConversation(channelID interface{}, users ...interface{}) *mockISlackConversation_InviteUsersToConversation_Call {
-	return &mockISlackConversation_InviteUsersToConversation_Call{Call: _e.mock.On("InviteUsersToConversation",
-		append([]interface{}{channelID}, users...)...)}
+func (_e *mockISlackConversation_Expecter) InviteUsersToConversationContext(ctx interface{}, channelID interface{}, users ...interface{}) *mockISlackConversation_InviteUsersToConversationContext_Call {
+	return &mockISlackConversation_InviteUsersToConversationContext_Call{Call: _e.mock.On("InviteUsersToConversationContext",
+		append([]interface{}{ctx, channelID}, users...)...)}
 }
 
-func (_c *mockISlackConversation_InviteUsersToConversation_Call) Run(run func(channelID string, users ...string)) *mockISlackConversation_InviteUsersToConversation_Call {
+func (_c *mockISlackConversation_InviteUsersToConversationContext_Call) Run(run func(ctx context.Context, channelID string, users ...string)) *mockISlackConversation_InviteUsersToConversationContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]string, len(args)-1)
-		for i, a := range args[1:] {
+		variadicArgs := make([]string, len(args)-2)
+		for i, a := range args[2:] {
 			if a != nil {
 				variadicArgs[i] = a.(string)
 			}
 		}
-		run(args[0].(string), variadicArgs...)
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *mockISlackConversation_InviteUsersToConversation_Call) Return(_a0 *slack.Channel, _a1 error) *mockISlackConversation_InviteUsersToConversation_Call {
+func (_c *mockISlackConversation_InviteUsersToConversationContext_Call) Return(_a0 *slack.Channel, _a1 error) *mockISlackConversation_InviteUsersToConversationContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// KickUserFromConversation provides a mock function with given fields: channelID, user
-func (_m *mockISlackConversation) KickUserFromConversation(channelID string, user string) error {
-	ret := _m.Called(channelID, user)
+// KickUserFromConversationContext provides a mock function with given fields: ctx, channelID, user
+func (_m *mockISlackConversation) KickUserFromConversationContext(ctx context.Context, channelID string, user string) error {
+	ret := _m.Called(ctx, channelID, user)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(channelID, user)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, channelID, user)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -253,26 +456,27 @@ func (_m *mockISlackConversation) KickUserFromConversation(channelID string, use
 	return r0
 }
 
-// mockISlackConversation_KickUserFromConversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KickUserFromConversation'
-type mockISlackConversation_KickUserFromConversation_Call struct {
+// mockISlackConversation_KickUserFromConversationContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KickUserFromConversationContext'
+type mockISlackConversation_KickUserFromConversationContext_Call struct {
 	*mock.Call
 }
 
-// KickUserFromConversation is a helper method to define mock.On call
+// KickUserFromConversationContext is a helper method to define mock.On call
+//   - ctx context.Context
 //   - channelID string
 //   - user string
-func (_e *mockISlackConversation_Expecter) KickUserFromConversation(channelID interface{}, user interface{}) *mockISlackConversation_KickUserFromConversation_Call {
-	return &mockISlackConversation_KickUserFromConversation_Call{Call: _e.mock.On("KickUserFromConversation", channelID, user)}
+func (_e *mockISlackConversation_Expecter) KickUserFromConversationContext(ctx interface{}, channelID interface{}, user interface{}) *mockISlackConversation_KickUserFromConversationContext_Call {
+	return &mockISlackConversation_KickUserFromConversationContext_Call{Call: _e.mock.On("KickUserFromConversationContext", ctx, channelID, user)}
 }
 
-func (_c *mockISlackConversation_KickUserFromConversation_Call) Run(run func(channelID string, user string)) *mockISlackConversation_KickUserFromConversation_Call {
+func (_c *mockISlackConversation_KickUserFromConversationContext_Call) Run(run func(ctx context.Context, channelID string, user string)) *mockISlackConversation_KickUserFromConversationContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *mockISlackConversation_KickUserFromConversation_Call) Return(_a0 error) *mockISlackConversation_KickUserFromConversation_Call {
+func (_c *mockISlackConversation_KickUserFromConversationContext_Call) Return(_a0 error) *mockISlackConversation_KickUserFromConversationContext_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
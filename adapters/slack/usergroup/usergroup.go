@@ -105,6 +105,7 @@ func (u *UserGroup) Add(ctx context.Context, emails []string) error {
 
 	// The updatedUserGroup is existing users + new users.
 	updatedUserGroup := make([]string, 0, len(u.cache)+len(emails))
+	added := make(map[string]string, len(emails))
 
 	// Prefill the updatedUserGroup with everyone currently in the group.
 	for _, id := range u.cache {
@@ -119,6 +120,7 @@ func (u *UserGroup) Add(ctx context.Context, emails []string) error {
 		}
 		// Add the new email user IDs to the list.
 		updatedUserGroup = append(updatedUserGroup, user.ID)
+		added[email] = user.ID
 
 		// Calls to GetUserByEmail are heavily rate limited, so sleep to avoid this.
 		time.Sleep(2 * time.Second) //nolint:gomnd
@@ -132,6 +134,11 @@ func (u *UserGroup) Add(ctx context.Context, emails []string) error {
 		return fmt.Errorf("slack.usergroup.add.updateusergroupmembers(%s) -> %w", u.userGroupName, err)
 	}
 
+	// Slack replaces the whole member list, so keep the cache up to date for any subsequent Add or Remove.
+	for email, id := range added {
+		u.cache[email] = id
+	}
+
 	u.logger.Println("Finished adding accounts successfully")
 
 	return nil
@@ -168,6 +175,7 @@ func (u *UserGroup) Remove(ctx context.Context, emails []string) error {
 	if err != nil {
 		if strings.Contains(err.Error(), "invalid_arguments") && u.MuteGroupCannotBeEmpty {
 			u.logger.Println("Cannot remove all members from usergroup, but error is muted by configuration - continuing")
+			gosync.Warn(ctx, fmt.Errorf("slack.usergroup.remove.updateusergroupmembers(%s, ...) -> %w", u.userGroupName, err))
 
 			return nil
 		}
@@ -175,6 +183,11 @@ func (u *UserGroup) Remove(ctx context.Context, emails []string) error {
 		return fmt.Errorf("slack.usergroup.remove.updateusergroupmembers(%s, ...) -> %w", u.userGroupName, err)
 	}
 
+	// Slack replaces the whole member list, so keep the cache up to date for any subsequent Add or Remove.
+	for _, email := range emails {
+		delete(u.cache, email)
+	}
+
 	u.logger.Println("Finished removing accounts successfully")
 
 	return nil
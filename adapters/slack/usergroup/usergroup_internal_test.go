@@ -87,6 +87,12 @@ func TestUserGroup_Add(t *testing.T) {
 		err := adapter.Add(ctx, []string{"fizz@email", "buzz@email"})
 
 		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"foo@email":  "foo",
+			"bar@email":  "bar",
+			"fizz@email": "fizz",
+			"buzz@email": "buzz",
+		}, adapter.cache)
 	})
 }
 
@@ -120,6 +126,14 @@ func TestUserGroup_Remove(t *testing.T) {
 
 		err := adapter.Remove(ctx, []string{"bar@email"})
 
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"foo@email": "foo"}, adapter.cache)
+
+		// A subsequent change is based on the updated members.
+		slackClient.EXPECT().UpdateUserGroupMembersContext(ctx, "test", "").Return(slack.UserGroup{}, nil)
+
+		err = adapter.Remove(ctx, []string{"foo@email"})
+
 		assert.NoError(t, err)
 	})
 
@@ -141,11 +155,16 @@ func TestUserGroup_Remove(t *testing.T) {
 
 		assert.ErrorIs(t, err, errInvalidArguments)
 
-		// Reset the cache and mute the empty group error.
+		// Mute the empty group error, which should be reported as a warning instead.
 		adapter.MuteGroupCannotBeEmpty = true
+		warnCtx := gosync.ContextWithWarnings(ctx)
 
-		err = adapter.Remove(ctx, []string{"foo@email"})
+		slackClient.EXPECT().UpdateUserGroupMembersContext(warnCtx, "test", "").Return(slack.UserGroup{}, errInvalidArguments)
+
+		err = adapter.Remove(warnCtx, []string{"foo@email"})
 
 		assert.NoError(t, err)
+		assert.Len(t, gosync.Warnings(warnCtx), 1)
+		assert.ErrorIs(t, gosync.Warnings(warnCtx)[0], errInvalidArguments)
 	})
 }
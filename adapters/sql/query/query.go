@@ -0,0 +1,188 @@
+/*
+Package query synchronises email addresses with a SQL database, e.g. Snowflake role grants or an in-house access
+table, using statements given to the adapter.
+
+Get runs the list query, and scans an email from the first column of each row. Add and Remove run their statements
+once per email, with the email as the only argument, in a transaction.
+*/
+package query
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+
+	gosync "github.com/ovotech/go-sync"
+)
+
+// Ensure the adapter type fully satisfies the ports.Adapter, ports.ReadOnlyAdapter and ports.ConfiguredAdapter
+// interfaces.
+var (
+	_ gosync.Adapter           = &Query{}
+	_ gosync.ReadOnlyAdapter   = &Query{}
+	_ gosync.ConfiguredAdapter = &Query{}
+)
+
+type Query struct {
+	db     *sql.DB
+	list   string // list is the query that selects the emails.
+	add    string // add is the statement run for each email by Add.
+	remove string // remove is the statement run for each email by Remove.
+	logger *log.Logger
+}
+
+// WithAdd sets the statement run for each email by Add, e.g. INSERT INTO members (email) VALUES (?). The email is
+// the only argument, so use the placeholder your driver expects, e.g. $1 for Postgres. Without it, Add fails with
+// gosync.ErrReadOnly.
+func WithAdd(statement string) func(*Query) {
+	return func(query *Query) {
+		query.add = statement
+	}
+}
+
+// WithRemove sets the statement run for each email by Remove, e.g. DELETE FROM members WHERE email = ?. Without it,
+// Remove fails with gosync.ErrReadOnly.
+func WithRemove(statement string) func(*Query) {
+	return func(query *Query) {
+		query.remove = statement
+	}
+}
+
+// WithLogger sets a custom logger.
+func WithLogger(logger *log.Logger) func(*Query) {
+	return func(query *Query) {
+		query.logger = logger
+	}
+}
+
+// New instantiates a new SQL adapter, which gets emails from db with the list query.
+func New(db *sql.DB, list string, optsFn ...func(*Query)) *Query {
+	query := &Query{
+		db:     db,
+		list:   list,
+		add:    "",
+		remove: "",
+		logger: log.New(os.Stderr, "[go-sync/sql/query] ", log.LstdFlags|log.Lshortfile|log.Lmsgprefix),
+	}
+
+	for _, fn := range optsFn {
+		fn(query)
+	}
+
+	return query
+}
+
+// ReadOnly returns true if neither an add nor a remove statement has been set.
+func (q *Query) ReadOnly() bool {
+	return q.add == "" && q.remove == ""
+}
+
+// Config returns the adapter's configuration. Statements are included, as the database's credentials aren't known
+// to the adapter.
+func (q *Query) Config() map[string]string {
+	return map[string]string{
+		"list":   q.list,
+		"add":    q.add,
+		"remove": q.remove,
+	}
+}
+
+// Get emails by running the list query. Rows with a NULL email are skipped.
+func (q *Query) Get(ctx context.Context) ([]string, error) {
+	q.logger.Printf("Fetching accounts with %s", q.list)
+
+	rows, err := q.db.QueryContext(ctx, q.list)
+	if err != nil {
+		return nil, fmt.Errorf("sql.query.get.query(%s) -> %w", q.list, err)
+	}
+	defer rows.Close()
+
+	emails := make([]string, 0)
+
+	for rows.Next() {
+		var email sql.NullString
+
+		if err := rows.Scan(&email); err != nil {
+			return nil, fmt.Errorf("sql.query.get.scan(%s) -> %w", q.list, err)
+		}
+
+		if email.Valid {
+			emails = append(emails, email.String)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sql.query.get.rows(%s) -> %w", q.list, err)
+	}
+
+	q.logger.Println("Fetched accounts successfully")
+
+	return emails, nil
+}
+
+// execEach runs a statement once for each email in a transaction, which is rolled back if any of them fail.
+func (q *Query) execEach(ctx context.Context, statement string, emails []string) error {
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin -> %w", err)
+	}
+
+	// Rolling back a committed transaction is a no-op.
+	defer tx.Rollback() //nolint:errcheck
+
+	stmt, err := tx.PrepareContext(ctx, statement)
+	if err != nil {
+		return fmt.Errorf("prepare(%s) -> %w", statement, err)
+	}
+	defer stmt.Close()
+
+	for index, email := range emails {
+		if _, err := stmt.ExecContext(ctx, email); err != nil {
+			return fmt.Errorf("exec(%s, %s) -> %w", statement, email, err)
+		}
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit -> %w", err)
+	}
+
+	return nil
+}
+
+// Add emails by running the add statement for each of them in a transaction.
+func (q *Query) Add(ctx context.Context, emails []string) error {
+	if q.add == "" {
+		return fmt.Errorf("sql.query.add -> %w", gosync.ErrReadOnly)
+	}
+
+	q.logger.Printf("Adding %s with %s", emails, q.add)
+
+	if err := q.execEach(ctx, q.add, emails); err != nil {
+		return fmt.Errorf("sql.query.add -> %w", err)
+	}
+
+	q.logger.Println("Finished adding accounts successfully")
+
+	return nil
+}
+
+// Remove emails by running the remove statement for each of them in a transaction.
+func (q *Query) Remove(ctx context.Context, emails []string) error {
+	if q.remove == "" {
+		return fmt.Errorf("sql.query.remove -> %w", gosync.ErrReadOnly)
+	}
+
+	q.logger.Printf("Removing %s with %s", emails, q.remove)
+
+	if err := q.execEach(ctx, q.remove, emails); err != nil {
+		return fmt.Errorf("sql.query.remove -> %w", err)
+	}
+
+	q.logger.Println("Finished removing accounts successfully")
+
+	return nil
+}
@@ -0,0 +1,260 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	gosync "github.com/ovotech/go-sync"
+	"github.com/stretchr/testify/assert"
+)
+
+var errDatabase = errors.New("an example error")
+
+// fakeDB is an in-memory table of emails behind a minimal database/sql driver. INSERT and DELETE statements add and
+// remove their argument, any other statement selects every email, and anything involving fail@email fails. Changes
+// made in a transaction are only applied when it's committed.
+type fakeDB struct {
+	mu     sync.Mutex
+	emails []interface{} // emails may contain nil, which is returned as NULL.
+}
+
+func (f *fakeDB) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{db: f, pending: nil}, nil
+}
+
+func (f *fakeDB) Driver() driver.Driver { return nil }
+
+func (f *fakeDB) Emails() []interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]interface{}(nil), f.emails...)
+}
+
+type fakeConn struct {
+	db      *fakeDB
+	pending []func()
+}
+
+func (c *fakeConn) Prepare(statement string) (driver.Stmt, error) {
+	if strings.Contains(statement, "fail@email") {
+		return nil, errDatabase
+	}
+
+	return &fakeStmt{conn: c, statement: statement}, nil
+}
+
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return c, nil }
+
+func (c *fakeConn) Commit() error {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+
+	for _, change := range c.pending {
+		change()
+	}
+
+	c.pending = nil
+
+	return nil
+}
+
+func (c *fakeConn) Rollback() error {
+	c.pending = nil
+
+	return nil
+}
+
+type fakeStmt struct {
+	conn      *fakeConn
+	statement string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	email := args[0]
+	if email == "fail@email" {
+		return nil, errDatabase
+	}
+
+	db := s.conn.db
+
+	switch {
+	case strings.HasPrefix(s.statement, "INSERT"):
+		s.conn.pending = append(s.conn.pending, func() { db.emails = append(db.emails, email) })
+	case strings.HasPrefix(s.statement, "DELETE"):
+		s.conn.pending = append(s.conn.pending, func() {
+			for index, existing := range db.emails {
+				if existing == email {
+					db.emails = append(db.emails[:index], db.emails[index+1:]...)
+
+					return
+				}
+			}
+		})
+	}
+
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &fakeRows{emails: s.conn.db.Emails()}, nil
+}
+
+type fakeRows struct {
+	emails []interface{}
+}
+
+func (r *fakeRows) Columns() []string { return []string{"email"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.emails) == 0 {
+		return io.EOF
+	}
+
+	dest[0], r.emails = r.emails[0], r.emails[1:]
+
+	return nil
+}
+
+func createAdapter(t *testing.T, emails []interface{}, optsFn ...func(*Query)) (*Query, *fakeDB) {
+	t.Helper()
+
+	fake := &fakeDB{emails: emails}
+	db := sql.OpenDB(fake)
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	return New(db, "SELECT email FROM members", optsFn...), fake
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	adapter, _ := createAdapter(t, nil)
+
+	assert.True(t, adapter.ReadOnly())
+	assert.Equal(t, map[string]string{
+		"list":   "SELECT email FROM members",
+		"add":    "",
+		"remove": "",
+	}, adapter.Config())
+
+	adapter, _ = createAdapter(t, nil, WithAdd("INSERT INTO members (email) VALUES (?)"))
+
+	assert.False(t, adapter.ReadOnly())
+	assert.Equal(t, "INSERT INTO members (email) VALUES (?)", adapter.Config()["add"])
+}
+
+func TestQuery_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, _ := createAdapter(t, []interface{}{"foo@email", nil, "bar@email"})
+
+		emails, err := adapter.Get(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email", "bar@email"}, emails)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, _ := createAdapter(t, nil)
+		adapter.list = "SELECT email FROM members WHERE email = 'fail@email'"
+
+		_, err := adapter.Get(context.TODO())
+
+		assert.ErrorIs(t, err, errDatabase)
+	})
+}
+
+//nolint:funlen
+func TestQuery_Add(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, fake := createAdapter(t, []interface{}{"foo@email"}, WithAdd("INSERT INTO members (email) VALUES (?)"))
+
+		assert.NoError(t, adapter.Add(context.TODO(), []string{"bar@email", "baz@email"}))
+		assert.Equal(t, []interface{}{"foo@email", "bar@email", "baz@email"}, fake.Emails())
+	})
+
+	t.Run("Rolled back", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, fake := createAdapter(t, []interface{}{"foo@email"}, WithAdd("INSERT INTO members (email) VALUES (?)"))
+
+		err := adapter.Add(context.TODO(), []string{"bar@email", "fail@email"})
+
+		assert.ErrorIs(t, err, errDatabase)
+		assert.ErrorContains(t, err, "INSERT INTO members (email) VALUES (?)")
+		assert.Equal(t, []interface{}{"foo@email"}, fake.Emails())
+	})
+
+	t.Run("Read only", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, _ := createAdapter(t, nil, WithRemove("DELETE FROM members WHERE email = ?"))
+
+		assert.ErrorIs(t, adapter.Add(context.TODO(), []string{"foo@email"}), gosync.ErrReadOnly)
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, fake := createAdapter(t, nil, WithAdd("INSERT INTO members (email) VALUES (?)"))
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+
+		assert.ErrorIs(t, adapter.Add(ctx, []string{"foo@email"}), context.Canceled)
+		assert.Empty(t, fake.Emails())
+	})
+}
+
+func TestQuery_Remove(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, fake := createAdapter(t, []interface{}{"foo@email", "bar@email"},
+			WithRemove("DELETE FROM members WHERE email = ?"))
+
+		assert.NoError(t, adapter.Remove(context.TODO(), []string{"foo@email"}))
+		assert.Equal(t, []interface{}{"bar@email"}, fake.Emails())
+	})
+
+	t.Run("Rolled back", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, fake := createAdapter(t, []interface{}{"foo@email"}, WithRemove("DELETE FROM members WHERE email = ?"))
+
+		assert.ErrorIs(t, adapter.Remove(context.TODO(), []string{"foo@email", "fail@email"}), errDatabase)
+		assert.Equal(t, []interface{}{"foo@email"}, fake.Emails())
+	})
+
+	t.Run("Read only", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, _ := createAdapter(t, nil, WithAdd("INSERT INTO members (email) VALUES (?)"))
+
+		assert.ErrorIs(t, adapter.Remove(context.TODO(), []string{"foo@email"}), gosync.ErrReadOnly)
+	})
+}
@@ -0,0 +1,153 @@
+package group
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ErrUnexpectedResponse is returned when the Zoom API responds with an unexpected status code.
+var ErrUnexpectedResponse = errors.New("unexpected response from zoom")
+
+// user is a Zoom user, with only the properties used by the adapter.
+type user struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+}
+
+// Client is a minimal Zoom API v2 client, authenticated with an OAuth access token.
+type Client struct {
+	httpClient *http.Client
+	server     string
+	token      string
+}
+
+// NewClient creates a new Zoom client for an OAuth access token, e.g. from a Server-to-Server OAuth app. To refresh
+// tokens automatically, pass an empty token and an HTTP client which authenticates requests to WithHTTPClient.
+func NewClient(token string) *Client {
+	return &Client{
+		httpClient: http.DefaultClient,
+		server:     "https://api.zoom.us/v2",
+		token:      token,
+	}
+}
+
+// WithHTTPClient sets a custom HTTP client, e.g. to configure timeouts or proxies, or to authenticate requests.
+func (c *Client) WithHTTPClient(httpClient *http.Client) *Client {
+	c.httpClient = httpClient
+
+	return c
+}
+
+// do makes a request to the Zoom API, and decodes the response into out if it isn't nil. The status code of the
+// response is returned, so callers can handle expected errors.
+func (c *Client) do(ctx context.Context, method string, path string, body interface{}, out interface{}) (int, error) {
+	var reader io.Reader
+
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return 0, fmt.Errorf("marshal -> %w", err)
+		}
+
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.server+path, reader)
+	if err != nil {
+		return 0, fmt.Errorf("newrequest(%s, %s) -> %w", method, path, err)
+	}
+
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("do(%s, %s) -> %w", method, path, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("do(%s, %s) -> %w: %s", method, path, ErrUnexpectedResponse, res.Status)
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+			return res.StatusCode, fmt.Errorf("decode(%s, %s) -> %w", method, path, err)
+		}
+	}
+
+	return res.StatusCode, nil
+}
+
+// GetGroupMembers gets a page of users in a group, and the token for the next page, which is empty on the last page.
+// Pass an empty token to get the first page.
+func (c *Client) GetGroupMembers(
+	ctx context.Context,
+	groupID string,
+	token string,
+	pageSize int,
+) ([]user, string, error) {
+	query := url.Values{"page_size": {strconv.Itoa(pageSize)}}
+	if token != "" {
+		query.Set("next_page_token", token)
+	}
+
+	var out struct {
+		Members       []user `json:"members"`
+		NextPageToken string `json:"next_page_token"`
+	}
+
+	path := "/groups/" + url.PathEscape(groupID) + "/members?" + query.Encode()
+	if _, err := c.do(ctx, http.MethodGet, path, nil, &out); err != nil {
+		return nil, "", err
+	}
+
+	return out.Members, out.NextPageToken, nil
+}
+
+// GetUserByEmail gets a user in the account by their email, or nil if they don't exist.
+func (c *Client) GetUserByEmail(ctx context.Context, email string) (*user, error) {
+	var found user
+
+	status, err := c.do(ctx, http.MethodGet, "/users/"+url.PathEscape(email), nil, &found)
+	if status == http.StatusNotFound {
+		return nil, nil //nolint:nilnil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &found, nil
+}
+
+// AddGroupMembers adds up to 30 users to a group, by their IDs.
+func (c *Client) AddGroupMembers(ctx context.Context, groupID string, userIDs ...string) error {
+	members := make([]map[string]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		members = append(members, map[string]string{"id": id})
+	}
+
+	path := "/groups/" + url.PathEscape(groupID) + "/members"
+	_, err := c.do(ctx, http.MethodPost, path, map[string]interface{}{"members": members}, nil)
+
+	return err
+}
+
+// RemoveGroupMember removes a user from a group.
+func (c *Client) RemoveGroupMember(ctx context.Context, groupID string, userID string) error {
+	path := "/groups/" + url.PathEscape(groupID) + "/members/" + url.PathEscape(userID)
+	_, err := c.do(ctx, http.MethodDelete, path, nil, nil)
+
+	return err
+}
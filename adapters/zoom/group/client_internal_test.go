@@ -0,0 +1,125 @@
+package group
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// startServer starts a fake Zoom API, which checks each request is authenticated before passing it to handler.
+func startServer(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		handler(w, r)
+	}))
+
+	t.Cleanup(server.Close)
+
+	client := NewClient("token")
+	client.server = server.URL + "/v2"
+
+	return client
+}
+
+//nolint:funlen
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("GetGroupMembers", func(t *testing.T) {
+		t.Parallel()
+
+		client := startServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v2/groups/group-id/members", r.URL.Path)
+			assert.Equal(t, "300", r.URL.Query().Get("page_size"))
+
+			switch r.URL.Query().Get("next_page_token") {
+			case "":
+				_, _ = w.Write([]byte(`{"members":[{"id":"foo","email":"foo@email"}],"next_page_token":"page-2"}`))
+			case "page-2":
+				_, _ = w.Write([]byte(`{"members":[{"id":"bar","email":"bar@email"}],"next_page_token":""}`))
+			}
+		})
+
+		users, next, err := client.GetGroupMembers(ctx, "group-id", "", 300)
+		assert.NoError(t, err)
+		assert.Equal(t, []user{{ID: "foo", Email: "foo@email"}}, users)
+		assert.Equal(t, "page-2", next)
+
+		users, next, err = client.GetGroupMembers(ctx, "group-id", "page-2", 300)
+		assert.NoError(t, err)
+		assert.Equal(t, []user{{ID: "bar", Email: "bar@email"}}, users)
+		assert.Empty(t, next)
+	})
+
+	t.Run("GetUserByEmail", func(t *testing.T) {
+		t.Parallel()
+
+		client := startServer(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/v2/users/foo@email":
+				_, _ = w.Write([]byte(`{"id":"foo","email":"foo@email"}`))
+			case "/v2/users/missing@email":
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"code":1001,"message":"User does not exist: missing@email."}`))
+			default:
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		})
+
+		found, err := client.GetUserByEmail(ctx, "foo@email")
+		assert.NoError(t, err)
+		assert.Equal(t, &user{ID: "foo", Email: "foo@email"}, found)
+
+		found, err = client.GetUserByEmail(ctx, "missing@email")
+		assert.NoError(t, err)
+		assert.Nil(t, found)
+
+		_, err = client.GetUserByEmail(ctx, "error@email")
+		assert.ErrorIs(t, err, ErrUnexpectedResponse)
+	})
+
+	t.Run("AddGroupMembers", func(t *testing.T) {
+		t.Parallel()
+
+		client := startServer(t, func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Members []map[string]string `json:"members"`
+			}
+
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/v2/groups/group-id/members", r.URL.Path)
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, []map[string]string{{"id": "foo"}, {"id": "bar"}}, body.Members)
+
+			w.WriteHeader(http.StatusCreated)
+		})
+
+		assert.NoError(t, client.AddGroupMembers(ctx, "group-id", "foo", "bar"))
+	})
+
+	t.Run("RemoveGroupMember", func(t *testing.T) {
+		t.Parallel()
+
+		client := startServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+
+			if r.URL.Path == "/v2/groups/group-id/members/foo" {
+				w.WriteHeader(http.StatusNoContent)
+			} else {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+
+		assert.NoError(t, client.RemoveGroupMember(ctx, "group-id", "foo"))
+		assert.ErrorIs(t, client.RemoveGroupMember(ctx, "group-id", "bar"), ErrUnexpectedResponse)
+	})
+}
@@ -0,0 +1,189 @@
+/*
+Package group synchronises email addresses with a Zoom group.
+
+In order to use this adapter, you'll need a Zoom OAuth access token with permission to read users and manage groups.
+*/
+package group
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	gosync "github.com/ovotech/go-sync"
+)
+
+// Ensure the adapter type fully satisfies the ports.Adapter and ports.ConfiguredAdapter interfaces.
+var (
+	_ gosync.Adapter           = &Group{}
+	_ gosync.ConfiguredAdapter = &Group{}
+)
+
+// ErrUserNotFound is returned when an email doesn't belong to a user in the Zoom account. Zoom only allows existing
+// users to be added to groups, so they must be created (or have accepted their invitation) first.
+var ErrUserNotFound = errors.New("user not found")
+
+const (
+	pageSize  = 300 // pageSize is the most group members Zoom returns per page.
+	batchSize = 30  // batchSize is the most users Zoom allows to be added to a group in a single call.
+)
+
+// iZoomClient is a subset of the Zoom Client, and used to build mocks for easy testing.
+type iZoomClient interface {
+	GetGroupMembers(ctx context.Context, groupID string, token string, pageSize int) ([]user, string, error)
+	GetUserByEmail(ctx context.Context, email string) (*user, error)
+	AddGroupMembers(ctx context.Context, groupID string, userIDs ...string) error
+	RemoveGroupMember(ctx context.Context, groupID string, userID string) error
+}
+
+type Group struct {
+	client  iZoomClient
+	groupID string
+	// cache stores the email -> user ID mapping for use with the Remove method.
+	cache  map[string]string
+	logger *log.Logger
+}
+
+// WithLogger sets a custom logger.
+func WithLogger(logger *log.Logger) func(*Group) {
+	return func(group *Group) {
+		group.logger = logger
+	}
+}
+
+// New instantiates a new Zoom group adapter, for the group with the given ID.
+func New(client *Client, groupID string, optsFn ...func(*Group)) *Group {
+	group := &Group{
+		client:  client,
+		groupID: groupID,
+		cache:   nil,
+		logger:  log.New(os.Stderr, "[go-sync/zoom/group] ", log.LstdFlags|log.Lshortfile|log.Lmsgprefix),
+	}
+
+	for _, fn := range optsFn {
+		fn(group)
+	}
+
+	return group
+}
+
+// Config returns the adapter's configuration, with the token redacted.
+func (g *Group) Config() map[string]string {
+	config := map[string]string{
+		"group": g.groupID,
+	}
+
+	if client, ok := g.client.(*Client); ok {
+		config["server"] = client.server
+		config["token"] = gosync.Redacted
+	}
+
+	return config
+}
+
+// Get emails of users in a Zoom group.
+func (g *Group) Get(ctx context.Context) ([]string, error) {
+	g.logger.Printf("Fetching accounts from Zoom group %s", g.groupID)
+
+	// Initialise the cache.
+	g.cache = make(map[string]string)
+
+	emails := make([]string, 0)
+	token := ""
+
+	for {
+		users, next, err := g.client.GetGroupMembers(ctx, g.groupID, token, pageSize)
+		if err != nil {
+			return nil, fmt.Errorf("zoom.group.get.getgroupmembers(%s) -> %w", g.groupID, err)
+		}
+
+		for _, user := range users {
+			emails = append(emails, user.Email)
+
+			// Add the email -> ID map for use with the Remove method.
+			g.cache[user.Email] = user.ID
+		}
+
+		if next == "" {
+			break
+		}
+
+		token = next
+	}
+
+	g.logger.Println("Fetched accounts successfully")
+
+	return emails, nil
+}
+
+// Add emails to a Zoom group. Every email is resolved to a user in the account before any are added, so if one can't
+// be found, Add fails with ErrUserNotFound without changing the group.
+func (g *Group) Add(ctx context.Context, emails []string) error {
+	g.logger.Printf("Adding %s to Zoom group %s", emails, g.groupID)
+
+	userIDs := make([]string, 0, len(emails))
+
+	for _, email := range emails {
+		found, err := g.client.GetUserByEmail(ctx, email)
+		if err != nil {
+			return fmt.Errorf("zoom.group.add.getuserbyemail(%s) -> %w", email, err)
+		}
+
+		if found == nil {
+			return fmt.Errorf("zoom.group.add.getuserbyemail(%s) -> %w", email, ErrUserNotFound)
+		}
+
+		userIDs = append(userIDs, found.ID)
+	}
+
+	for start := 0; start < len(userIDs); start += batchSize {
+		end := start + batchSize
+		if end > len(userIDs) {
+			end = len(userIDs)
+		}
+
+		err := g.client.AddGroupMembers(ctx, g.groupID, userIDs[start:end]...)
+		if err != nil {
+			return fmt.Errorf("zoom.group.add.addgroupmembers(%s, %s) -> %w", g.groupID, emails[start:end], err)
+		}
+
+		if g.cache != nil {
+			for index := start; index < end; index++ {
+				g.cache[emails[index]] = userIDs[index]
+			}
+		}
+
+		gosync.ReportProgress(ctx, end, len(emails))
+	}
+
+	g.logger.Println("Finished adding accounts successfully")
+
+	return nil
+}
+
+// Remove emails from a Zoom group. The users remain in the account.
+func (g *Group) Remove(ctx context.Context, emails []string) error {
+	g.logger.Printf("Removing %s from Zoom group %s", emails, g.groupID)
+
+	// If the cache hasn't been generated, regenerate it.
+	if g.cache == nil {
+		return fmt.Errorf("zoom.group.remove -> %w", gosync.ErrCacheEmpty)
+	}
+
+	for index, email := range emails {
+		err := g.client.RemoveGroupMember(ctx, g.groupID, g.cache[email])
+		if err != nil {
+			return fmt.Errorf("zoom.group.remove.removegroupmember(%s, %s) -> %w", g.groupID, email, err)
+		}
+
+		delete(g.cache, email)
+
+		gosync.ReportProgress(ctx, index+1, len(emails))
+	}
+
+	g.logger.Println("Finished removing accounts successfully")
+
+	return nil
+}
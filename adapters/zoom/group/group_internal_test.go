@@ -0,0 +1,163 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	gosync "github.com/ovotech/go-sync"
+	"github.com/stretchr/testify/assert"
+)
+
+var errZoom = errors.New("an example error")
+
+func createMockedAdapter(t *testing.T) (*Group, *mockIZoomClient) {
+	t.Helper()
+
+	client := newMockIZoomClient(t)
+	adapter := New(NewClient("token"), "group-id")
+	adapter.client = client
+
+	return adapter, client
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	adapter := New(NewClient("secret-token"), "group-id")
+
+	assert.Equal(t, "group-id", adapter.groupID)
+	assert.Equal(t, map[string]string{
+		"group":  "group-id",
+		"server": "https://api.zoom.us/v2",
+		"token":  gosync.Redacted,
+	}, adapter.Config())
+}
+
+func TestGroup_Get(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+
+		client.EXPECT().GetGroupMembers(ctx, "group-id", "", pageSize).
+			Return([]user{{ID: "foo", Email: "foo@email"}}, "page-2", nil)
+		client.EXPECT().GetGroupMembers(ctx, "group-id", "page-2", pageSize).
+			Return([]user{{ID: "bar", Email: "bar@email"}}, "", nil)
+
+		emails, err := adapter.Get(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email", "bar@email"}, emails)
+		assert.Equal(t, map[string]string{"foo@email": "foo", "bar@email": "bar"}, adapter.cache)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+
+		client.EXPECT().GetGroupMembers(ctx, "group-id", "", pageSize).Return(nil, "", errZoom)
+
+		_, err := adapter.Get(ctx)
+
+		assert.ErrorIs(t, err, errZoom)
+	})
+}
+
+func TestGroup_Add(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Batches", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+		adapter.cache = map[string]string{}
+
+		emails := make([]string, batchSize+1)
+		ids := make([]interface{}, batchSize+1)
+
+		for i := range emails {
+			emails[i] = fmt.Sprintf("user%d@email", i)
+			ids[i] = fmt.Sprintf("user%d", i)
+
+			client.EXPECT().GetUserByEmail(ctx, emails[i]).Return(&user{ID: ids[i].(string), Email: emails[i]}, nil)
+		}
+
+		client.EXPECT().AddGroupMembers(ctx, "group-id", ids[:batchSize]...).Return(nil).Once()
+		client.EXPECT().AddGroupMembers(ctx, "group-id", ids[batchSize:]...).Return(nil).Once()
+
+		assert.NoError(t, adapter.Add(ctx, emails))
+		assert.Len(t, adapter.cache, batchSize+1)
+		assert.Equal(t, "user0", adapter.cache["user0@email"])
+	})
+
+	t.Run("User not found", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+
+		client.EXPECT().GetUserByEmail(ctx, "foo@email").Return(&user{ID: "foo", Email: "foo@email"}, nil)
+		client.EXPECT().GetUserByEmail(ctx, "missing@email").Return(nil, nil)
+
+		err := adapter.Add(ctx, []string{"foo@email", "missing@email"})
+
+		assert.ErrorIs(t, err, ErrUserNotFound)
+		assert.ErrorContains(t, err, "missing@email")
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+
+		client.EXPECT().GetUserByEmail(ctx, "foo@email").Return(&user{ID: "foo", Email: "foo@email"}, nil)
+		client.EXPECT().AddGroupMembers(ctx, "group-id", "foo").Return(errZoom)
+
+		assert.ErrorIs(t, adapter.Add(ctx, []string{"foo@email"}), errZoom)
+	})
+}
+
+func TestGroup_Remove(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+		adapter.cache = map[string]string{"foo@email": "foo", "bar@email": "bar"}
+
+		client.EXPECT().RemoveGroupMember(ctx, "group-id", "foo").Return(nil)
+
+		assert.NoError(t, adapter.Remove(ctx, []string{"foo@email"}))
+		assert.Equal(t, map[string]string{"bar@email": "bar"}, adapter.cache)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, client := createMockedAdapter(t)
+		adapter.cache = map[string]string{"foo@email": "foo"}
+
+		client.EXPECT().RemoveGroupMember(ctx, "group-id", "foo").Return(errZoom)
+
+		assert.ErrorIs(t, adapter.Remove(ctx, []string{"foo@email"}), errZoom)
+	})
+
+	t.Run("Cache not built", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, _ := createMockedAdapter(t)
+
+		assert.ErrorIs(t, adapter.Remove(ctx, []string{"foo@email"}), gosync.ErrCacheEmpty)
+	})
+}
@@ -0,0 +1,232 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package group
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockIZoomClient is an autogenerated mock type for the iZoomClient type
+type mockIZoomClient struct {
+	mock.Mock
+}
+
+type mockIZoomClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockIZoomClient) EXPECT() *mockIZoomClient_Expecter {
+	return &mockIZoomClient_Expecter{mock: &_m.Mock}
+}
+
+// AddGroupMembers provides a mock function with given fields: ctx, groupID, userIDs
+func (_m *mockIZoomClient) AddGroupMembers(ctx context.Context, groupID string, userIDs ...string) error {
+	_va := make([]interface{}, len(userIDs))
+	for _i := range userIDs {
+		_va[_i] = userIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, groupID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...string) error); ok {
+		r0 = rf(ctx, groupID, userIDs...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockIZoomClient_AddGroupMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddGroupMembers'
+type mockIZoomClient_AddGroupMembers_Call struct {
+	*mock.Call
+}
+
+// AddGroupMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID string
+//   - userIDs ...string
+func (_e *mockIZoomClient_Expecter) AddGroupMembers(ctx interface{}, groupID interface{}, userIDs ...interface{}) *mockIZoomClient_AddGroupMembers_Call {
+	return &mockIZoomClient_AddGroupMembers_Call{Call: _e.mock.On("AddGroupMembers",
+		append([]interface{}{ctx, groupID}, userIDs...)...)}
+}
+
+func (_c *mockIZoomClient_AddGroupMembers_Call) Run(run func(ctx context.Context, groupID string, userIDs ...string)) *mockIZoomClient_AddGroupMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *mockIZoomClient_AddGroupMembers_Call) Return(_a0 error) *mockIZoomClient_AddGroupMembers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// GetGroupMembers provides a mock function with given fields: ctx, groupID, token, pageSize
+func (_m *mockIZoomClient) GetGroupMembers(ctx context.Context, groupID string, token string, pageSize int) ([]user, string, error) {
+	ret := _m.Called(ctx, groupID, token, pageSize)
+
+	var r0 []user
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) []user); ok {
+		r0 = rf(ctx, groupID, token, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) string); ok {
+		r1 = rf(ctx, groupID, token, pageSize)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, int) error); ok {
+		r2 = rf(ctx, groupID, token, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// mockIZoomClient_GetGroupMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupMembers'
+type mockIZoomClient_GetGroupMembers_Call struct {
+	*mock.Call
+}
+
+// GetGroupMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID string
+//   - token string
+//   - pageSize int
+func (_e *mockIZoomClient_Expecter) GetGroupMembers(ctx interface{}, groupID interface{}, token interface{}, pageSize interface{}) *mockIZoomClient_GetGroupMembers_Call {
+	return &mockIZoomClient_GetGroupMembers_Call{Call: _e.mock.On("GetGroupMembers", ctx, groupID, token, pageSize)}
+}
+
+func (_c *mockIZoomClient_GetGroupMembers_Call) Run(run func(ctx context.Context, groupID string, token string, pageSize int)) *mockIZoomClient_GetGroupMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *mockIZoomClient_GetGroupMembers_Call) Return(_a0 []user, _a1 string, _a2 error) *mockIZoomClient_GetGroupMembers_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+// GetUserByEmail provides a mock function with given fields: ctx, email
+func (_m *mockIZoomClient) GetUserByEmail(ctx context.Context, email string) (*user, error) {
+	ret := _m.Called(ctx, email)
+
+	var r0 *user
+	if rf, ok := ret.Get(0).(func(context.Context, string) *user); ok {
+		r0 = rf(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*user)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockIZoomClient_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
+type mockIZoomClient_GetUserByEmail_Call struct {
+	*mock.Call
+}
+
+// GetUserByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *mockIZoomClient_Expecter) GetUserByEmail(ctx interface{}, email interface{}) *mockIZoomClient_GetUserByEmail_Call {
+	return &mockIZoomClient_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", ctx, email)}
+}
+
+func (_c *mockIZoomClient_GetUserByEmail_Call) Run(run func(ctx context.Context, email string)) *mockIZoomClient_GetUserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockIZoomClient_GetUserByEmail_Call) Return(_a0 *user, _a1 error) *mockIZoomClient_GetUserByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// RemoveGroupMember provides a mock function with given fields: ctx, groupID, userID
+func (_m *mockIZoomClient) RemoveGroupMember(ctx context.Context, groupID string, userID string) error {
+	ret := _m.Called(ctx, groupID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, groupID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockIZoomClient_RemoveGroupMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveGroupMember'
+type mockIZoomClient_RemoveGroupMember_Call struct {
+	*mock.Call
+}
+
+// RemoveGroupMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID string
+//   - userID string
+func (_e *mockIZoomClient_Expecter) RemoveGroupMember(ctx interface{}, groupID interface{}, userID interface{}) *mockIZoomClient_RemoveGroupMember_Call {
+	return &mockIZoomClient_RemoveGroupMember_Call{Call: _e.mock.On("RemoveGroupMember", ctx, groupID, userID)}
+}
+
+func (_c *mockIZoomClient_RemoveGroupMember_Call) Run(run func(ctx context.Context, groupID string, userID string)) *mockIZoomClient_RemoveGroupMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockIZoomClient_RemoveGroupMember_Call) Return(_a0 error) *mockIZoomClient_RemoveGroupMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+type mockConstructorTestingTnewMockIZoomClient interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// newMockIZoomClient creates a new instance of mockIZoomClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func newMockIZoomClient(t mockConstructorTestingTnewMockIZoomClient) *mockIZoomClient {
+	mock := &mockIZoomClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
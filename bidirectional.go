@@ -0,0 +1,156 @@
+package gosync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// conflictPolicy specifies how SyncBidirectional resolves things that are only in one of the adapters.
+type conflictPolicy string
+
+const (
+	// NeverRemove adds things that are missing from either adapter, so both adapters converge on the union.
+	// Nothing is ever removed, so no data can be lost.
+	NeverRemove conflictPolicy = "NeverRemove"
+	// RemoveUnshared removes things that aren't in both adapters, so both adapters converge on the intersection.
+	// To guard against data loss, the sync is aborted if this would remove everything from either adapter.
+	RemoveUnshared conflictPolicy = "RemoveUnshared"
+)
+
+// difference returns the things in a whose identities aren't in b.
+func difference(a map[string]string, b map[string]string) []string {
+	out := make([]string, 0, len(a))
+
+	for key, thing := range a {
+		if _, ok := b[key]; !ok {
+			out = append(out, thing)
+		}
+	}
+
+	return out
+}
+
+// bidirectionalOperation is an operation on one side of a bidirectional sync.
+type bidirectionalOperation struct {
+	name     string
+	adapter  Adapter
+	isSource bool
+	fn       func() error
+}
+
+// SyncBidirectional synchronises the source adapter and another adapter in both directions, so they end up with the
+// same things. Unlike SyncWith, neither adapter is authoritative. Things that are only in one of the adapters are
+// resolved using the ConflictPolicy.
+//
+// If one of the adapters is read-only (it implements ReadOnlyAdapter, or returns ErrReadOnly), it's skipped and the
+// other adapter is still synchronised. The returned error then wraps ErrReadOnly, to note that the adapters may differ.
+func (s *Sync) SyncBidirectional(ctx context.Context, adapter Adapter) error {
+	s.logger.Println("Starting bidirectional sync")
+
+	// Call to populate the cache from the source adapter.
+	if err := s.generateCache(ctx); err != nil {
+		return fmt.Errorf("sync.syncbidirectional.generateCache -> %w", err)
+	}
+
+	s.logger.Println("Getting things from other adapter")
+
+	things, err := adapter.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("sync.syncbidirectional.get -> %w", err)
+	}
+
+	var (
+		other            = s.index(things)
+		onlyInSource     = difference(s.cache, other)
+		onlyInAdapter    = difference(other, s.cache)
+		operations       []bidirectionalOperation
+		noDiff           = func(things []string) []string { return things }
+		add              = s.notifyChanges(adapter, "add", adapter.Add)
+		addToSource      = s.notifyChanges(s.source, "add to source", s.source.Add)
+		remove           = s.notifyChanges(adapter, "remove", s.journalRemovals(adapter, "remove", adapter.Remove))
+		removeFromSource = s.notifyChanges(
+			s.source,
+			"remove from source",
+			s.journalRemovals(s.source, "remove from source", s.source.Remove),
+		)
+	)
+
+	s.logger.Printf("Resolving conflicts with %s policy", s.ConflictPolicy)
+
+	switch s.ConflictPolicy {
+	case NeverRemove:
+		operations = []bidirectionalOperation{
+			{"add to source", s.source, true, s.perform(ctx, "add to source", onlyInAdapter, noDiff, addToSource, nil, nil)},
+			{"add", adapter, false, s.perform(ctx, "add", onlyInSource, noDiff, add, nil, nil)},
+		}
+	case RemoveUnshared:
+		// If nothing is shared, then one of the adapters would be emptied.
+		if len(s.cache)+len(other) > 0 && len(s.cache)-len(onlyInSource) == 0 {
+			return fmt.Errorf("sync.syncbidirectional(%d, %d) -> %w", len(s.cache), len(other), ErrUnsafeRemoval)
+		}
+
+		operations = []bidirectionalOperation{
+			{
+				"remove from source", s.source, true,
+				s.perform(ctx, "remove from source", onlyInSource, noDiff, removeFromSource, nil, nil),
+			},
+			{"remove", adapter, false, s.perform(ctx, "remove", onlyInAdapter, noDiff, remove, nil, nil)},
+		}
+	}
+
+	var (
+		skipped       []string
+		sourceChanged bool
+	)
+
+	for _, operation := range operations {
+		if isReadOnly(operation.adapter) {
+			s.logger.Printf("Skipping %s, as the adapter is read-only", operation.name)
+
+			skipped = append(skipped, operation.name)
+
+			continue
+		}
+
+		err = operation.fn()
+		if errors.Is(err, ErrReadOnly) {
+			s.logger.Printf("Skipped %s, as the adapter is read-only", operation.name)
+
+			skipped = append(skipped, operation.name)
+
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("sync.syncbidirectional.execute -> %w", err)
+		}
+
+		sourceChanged = sourceChanged || operation.isSource
+	}
+
+	// Keep the cache in line with the changes made to the source adapter.
+	if !s.DryRun && sourceChanged {
+		switch s.ConflictPolicy {
+		case NeverRemove:
+			for _, thing := range onlyInAdapter {
+				s.cache[s.identity(thing)] = thing
+			}
+		case RemoveUnshared:
+			for _, thing := range onlyInSource {
+				delete(s.cache, s.identity(thing))
+			}
+		}
+	}
+
+	if len(skipped) > 0 {
+		s.logger.Println("Finished bidirectional sync, but one of the adapters is read-only")
+
+		return fmt.Errorf("sync.syncbidirectional(%s) -> %w", strings.Join(skipped, ", "), ErrReadOnly)
+	}
+
+	s.logger.Println("Finished bidirectional sync")
+
+	return nil
+}
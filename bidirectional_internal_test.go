@@ -0,0 +1,177 @@
+package gosync
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// memoryAdapter is a minimal in-memory adapter, used to test real sync flows.
+type memoryAdapter map[string]bool
+
+func newMemoryAdapter(things ...string) memoryAdapter {
+	return generateHashMap(things)
+}
+
+func (m memoryAdapter) Get(_ context.Context) ([]string, error) {
+	out := make([]string, 0, len(m))
+	for thing := range m {
+		out = append(out, thing)
+	}
+
+	sort.Strings(out)
+
+	return out, nil
+}
+
+func (m memoryAdapter) Add(_ context.Context, things []string) error {
+	for _, thing := range things {
+		m[thing] = true
+	}
+
+	return nil
+}
+
+func (m memoryAdapter) Remove(_ context.Context, things []string) error {
+	for _, thing := range things {
+		delete(m, thing)
+	}
+
+	return nil
+}
+
+// readOnlyMemoryAdapter is a memoryAdapter that declares that it's read-only.
+type readOnlyMemoryAdapter struct {
+	memoryAdapter
+}
+
+func (r readOnlyMemoryAdapter) ReadOnly() bool {
+	return true
+}
+
+//nolint:funlen
+func TestSync_SyncBidirectional(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("NeverRemove", func(t *testing.T) {
+		t.Parallel()
+
+		source := newMemoryAdapter("foo", "bar")
+		other := newMemoryAdapter("bar", "baz")
+
+		syncService := New(source)
+
+		err := syncService.SyncBidirectional(ctx, other)
+		assert.NoError(t, err)
+
+		sourceThings, _ := source.Get(ctx)
+		otherThings, _ := other.Get(ctx)
+
+		assert.Equal(t, []string{"bar", "baz", "foo"}, sourceThings)
+		assert.Equal(t, []string{"bar", "baz", "foo"}, otherThings)
+
+		// A second sync should use the updated cache, and change nothing.
+		err = syncService.SyncBidirectional(ctx, other)
+		assert.NoError(t, err)
+
+		otherThings, _ = other.Get(ctx)
+		assert.Equal(t, []string{"bar", "baz", "foo"}, otherThings)
+	})
+
+	t.Run("RemoveUnshared", func(t *testing.T) {
+		t.Parallel()
+
+		source := newMemoryAdapter("foo", "bar")
+		other := newMemoryAdapter("bar", "baz")
+
+		syncService := New(source)
+		syncService.ConflictPolicy = RemoveUnshared
+
+		err := syncService.SyncBidirectional(ctx, other)
+		assert.NoError(t, err)
+
+		sourceThings, _ := source.Get(ctx)
+		otherThings, _ := other.Get(ctx)
+
+		assert.Equal(t, []string{"bar"}, sourceThings)
+		assert.Equal(t, []string{"bar"}, otherThings)
+		assert.Equal(t, map[string]string{"bar": "bar"}, syncService.cache)
+	})
+
+	t.Run("RemoveUnshared guards against data loss", func(t *testing.T) {
+		t.Parallel()
+
+		source := newMemoryAdapter("foo")
+		other := newMemoryAdapter()
+
+		syncService := New(source)
+		syncService.ConflictPolicy = RemoveUnshared
+
+		err := syncService.SyncBidirectional(ctx, other)
+		assert.ErrorIs(t, err, ErrUnsafeRemoval)
+
+		sourceThings, _ := source.Get(ctx)
+		assert.Equal(t, []string{"foo"}, sourceThings)
+	})
+
+	t.Run("Read-only adapter", func(t *testing.T) {
+		t.Parallel()
+
+		source := newMemoryAdapter("foo")
+		other := newMemoryAdapter("bar")
+
+		// The source declares that it's read-only, so it's never written to.
+		syncService := New(readOnlyMemoryAdapter{source})
+
+		err := syncService.SyncBidirectional(ctx, other)
+		assert.ErrorIs(t, err, ErrReadOnly)
+		assert.ErrorContains(t, err, "add to source")
+
+		sourceThings, _ := source.Get(ctx)
+		otherThings, _ := other.Get(ctx)
+
+		assert.Equal(t, []string{"foo"}, sourceThings)
+		assert.Equal(t, []string{"bar", "foo"}, otherThings)
+		assert.Equal(t, map[string]string{"foo": "foo"}, syncService.cache)
+	})
+
+	t.Run("Adapter returns ErrReadOnly", func(t *testing.T) {
+		t.Parallel()
+
+		source := newMemoryAdapter("foo", "bar")
+		other := NewMockAdapter(t)
+
+		other.EXPECT().Get(ctx).Once().Return([]string{"baz"}, nil)
+		other.EXPECT().Add(mock.Anything, mock.Anything).Once().Return(ErrReadOnly)
+
+		err := New(source).SyncBidirectional(ctx, other)
+		assert.ErrorIs(t, err, ErrReadOnly)
+
+		sourceThings, _ := source.Get(ctx)
+		assert.Equal(t, []string{"bar", "baz", "foo"}, sourceThings)
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		t.Parallel()
+
+		source := newMemoryAdapter("foo")
+		other := newMemoryAdapter("bar")
+
+		syncService := New(source)
+		syncService.DryRun = true
+
+		err := syncService.SyncBidirectional(ctx, other)
+		assert.NoError(t, err)
+
+		sourceThings, _ := source.Get(ctx)
+		otherThings, _ := other.Get(ctx)
+
+		assert.Equal(t, []string{"foo"}, sourceThings)
+		assert.Equal(t, []string{"bar"}, otherThings)
+	})
+}
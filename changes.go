@@ -0,0 +1,57 @@
+package gosync
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// ChangeEvent is a single change Sync is about to make to an adapter, passed to the WithOnChange callback.
+type ChangeEvent struct {
+	Time        time.Time // Time the change was about to be made.
+	Action      string    // Action being performed, e.g. add, remove or add to source.
+	Thing       string    // Thing being changed, e.g. an email.
+	Destination string    // Destination is the name of the adapter being changed, as in Result.Destination.
+}
+
+// WithOnChange calls fn with a ChangeEvent for each thing immediately before it's passed to an adapter's Add or Remove
+// method, e.g. to stream changes into an audit log as they happen. As the adapter hasn't been called yet, a change may
+// still fail; a failed run reports the error as normal. fn is never called in dry run mode, and is never called
+// concurrently, even with WithConcurrency.
+func WithOnChange(fn func(event ChangeEvent)) func(*Sync) {
+	return func(sync *Sync) {
+		sync.onChange = fn
+	}
+}
+
+// notifyChanges wraps an adapter's Add or Remove method, so the WithOnChange callback is called for each thing
+// immediately before executeFn is called with it.
+func (s *Sync) notifyChanges(
+	adapter Adapter,
+	action string,
+	executeFn func(context.Context, []string) error,
+) func(context.Context, []string) error {
+	if s.onChange == nil {
+		return executeFn
+	}
+
+	var (
+		mu          sync.Mutex
+		destination = adapterName(adapter)
+	)
+
+	return func(ctx context.Context, things []string) error {
+		mu.Lock()
+		for _, thing := range things {
+			s.onChange(ChangeEvent{
+				Time:        time.Now(),
+				Action:      action,
+				Thing:       thing,
+				Destination: destination,
+			})
+		}
+		mu.Unlock()
+
+		return executeFn(ctx, things)
+	}
+}
@@ -0,0 +1,129 @@
+package gosync
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// changeSteps returns the action, thing and destination of each change, to compare them without timestamps.
+func changeSteps(events []ChangeEvent) [][3]string {
+	out := make([][3]string, 0, len(events))
+
+	for _, event := range events {
+		out = append(out, [3]string{event.Action, event.Thing, event.Destination})
+	}
+
+	return out
+}
+
+//nolint:funlen
+func TestWithOnChange(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Before each call", func(t *testing.T) {
+		t.Parallel()
+
+		var changes []ChangeEvent
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		syncService := New(source, WithOnChange(func(event ChangeEvent) {
+			changes = append(changes, event)
+		}))
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{"bar"}, nil)
+		destination.EXPECT().Remove(mock.Anything, []string{"bar"}).Run(func(context.Context, []string) {
+			// The change is streamed before the adapter is called.
+			assert.Equal(t, [][3]string{{"remove", "bar", "*gosync.MockAdapter"}}, changeSteps(changes))
+		}).Return(nil).Once()
+		destination.EXPECT().Add(mock.Anything, []string{"foo"}).Once().Return(nil)
+
+		err := syncService.SyncWith(ctx, destination)
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][3]string{
+			{"remove", "bar", "*gosync.MockAdapter"},
+			{"add", "foo", "*gosync.MockAdapter"},
+		}, changeSteps(changes))
+		assert.False(t, changes[0].Time.IsZero())
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		testErr := errors.New("foo") //nolint:goerr113
+
+		var changes []ChangeEvent
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		syncService := New(source, WithOnChange(func(event ChangeEvent) {
+			changes = append(changes, event)
+		}))
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{"bar"}, nil)
+		destination.EXPECT().Remove(mock.Anything, []string{"bar"}).Once().Return(testErr)
+
+		err := syncService.SyncWith(ctx, destination)
+
+		// The change was streamed even though the run failed, and nothing after it was attempted.
+		assert.ErrorIs(t, err, testErr)
+		assert.Equal(t, [][3]string{{"remove", "bar", "*gosync.MockAdapter"}}, changeSteps(changes))
+	})
+
+	t.Run("Dry run", func(t *testing.T) {
+		t.Parallel()
+
+		called := false
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		syncService := New(source, WithOnChange(func(ChangeEvent) { called = true }))
+		syncService.DryRun = true
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{"bar"}, nil)
+
+		err := syncService.SyncWith(ctx, destination)
+
+		assert.NoError(t, err)
+		assert.False(t, called)
+	})
+
+	t.Run("Bidirectional", func(t *testing.T) {
+		t.Parallel()
+
+		var changes []ChangeEvent
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		syncService := New(source, WithOnChange(func(event ChangeEvent) {
+			changes = append(changes, event)
+		}))
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{"bar"}, nil)
+		source.EXPECT().Add(mock.Anything, []string{"bar"}).Once().Return(nil)
+		destination.EXPECT().Add(mock.Anything, []string{"foo"}).Once().Return(nil)
+
+		err := syncService.SyncBidirectional(ctx, destination)
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][3]string{
+			{"add to source", "bar", "*gosync.MockAdapter"},
+			{"add", "foo", "*gosync.MockAdapter"},
+		}, changeSteps(changes))
+	})
+}
@@ -0,0 +1,222 @@
+package gosync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Ensure CircuitBreaker fully satisfies the Adapter, Namer, ReadOnlyAdapter and TypedAdapter interfaces.
+var (
+	_ Adapter         = &CircuitBreaker{}
+	_ Namer           = &CircuitBreaker{}
+	_ ReadOnlyAdapter = &CircuitBreaker{}
+	_ TypedAdapter    = &CircuitBreaker{}
+)
+
+// CircuitState is the current state of a CircuitBreaker.
+type CircuitState string
+
+const (
+	// CircuitClosed passes all calls through to the wrapped adapter.
+	CircuitClosed CircuitState = "Closed"
+	// CircuitOpen short-circuits all calls until the cooldown has elapsed.
+	CircuitOpen CircuitState = "Open"
+	// CircuitHalfOpen allows a single trial call through, which will either close or re-open the circuit. Other calls
+	// are short-circuited until the trial call completes.
+	CircuitHalfOpen CircuitState = "HalfOpen"
+)
+
+const (
+	defaultFailureThreshold = 5
+	defaultCooldown         = 30 * time.Second
+)
+
+// CircuitBreaker wraps an adapter, and stops calling it after a number of consecutive failures.
+type CircuitBreaker struct {
+	adapter   Adapter       // The wrapped adapter.
+	threshold int           // Number of consecutive failures before the circuit opens.
+	cooldown  time.Duration // Time to wait before allowing a trial call through an open circuit.
+	state     CircuitState
+	failures  int       // Number of consecutive failures.
+	openedAt  time.Time // Time the circuit was last opened.
+	trial     bool      // Whether a half-open trial call is in progress.
+	getTime   func() time.Time
+	mu        sync.Mutex
+}
+
+// WithFailureThreshold sets the number of consecutive failures before the circuit opens.
+func WithFailureThreshold(threshold int) func(*CircuitBreaker) {
+	return func(breaker *CircuitBreaker) {
+		breaker.threshold = threshold
+	}
+}
+
+// WithCooldown sets how long an open circuit waits before allowing a trial call through.
+func WithCooldown(cooldown time.Duration) func(*CircuitBreaker) {
+	return func(breaker *CircuitBreaker) {
+		breaker.cooldown = cooldown
+	}
+}
+
+// NewCircuitBreaker wraps an adapter with a circuit breaker.
+func NewCircuitBreaker(adapter Adapter, optsFn ...func(*CircuitBreaker)) *CircuitBreaker {
+	breaker := &CircuitBreaker{
+		adapter:   adapter,
+		threshold: defaultFailureThreshold,
+		cooldown:  defaultCooldown,
+		state:     CircuitClosed,
+		getTime:   time.Now,
+	}
+
+	for _, fn := range optsFn {
+		fn(breaker)
+	}
+
+	return breaker
+}
+
+// State returns the current state of the circuit, for use with metrics.
+func (c *CircuitBreaker) State() CircuitState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state == CircuitOpen && c.getTime().Sub(c.openedAt) >= c.cooldown {
+		return CircuitHalfOpen
+	}
+
+	return c.state
+}
+
+// Failures returns the current number of consecutive failures, for use with metrics.
+func (c *CircuitBreaker) Failures() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.failures
+}
+
+// allow determines whether a call should be passed through to the wrapped adapter, and whether it's the trial call of
+// a half-open circuit. Only one trial call is allowed through at a time.
+func (c *CircuitBreaker) allow() (bool, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state == CircuitOpen {
+		if c.getTime().Sub(c.openedAt) < c.cooldown {
+			return false, false
+		}
+
+		c.state = CircuitHalfOpen
+	}
+
+	if c.state == CircuitHalfOpen {
+		if c.trial {
+			return false, false
+		}
+
+		c.trial = true
+
+		return true, true
+	}
+
+	return true, false
+}
+
+// record updates the state of the circuit with the result of a call. Errors that aren't the wrapped adapter failing,
+// i.e. ErrReadOnly and the context being cancelled or timing out, aren't counted.
+func (c *CircuitBreaker) record(err error, trial bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if trial {
+		c.trial = false
+	}
+
+	if errors.Is(err, ErrReadOnly) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return
+	}
+
+	if err == nil {
+		c.state = CircuitClosed
+		c.failures = 0
+
+		return
+	}
+
+	c.failures++
+
+	// A failed trial call re-opens the circuit immediately.
+	if c.state == CircuitHalfOpen || c.failures >= c.threshold {
+		c.state = CircuitOpen
+		c.openedAt = c.getTime()
+	}
+}
+
+// Name returns the wrapped adapter's name, rather than the circuit breaker's type.
+func (c *CircuitBreaker) Name() string {
+	return adapterName(c.adapter)
+}
+
+// ReadOnly returns true if the wrapped adapter can only be used as a source.
+func (c *CircuitBreaker) ReadOnly() bool {
+	return isReadOnly(c.adapter)
+}
+
+// Type returns the wrapped adapter's type of things, if it declares one.
+func (c *CircuitBreaker) Type() string {
+	return adapterType(c.adapter)
+}
+
+// Get things from the wrapped adapter, unless the circuit is open.
+func (c *CircuitBreaker) Get(ctx context.Context) ([]string, error) {
+	allowed, trial := c.allow()
+	if !allowed {
+		return nil, fmt.Errorf("circuitbreaker.get -> %w", ErrCircuitOpen)
+	}
+
+	things, err := c.adapter.Get(ctx)
+	c.record(err, trial)
+
+	if err != nil {
+		return nil, fmt.Errorf("circuitbreaker.get -> %w", err)
+	}
+
+	return things, nil
+}
+
+// Add things to the wrapped adapter, unless the circuit is open.
+func (c *CircuitBreaker) Add(ctx context.Context, things []string) error {
+	allowed, trial := c.allow()
+	if !allowed {
+		return fmt.Errorf("circuitbreaker.add -> %w", ErrCircuitOpen)
+	}
+
+	err := c.adapter.Add(ctx, things)
+	c.record(err, trial)
+
+	if err != nil {
+		return fmt.Errorf("circuitbreaker.add -> %w", err)
+	}
+
+	return nil
+}
+
+// Remove things from the wrapped adapter, unless the circuit is open.
+func (c *CircuitBreaker) Remove(ctx context.Context, things []string) error {
+	allowed, trial := c.allow()
+	if !allowed {
+		return fmt.Errorf("circuitbreaker.remove -> %w", ErrCircuitOpen)
+	}
+
+	err := c.adapter.Remove(ctx, things)
+	c.record(err, trial)
+
+	if err != nil {
+		return fmt.Errorf("circuitbreaker.remove -> %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package gosync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	adapter := NewMockAdapter(t)
+	breaker := NewCircuitBreaker(adapter, WithFailureThreshold(2), WithCooldown(time.Minute))
+
+	assert.Equal(t, CircuitClosed, breaker.State())
+	assert.Equal(t, 2, breaker.threshold)
+	assert.Equal(t, time.Minute, breaker.cooldown)
+	assert.Zero(t, breaker.Failures())
+	assert.Zero(t, adapter.Calls)
+}
+
+//nolint:funlen
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	testErr := errors.New("foo") //nolint:goerr113
+
+	t.Run("Opens after consecutive failures", func(t *testing.T) {
+		t.Parallel()
+
+		adapter := NewMockAdapter(t)
+		breaker := NewCircuitBreaker(adapter, WithFailureThreshold(2))
+
+		adapter.EXPECT().Get(ctx).Twice().Return(nil, testErr)
+
+		_, err := breaker.Get(ctx)
+		assert.ErrorIs(t, err, testErr)
+		assert.Equal(t, CircuitClosed, breaker.State())
+
+		_, err = breaker.Get(ctx)
+		assert.ErrorIs(t, err, testErr)
+		assert.Equal(t, CircuitOpen, breaker.State())
+
+		// The wrapped adapter should not be called again while the circuit is open.
+		_, err = breaker.Get(ctx)
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+
+		err = breaker.Add(ctx, []string{"foo"})
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+
+		err = breaker.Remove(ctx, []string{"foo"})
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+	})
+
+	t.Run("Success resets failures", func(t *testing.T) {
+		t.Parallel()
+
+		adapter := NewMockAdapter(t)
+		breaker := NewCircuitBreaker(adapter, WithFailureThreshold(2))
+
+		adapter.EXPECT().Add(ctx, []string{"foo"}).Once().Return(testErr)
+		adapter.EXPECT().Add(ctx, []string{"bar"}).Once().Return(nil)
+		adapter.EXPECT().Remove(ctx, []string{"foo"}).Once().Return(testErr)
+
+		assert.ErrorIs(t, breaker.Add(ctx, []string{"foo"}), testErr)
+		assert.Equal(t, 1, breaker.Failures())
+
+		assert.NoError(t, breaker.Add(ctx, []string{"bar"}))
+		assert.Zero(t, breaker.Failures())
+
+		assert.ErrorIs(t, breaker.Remove(ctx, []string{"foo"}), testErr)
+		assert.Equal(t, CircuitClosed, breaker.State())
+	})
+
+	t.Run("Half-open recovery", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+
+		adapter := NewMockAdapter(t)
+		breaker := NewCircuitBreaker(adapter, WithFailureThreshold(1), WithCooldown(time.Minute))
+		breaker.getTime = func() time.Time { return now }
+
+		adapter.EXPECT().Get(ctx).Once().Return(nil, testErr)
+		adapter.EXPECT().Get(ctx).Once().Return([]string{"foo"}, nil)
+
+		_, err := breaker.Get(ctx)
+		assert.ErrorIs(t, err, testErr)
+		assert.Equal(t, CircuitOpen, breaker.State())
+
+		// Still within the cooldown.
+		now = now.Add(30 * time.Second)
+		_, err = breaker.Get(ctx)
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+
+		// Cooldown has elapsed, so a trial call is allowed through.
+		now = now.Add(30 * time.Second)
+		assert.Equal(t, CircuitHalfOpen, breaker.State())
+
+		things, err := breaker.Get(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo"}, things)
+		assert.Equal(t, CircuitClosed, breaker.State())
+	})
+
+	t.Run("Half-open failure re-opens", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+
+		adapter := NewMockAdapter(t)
+		breaker := NewCircuitBreaker(adapter, WithFailureThreshold(3), WithCooldown(time.Minute))
+		breaker.getTime = func() time.Time { return now }
+
+		adapter.EXPECT().Get(ctx).Times(4).Return(nil, testErr)
+
+		for i := 0; i < 3; i++ {
+			_, _ = breaker.Get(ctx)
+		}
+
+		assert.Equal(t, CircuitOpen, breaker.State())
+
+		now = now.Add(time.Minute)
+
+		// A single failure in the half-open state should re-open the circuit.
+		_, err := breaker.Get(ctx)
+		assert.ErrorIs(t, err, testErr)
+		assert.Equal(t, CircuitOpen, breaker.State())
+
+		_, err = breaker.Get(ctx)
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+	})
+
+	t.Run("Half-open allows a single trial call", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+
+		adapter := NewMockAdapter(t)
+		breaker := NewCircuitBreaker(adapter, WithFailureThreshold(1), WithCooldown(time.Minute))
+		breaker.getTime = func() time.Time { return now }
+
+		adapter.EXPECT().Get(ctx).Once().Return(nil, testErr)
+
+		_, _ = breaker.Get(ctx)
+
+		now = now.Add(time.Minute)
+
+		// Other calls are short-circuited while the trial call is in progress.
+		adapter.EXPECT().Get(ctx).Run(func(_ context.Context) {
+			assert.ErrorIs(t, breaker.Add(ctx, []string{"foo"}), ErrCircuitOpen)
+		}).Return([]string{"foo"}, nil).Once()
+
+		_, err := breaker.Get(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, CircuitClosed, breaker.State())
+	})
+
+	t.Run("Cancellation and read-only errors aren't counted", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+
+		adapter := NewMockAdapter(t)
+		breaker := NewCircuitBreaker(adapter, WithFailureThreshold(1), WithCooldown(time.Minute))
+		breaker.getTime = func() time.Time { return now }
+
+		adapter.EXPECT().Get(ctx).Once().Return(nil, fmt.Errorf("foo -> %w", context.Canceled))
+		adapter.EXPECT().Add(ctx, []string{"foo"}).Once().Return(context.DeadlineExceeded)
+		adapter.EXPECT().Remove(ctx, []string{"foo"}).Once().Return(ErrReadOnly)
+
+		_, err := breaker.Get(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.ErrorIs(t, breaker.Add(ctx, []string{"foo"}), context.DeadlineExceeded)
+		assert.ErrorIs(t, breaker.Remove(ctx, []string{"foo"}), ErrReadOnly)
+
+		assert.Equal(t, CircuitClosed, breaker.State())
+		assert.Zero(t, breaker.Failures())
+
+		// A cancelled trial call doesn't re-open the circuit, and lets another trial call through.
+		adapter.EXPECT().Get(ctx).Once().Return(nil, testErr)
+
+		_, _ = breaker.Get(ctx)
+
+		now = now.Add(time.Minute)
+
+		adapter.EXPECT().Get(ctx).Once().Return(nil, context.Canceled)
+		adapter.EXPECT().Get(ctx).Once().Return([]string{"foo"}, nil)
+
+		_, err = breaker.Get(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, CircuitHalfOpen, breaker.State())
+
+		_, err = breaker.Get(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, CircuitClosed, breaker.State())
+	})
+}
+
+func TestCircuitBreaker_Namer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	// The wrapped adapter's name, read-only flag and type are used, rather than the CircuitBreaker's own.
+	breaker := NewCircuitBreaker(&namedMemory{NewMemory([]string{"foo"}), "test/named:foo"})
+
+	assert.Equal(t, "test/named:foo", breaker.Name())
+	assert.False(t, breaker.ReadOnly())
+	assert.Empty(t, breaker.Type())
+
+	result, err := New(NewMemory([]string{"foo"})).SyncWithResult(ctx, breaker)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "test/named:foo", result.Destination)
+
+	assert.Equal(t, "*gosync.Recorder", NewCircuitBreaker(NewRecorder()).Name())
+	assert.True(t, NewCircuitBreaker(readOnlyAdapter{NewRecorder()}).ReadOnly())
+	assert.Equal(t, TypeEmail, NewCircuitBreaker(typedAdapter{NewRecorder(), TypeEmail}).Type())
+
+	// A read-only wrapped adapter can't be used as a destination through the circuit breaker.
+	err = New(NewRecorder()).Validate(NewCircuitBreaker(readOnlyAdapter{NewRecorder()}))
+	assert.ErrorIs(t, err, ErrIncompatibleAdapters)
+}
@@ -0,0 +1,131 @@
+package gosync
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Ensure Composition fully satisfies the Adapter, ReadOnlyAdapter and Namer interfaces.
+var (
+	_ Adapter         = &Composition{}
+	_ ReadOnlyAdapter = &Composition{}
+	_ Namer           = &Composition{}
+)
+
+// Composition combines the things from two source adapters, e.g. an Opsgenie on-call schedule and a static list of
+// team leads. Things are matched case-insensitively and ignoring leading/trailing whitespace, like Sync does by
+// default. A composition can only be used as a source, so Add and Remove fail with ErrReadOnly.
+type Composition struct {
+	name    string // name is the kind of composition, e.g. union.
+	a, b    Adapter
+	combine func(a []string, b []string) []string // combine combines the deduplicated things from a and b.
+}
+
+// compositionIdentity returns the identity of a thing, used to match things between the composed adapters.
+func compositionIdentity(thing string) string {
+	return strings.ToLower(strings.TrimSpace(thing))
+}
+
+// identities returns the set of identities of things.
+func identities(things []string) map[string]bool {
+	out := make(map[string]bool, len(things))
+
+	for _, thing := range things {
+		out[compositionIdentity(thing)] = true
+	}
+
+	return out
+}
+
+// Union composes two adapters, so that Get returns the things in either of them. If a thing is in both, a's value is
+// returned.
+func Union(a Adapter, b Adapter) *Composition {
+	return &Composition{
+		name: "union",
+		a:    a,
+		b:    b,
+		combine: func(a []string, b []string) []string {
+			inA := identities(a)
+
+			for _, thing := range b {
+				if !inA[compositionIdentity(thing)] {
+					a = append(a, thing)
+				}
+			}
+
+			return a
+		},
+	}
+}
+
+// Intersect composes two adapters, so that Get returns the things in both of them, with a's values.
+func Intersect(a Adapter, b Adapter) *Composition {
+	return &Composition{
+		name: "intersect",
+		a:    a,
+		b:    b,
+		combine: func(a []string, b []string) []string {
+			inB := identities(b)
+			out := make([]string, 0, len(a))
+
+			for _, thing := range a {
+				if inB[compositionIdentity(thing)] {
+					out = append(out, thing)
+				}
+			}
+
+			return out
+		},
+	}
+}
+
+// Name returns the composition's name for logs and metrics, e.g. union(*oncall.OnCall, *gosync.Memory).
+func (c *Composition) Name() string {
+	return fmt.Sprintf("%s(%s, %s)", c.name, adapterName(c.a), adapterName(c.b))
+}
+
+// ReadOnly always returns true, as a composition can only be used as a source.
+func (c *Composition) ReadOnly() bool {
+	return true
+}
+
+// Get things from both adapters, and combine them. Duplicates are returned once.
+func (c *Composition) Get(ctx context.Context) ([]string, error) {
+	a, err := c.a.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("gosync.%s.get(%s) -> %w", c.name, adapterName(c.a), err)
+	}
+
+	b, err := c.b.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("gosync.%s.get(%s) -> %w", c.name, adapterName(c.b), err)
+	}
+
+	return c.combine(unique(a), unique(b)), nil
+}
+
+// unique returns things without duplicates, keeping the first of each.
+func unique(things []string) []string {
+	seen := make(map[string]bool, len(things))
+	out := make([]string, 0, len(things))
+
+	for _, thing := range things {
+		if !seen[compositionIdentity(thing)] {
+			seen[compositionIdentity(thing)] = true
+			out = append(out, thing)
+		}
+	}
+
+	return out
+}
+
+// Add always fails with ErrReadOnly, as a composition can only be used as a source.
+func (c *Composition) Add(_ context.Context, _ []string) error {
+	return fmt.Errorf("gosync.%s.add -> %w", c.name, ErrReadOnly)
+}
+
+// Remove always fails with ErrReadOnly, as a composition can only be used as a source.
+func (c *Composition) Remove(_ context.Context, _ []string) error {
+	return fmt.Errorf("gosync.%s.remove -> %w", c.name, ErrReadOnly)
+}
@@ -0,0 +1,112 @@
+package gosync
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+//nolint:funlen
+func TestComposition(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	for _, test := range []struct {
+		name      string
+		a, b      []string
+		union     []string
+		intersect []string
+	}{
+		{
+			name:      "Overlapping",
+			a:         []string{"bar@email", "foo@email"},
+			b:         []string{"baz@email", "Foo@Email "},
+			union:     []string{"bar@email", "foo@email", "baz@email"},
+			intersect: []string{"foo@email"},
+		},
+		{
+			name:      "Disjoint",
+			a:         []string{"foo@email"},
+			b:         []string{"bar@email"},
+			union:     []string{"foo@email", "bar@email"},
+			intersect: []string{},
+		},
+		{
+			name:      "Empty",
+			a:         []string{},
+			b:         []string{"bar@email", "bar@email"},
+			union:     []string{"bar@email"},
+			intersect: []string{},
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := NewMockAdapter(t)
+			b := NewMockAdapter(t)
+
+			a.EXPECT().Get(ctx).Return(test.a, nil).Twice()
+			b.EXPECT().Get(ctx).Return(test.b, nil).Twice()
+
+			union, err := Union(a, b).Get(ctx)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.union, union)
+
+			intersect, err := Intersect(a, b).Get(ctx)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.intersect, intersect)
+		})
+	}
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		testErr := errors.New("foo") //nolint:goerr113
+
+		a := NewMemory([]string{"foo@email"})
+		b := NewMockAdapter(t)
+
+		b.EXPECT().Get(ctx).Return(nil, testErr)
+
+		_, err := Union(a, b).Get(ctx)
+
+		assert.ErrorIs(t, err, testErr)
+		assert.ErrorContains(t, err, "gosync.union.get(*gosync.MockAdapter)")
+	})
+
+	t.Run("Read only", func(t *testing.T) {
+		t.Parallel()
+
+		a := NewMockAdapter(t)
+		b := NewMockAdapter(t)
+		composition := Intersect(a, b)
+
+		assert.True(t, composition.ReadOnly())
+		assert.Equal(t, "intersect(*gosync.MockAdapter, *gosync.MockAdapter)", composition.Name())
+		assert.ErrorIs(t, composition.Add(ctx, []string{"foo@email"}), ErrReadOnly)
+		assert.ErrorIs(t, composition.Remove(ctx, []string{"foo@email"}), ErrReadOnly)
+		assert.Zero(t, a.Calls)
+		assert.Zero(t, b.Calls)
+	})
+
+	t.Run("Source", func(t *testing.T) {
+		t.Parallel()
+
+		source := Union(NewMemory([]string{"foo@email"}), NewMemory([]string{"lead@email"}))
+		destination := NewMockAdapter(t)
+
+		destination.EXPECT().Get(mock.Anything).Return([]string{"foo@email", "old@email"}, nil)
+		destination.EXPECT().Remove(mock.Anything, []string{"old@email"}).Return(nil)
+		destination.EXPECT().Add(mock.Anything, []string{"lead@email"}).Return(nil)
+
+		assert.NoError(t, New(source).SyncWith(ctx, destination))
+	})
+}
@@ -0,0 +1,124 @@
+package gosync
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// WithConcurrency splits the things passed to each Add/Remove call into n chunks, and calls the adapter with each
+// chunk concurrently. This speeds up adapters that process things one at a time (e.g. with a rate limit per request),
+// but the adapter must be safe for concurrent use. Default is 1, so each adapter receives a single slice.
+func WithConcurrency(n int) func(*Sync) {
+	return func(sync *Sync) {
+		sync.concurrency = n
+	}
+}
+
+// chunk splits things into at most n contiguous chunks of similar size.
+func chunk(things []string, n int) [][]string {
+	if n > len(things) {
+		n = len(things)
+	}
+
+	chunks := make([][]string, 0, n)
+
+	for i := 0; i < n; i++ {
+		start, end := i*len(things)/n, (i+1)*len(things)/n
+		chunks = append(chunks, things[start:end])
+	}
+
+	return chunks
+}
+
+// execute calls executeFn with things, reporting progress and events as the adapter works through them. If concurrency
+// is set, things are split into chunks which are executed concurrently. The things that were successfully changed
+// are returned, even if some chunks failed.
+func (s *Sync) execute(
+	ctx context.Context,
+	action string,
+	things []string,
+	progress ProgressFunc,
+	executeFn func(context.Context, []string) error,
+) ([]string, error) {
+	if s.concurrency <= 1 || len(things) <= 1 {
+		progress, flush := appliedEvents(ctx, action, things, progress)
+
+		if err := executeFn(contextWithProgress(ctx, action, progress), things); err != nil {
+			recordEvent(ctx, EventErrored, action, "", err)
+
+			return nil, err
+		}
+
+		flush()
+
+		return things, nil
+	}
+
+	chunks := chunk(things, s.concurrency)
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		processed int
+		succeeded = make([]bool, len(chunks))
+		errs      = make([]error, len(chunks))
+	)
+
+	for i, chunkThings := range chunks {
+		wg.Add(1)
+
+		go func(i int, chunkThings []string) {
+			defer wg.Done()
+
+			// Translate the progress of each chunk into the progress of all things.
+			last := 0
+			chunkProgress := func(action string, chunkProcessed int, _ int) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				processed += chunkProcessed - last
+				last = chunkProcessed
+
+				progress(action, processed, len(things))
+			}
+
+			chunkProgress, flush := appliedEvents(ctx, action, chunkThings, chunkProgress)
+
+			if err := executeFn(contextWithProgress(ctx, action, chunkProgress), chunkThings); err != nil {
+				recordEvent(ctx, EventErrored, action, "", err)
+
+				errs[i] = err
+
+				return
+			}
+
+			flush()
+
+			succeeded[i] = true
+		}(i, chunkThings)
+	}
+
+	wg.Wait()
+
+	var (
+		changed  []string
+		failures []error
+	)
+
+	for i, chunkThings := range chunks {
+		if succeeded[i] {
+			changed = append(changed, chunkThings...)
+
+			continue
+		}
+
+		failures = append(failures, errs[i])
+	}
+
+	if len(failures) > 0 {
+		return changed, fmt.Errorf("%d of %d chunks failed: %w", len(failures), len(chunks), joinErrors(failures))
+	}
+
+	return changed, nil
+}
@@ -0,0 +1,155 @@
+package gosync
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// concurrentAdapter is a memoryAdapter that's safe for concurrent use, and records each call to Add.
+type concurrentAdapter struct {
+	mu     sync.Mutex
+	things memoryAdapter
+	calls  [][]string
+	fail   string // fail is a thing that causes Add to fail, before anything is added.
+
+	// failWith maps things to the error they cause Add to fail with, before anything is added.
+	failWith map[string]error
+}
+
+func (c *concurrentAdapter) Get(ctx context.Context) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.things.Get(ctx)
+}
+
+func (c *concurrentAdapter) Add(ctx context.Context, things []string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls = append(c.calls, things)
+
+	for _, thing := range things {
+		if thing == c.fail {
+			return errors.New("failed") //nolint:goerr113
+		}
+
+		if err, ok := c.failWith[thing]; ok {
+			return err
+		}
+	}
+
+	for index, thing := range things {
+		c.things[thing] = true
+
+		ReportProgress(ctx, index+1, len(things))
+	}
+
+	return nil
+}
+
+func (c *concurrentAdapter) Remove(ctx context.Context, things []string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.things.Remove(ctx, things)
+}
+
+func TestChunk(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, [][]string{{"a", "b"}, {"c", "d", "e"}}, chunk([]string{"a", "b", "c", "d", "e"}, 2))
+	assert.Equal(t, [][]string{{"a"}, {"b"}}, chunk([]string{"a", "b"}, 4))
+}
+
+//nolint:funlen
+func TestSync_WithConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Sequential by default", func(t *testing.T) {
+		t.Parallel()
+
+		destination := &concurrentAdapter{things: newMemoryAdapter()}
+
+		err := New(newMemoryAdapter("a", "b", "c")).SyncWith(ctx, destination)
+
+		assert.NoError(t, err)
+		assert.Len(t, destination.calls, 1)
+	})
+
+	t.Run("Chunked", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			mu       sync.Mutex
+			progress []int
+		)
+
+		destination := &concurrentAdapter{things: newMemoryAdapter()}
+		syncService := New(newMemoryAdapter("a", "b", "c", "d", "e", "f"), WithConcurrency(3),
+			WithProgress(func(_ string, processed int, total int) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				assert.Equal(t, 6, total)
+				progress = append(progress, processed)
+			}),
+		)
+
+		result, err := syncService.SyncWithResult(ctx, destination)
+
+		assert.NoError(t, err)
+		assert.Len(t, destination.calls, 3)
+
+		for _, call := range destination.calls {
+			assert.Len(t, call, 2)
+		}
+
+		assert.ElementsMatch(t, []string{"a", "b", "c", "d", "e", "f"}, result.Added)
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, progress)
+	})
+
+	t.Run("Partial failure", func(t *testing.T) {
+		t.Parallel()
+
+		destination := &concurrentAdapter{things: newMemoryAdapter(), fail: "a"}
+		syncService := New(newMemoryAdapter("a", "b", "c", "d"), WithConcurrency(2))
+
+		result, err := syncService.SyncWithResult(ctx, destination)
+
+		assert.ErrorContains(t, err, "1 of 2 chunks failed")
+
+		// The chunk that succeeded is still recorded.
+		sort.Strings(result.Added)
+
+		things, _ := destination.Get(ctx)
+		assert.Equal(t, things, result.Added)
+		assert.Len(t, result.Added, 2)
+	})
+
+	t.Run("Every chunk's error is returned", func(t *testing.T) {
+		t.Parallel()
+
+		errA := errors.New("a failed") //nolint:goerr113
+		errC := errors.New("c failed") //nolint:goerr113
+
+		destination := &concurrentAdapter{
+			things:   newMemoryAdapter(),
+			failWith: map[string]error{"a": errA, "c": errC},
+		}
+		syncService := New(newMemoryAdapter("a", "b", "c", "d"), WithConcurrency(2))
+
+		err := syncService.SyncWith(ctx, destination)
+
+		assert.ErrorContains(t, err, "2 of 2 chunks failed")
+		assert.ErrorIs(t, err, errA)
+		assert.ErrorIs(t, err, errC)
+	})
+}
@@ -0,0 +1,102 @@
+package gosync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// joinedError combines the failures of a sync that continued past them, as errors.Join isn't available in Go 1.18.
+// errors.Is and errors.As match any of the failures.
+type joinedError struct {
+	errs []error
+}
+
+// joinErrors combines errs into a single error, or returns nil if there aren't any.
+func joinErrors(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return &joinedError{errs: errs}
+}
+
+func (e *joinedError) Error() string {
+	messages := make([]string, 0, len(e.errs))
+	for _, err := range e.errs {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap returns the failures, for errors.Is and errors.As in Go 1.20 onwards.
+func (e *joinedError) Unwrap() []error {
+	return e.errs
+}
+
+// Is reports whether any of the failures match target.
+func (e *joinedError) Is(target error) bool {
+	for _, err := range e.errs {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// As finds the first failure that matches target.
+func (e *joinedError) As(target interface{}) bool {
+	for _, err := range e.errs {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// executeEach calls executeFn with each thing on its own, so that one failing thing doesn't stop the rest from being
+// changed. The things that were successfully changed are returned, with every failure combined into a single error.
+func (s *Sync) executeEach(
+	ctx context.Context,
+	action string,
+	things []string,
+	progress ProgressFunc,
+	executeFn func(context.Context, []string) error,
+) ([]string, error) {
+	var (
+		changed []string
+		errs    []error
+	)
+
+	// Progress is reported once per thing below, rather than by the adapter for a single thing.
+	ignoreProgress := func(string, int, int) {}
+
+	for index, thing := range things {
+		// Stop if the run has been cancelled, rather than failing every remaining thing.
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+
+			break
+		}
+
+		if _, err := s.execute(ctx, action, []string{thing}, ignoreProgress, executeFn); err != nil {
+			s.logger.Printf("Failed to %s %s, continuing with remaining things: %s", action, thing, err)
+
+			errs = append(errs, fmt.Errorf("%s -> %w", thing, err))
+		} else {
+			changed = append(changed, thing)
+		}
+
+		progress(action, index+1, len(things))
+	}
+
+	if len(errs) > 0 {
+		return changed, fmt.Errorf("%d of %d failed: %w", len(things)-len(changed), len(things), joinErrors(errs))
+	}
+
+	return changed, nil
+}
@@ -0,0 +1,130 @@
+package gosync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// thingError is an error about a thing, used to test errors.As.
+type thingError struct {
+	thing string
+}
+
+func (e *thingError) Error() string {
+	return "thing error: " + e.thing
+}
+
+func TestJoinErrors(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, joinErrors(nil))
+
+	var target *thingError
+
+	err := joinErrors([]error{
+		fmt.Errorf("foo -> %w", ErrReadOnly),
+		fmt.Errorf("bar -> %w", &thingError{thing: "bar"}),
+	})
+
+	assert.EqualError(t, err, "foo -> "+ErrReadOnly.Error()+"; bar -> thing error: bar")
+	assert.ErrorIs(t, err, ErrReadOnly)
+	assert.NotErrorIs(t, err, ErrCacheEmpty)
+	assert.True(t, errors.As(err, &target))
+	assert.Equal(t, "bar", target.thing)
+}
+
+//nolint:funlen
+func TestSync_ContinueOnError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Aborts by default", func(t *testing.T) {
+		t.Parallel()
+
+		destination := &concurrentAdapter{things: newMemoryAdapter(), fail: "b"}
+
+		result, err := New(newMemoryAdapter("a", "b", "c")).SyncWithResult(ctx, destination)
+
+		assert.Error(t, err)
+		assert.Empty(t, result.Added)
+		assert.Len(t, destination.calls, 1)
+	})
+
+	t.Run("Continues past failures", func(t *testing.T) {
+		t.Parallel()
+
+		destination := &concurrentAdapter{things: newMemoryAdapter(), fail: "b"}
+
+		sync := New(newMemoryAdapter("a", "b", "c"))
+		sync.ContinueOnError = true
+
+		result, err := sync.SyncWithResult(ctx, destination)
+
+		assert.ErrorContains(t, err, "1 of 3 failed: b -> failed")
+		assert.ElementsMatch(t, []string{"a", "c"}, result.Added)
+		assert.ElementsMatch(t, [][]string{{"a"}, {"b"}, {"c"}}, destination.calls)
+		assert.Len(t, result.Errors, 1)
+	})
+
+	t.Run("Continues with remaining operations", func(t *testing.T) {
+		t.Parallel()
+
+		destination := &concurrentAdapter{things: newMemoryAdapter("x"), fail: "b"}
+
+		sync := New(newMemoryAdapter("a", "b"))
+		sync.OperatingMode = AddRemove
+		sync.ContinueOnError = true
+
+		result, err := sync.SyncWithResult(ctx, destination)
+
+		assert.ErrorContains(t, err, "b -> failed")
+		assert.Equal(t, []string{"a"}, result.Added)
+		assert.Equal(t, []string{"x"}, result.Removed)
+	})
+
+	t.Run("Joins every failure", func(t *testing.T) {
+		t.Parallel()
+
+		destination := NewMemory(nil, WithReadOnly())
+
+		sync := New(NewMemory([]string{"a", "b"}))
+		sync.ContinueOnError = true
+
+		err := sync.SyncWith(ctx, destination)
+
+		assert.ErrorIs(t, err, ErrReadOnly)
+		assert.ErrorContains(t, err, "2 of 2 failed")
+		assert.ErrorContains(t, err, "a -> gosync.memory.add")
+		assert.ErrorContains(t, err, "b -> gosync.memory.add")
+	})
+
+	t.Run("Stops when cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		cancelled, cancel := context.WithCancel(ctx)
+
+		// Cancel the run while the second thing is being added.
+		destination := &concurrentAdapter{things: newMemoryAdapter()}
+		changes := 0
+
+		sync := New(newMemoryAdapter("a", "b", "c"), WithOnChange(func(ChangeEvent) {
+			if changes++; changes == 2 {
+				cancel()
+			}
+		}))
+		sync.ContinueOnError = true
+
+		result, err := sync.SyncWithResult(cancelled, destination)
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Len(t, result.Added, 2)
+		assert.Len(t, destination.calls, 2)
+
+		cancel()
+	})
+}
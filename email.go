@@ -0,0 +1,83 @@
+package gosync
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+)
+
+// NormaliseEmail parses an email address and returns it in a canonical form, without surrounding whitespace or a
+// display name, and with its domain lowercased, e.g. " Jane Doe <Jane@Example.COM>" becomes "Jane@example.com".
+// If the email can't be parsed, it returns an error wrapping ErrInvalidEmail.
+func NormaliseEmail(email string) (string, error) {
+	address, err := mail.ParseAddress(strings.TrimSpace(email))
+	if err != nil {
+		return "", fmt.Errorf("%w: %q (%v)", ErrInvalidEmail, email, err)
+	}
+
+	// The domain is case-insensitive, but the local part may not be.
+	index := strings.LastIndex(address.Address, "@")
+
+	return address.Address[:index] + strings.ToLower(address.Address[index:]), nil
+}
+
+// normaliseEmails normalises things with NormaliseEmail before they're passed to an adapter, if NormaliseEmails or
+// StrictEmails is set. Invalid emails are skipped with a warning, unless StrictEmails is set, in which case an error is
+// returned instead. Nothing is changed in dry run mode, so invalid emails are always skipped there.
+//
+// Things to remove come from the destination's own Get, so are validated but passed back as the destination returned
+// them, e.g. "Jane@Example.COM" rather than "Jane@example.com", so that they match the values it has stored.
+func (s *Sync) normaliseEmails(ctx context.Context, action string, things []string) ([]string, error) {
+	if !s.NormaliseEmails && !s.StrictEmails {
+		return things, nil
+	}
+
+	var (
+		out      = make([]string, 0, len(things))
+		original = make(map[string]string, len(things)) // original maps normalised emails to the things they came from.
+	)
+
+	var invalid []string
+
+	var errs []error
+
+	for _, thing := range things {
+		email, err := NormaliseEmail(thing)
+		if err != nil {
+			invalid = append(invalid, thing)
+			errs = append(errs, err)
+
+			continue
+		}
+
+		// Keep the first spelling of each email, as different spellings are only passed to the adapter once.
+		if _, ok := original[email]; !ok {
+			original[email] = thing
+		}
+
+		out = append(out, email)
+	}
+
+	if s.StrictEmails && !s.DryRun && len(errs) > 0 {
+		return nil, fmt.Errorf("normaliseemails -> %w", joinErrors(errs))
+	}
+
+	for index, thing := range invalid {
+		s.logger.Printf("Skipping invalid email to %s: %s", action, errs[index])
+
+		Warn(ctx, fmt.Errorf("sync.normaliseemails(%s) -> %w", action, errs[index]))
+		recordEvent(ctx, EventSkipped, action, thing, errs[index])
+	}
+
+	// Different spellings of the same email are only passed to the adapter once.
+	out = s.dedupe(out)
+
+	if action == "remove" {
+		for index, email := range out {
+			out[index] = original[email]
+		}
+	}
+
+	return out, nil
+}
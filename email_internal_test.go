@@ -0,0 +1,138 @@
+package gosync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormaliseEmail(t *testing.T) {
+	t.Parallel()
+
+	for input, expected := range map[string]string{
+		"jane@example.com":                "jane@example.com",
+		"  jane@example.com\t":            "jane@example.com",
+		"Jane <jane@example.com>":         "jane@example.com",
+		`"Doe, Jane" <Jane@Example.COM>`:  "Jane@example.com",
+		" <jane@example.com> ":            "jane@example.com",
+		"jane@example.com (Jane Doe)":     "jane@example.com",
+		"Jane Doe <jane.doe@example.com>": "jane.doe@example.com",
+	} {
+		email, err := NormaliseEmail(input)
+
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, email, input)
+	}
+
+	for _, input := range []string{"", "jane", "jane@", "Jane <jane@example.com", "a@example.com, b@example.com"} {
+		_, err := NormaliseEmail(input)
+
+		assert.ErrorIs(t, err, ErrInvalidEmail, input)
+	}
+}
+
+//nolint:funlen
+func TestSync_NormaliseEmails(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Off by default", func(t *testing.T) {
+		t.Parallel()
+
+		destination := NewMemory(nil)
+
+		_, err := New(NewMemory([]string{" Jane <jane@example.com>"})).SyncWithResult(ctx, destination)
+
+		assert.NoError(t, err)
+
+		things, _ := destination.Get(ctx)
+		assert.Equal(t, []string{" Jane <jane@example.com>"}, things)
+	})
+
+	t.Run("Normalises and skips invalid emails", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMemory([]string{" Jane <jane@example.com>", "  john@Example.com ", "not an email"})
+		destination := NewMemory([]string{"old@example.com"})
+
+		sync := New(source)
+		sync.NormaliseEmails = true
+		sync.Events = true
+
+		result, err := sync.SyncWithResult(ctx, destination)
+
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"jane@example.com", "john@example.com"}, result.Added)
+		assert.Equal(t, []string{"old@example.com"}, result.Removed)
+		assert.Len(t, result.Warnings, 1)
+		assert.ErrorIs(t, result.Warnings[0], ErrInvalidEmail)
+		var skipped []Event
+
+		for _, event := range result.Events {
+			if event.Type == EventSkipped {
+				skipped = append(skipped, event)
+			}
+		}
+
+		assert.Len(t, skipped, 1)
+		assert.Equal(t, "not an email", skipped[0].Thing)
+		assert.ErrorIs(t, skipped[0].Err, ErrInvalidEmail)
+
+		things, _ := destination.Get(ctx)
+		assert.Equal(t, []string{"jane@example.com", "john@example.com"}, things)
+	})
+
+	t.Run("Removes the destination's own values", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMemory([]string{"jane@example.com"})
+		destination := NewMemory([]string{"jane@example.com", "Old.User@Example.COM", "Leaver <leaver@EXAMPLE.com>"})
+
+		sync := New(source)
+		sync.NormaliseEmails = true
+
+		result, err := sync.SyncWithResult(ctx, destination)
+
+		// Removals are validated, but passed to Remove as the destination returned them, so they match its keys.
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"Old.User@Example.COM", "Leaver <leaver@EXAMPLE.com>"}, result.Removed)
+
+		things, _ := destination.Get(ctx)
+		assert.Equal(t, []string{"jane@example.com"}, things)
+	})
+
+	t.Run("Strict", func(t *testing.T) {
+		t.Parallel()
+
+		destination := NewMemory([]string{"old@example.com"})
+
+		sync := New(NewMemory([]string{"Jane <jane@example.com>", "not an email"}))
+		sync.StrictEmails = true
+		sync.OperatingMode = AddRemove
+
+		result, err := sync.SyncWithResult(ctx, destination)
+
+		assert.ErrorIs(t, err, ErrInvalidEmail)
+		assert.ErrorContains(t, err, `"not an email"`)
+		assert.Empty(t, result.Added)
+
+		things, _ := destination.Get(ctx)
+		assert.Equal(t, []string{"old@example.com"}, things)
+	})
+
+	t.Run("Strict in dry run mode", func(t *testing.T) {
+		t.Parallel()
+
+		sync := New(NewMemory([]string{"Jane <jane@example.com>", "not an email"}))
+		sync.StrictEmails = true
+		sync.DryRun = true
+
+		result, err := sync.SyncWithResult(ctx, NewMemory(nil))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"jane@example.com"}, result.WouldAdd)
+		assert.Len(t, result.Warnings, 1)
+	})
+}
@@ -10,3 +10,18 @@ var ErrCacheEmpty = errors.New("cache is empty, run Get first")
 
 // ErrReadOnly is returned for adapters that cannot Add/Remove, but have been set as a destination.
 var ErrReadOnly = errors.New("cannot perform action, adapter is readonly")
+
+// ErrCircuitOpen is returned by CircuitBreaker when the wrapped adapter has failed too many times in a row.
+var ErrCircuitOpen = errors.New("circuit is open, adapter has failed too many times")
+
+// ErrMissingEnv is returned when an adapter is built from environment variables, but required variables aren't set.
+var ErrMissingEnv = errors.New("missing required environment variables")
+
+// ErrUnsafeRemoval is returned when a sync refuses to remove things, as doing so would likely lose data.
+var ErrUnsafeRemoval = errors.New("refusing to remove things, as this would likely lose data")
+
+// ErrIncompatibleAdapters is returned by Validate when two adapters can't be synchronised with each other.
+var ErrIncompatibleAdapters = errors.New("adapters are incompatible")
+
+// ErrInvalidEmail is returned when a thing can't be parsed as an email address.
+var ErrInvalidEmail = errors.New("invalid email address")
@@ -0,0 +1,115 @@
+package gosync
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// eventType is the kind of step recorded by an Event.
+type eventType string
+
+const (
+	// EventResolved is recorded for each thing Sync has determined needs adding or removing.
+	EventResolved eventType = "Resolved"
+	// EventAdded is recorded once a thing has been added to the destination.
+	EventAdded eventType = "Added"
+	// EventRemoved is recorded once a thing has been removed from the destination.
+	EventRemoved eventType = "Removed"
+	// EventSkipped is recorded for a thing that would have changed, but didn't, e.g. in dry run mode.
+	EventSkipped eventType = "Skipped"
+	// EventErrored is recorded when an Add/Remove call fails. Things applied before the failure have already been
+	// recorded, so Thing is empty.
+	EventErrored eventType = "Errored"
+)
+
+// Event is a single step of a sync run, used with Sync.Events to replay a run step by step.
+type Event struct {
+	Time   time.Time // Time the step happened.
+	Type   eventType // Type of the step.
+	Action string    // Action Sync was performing, i.e. add or remove.
+	Thing  string    // Thing the step happened to, if any.
+	Err    error     // Err is set for EventErrored, and for invalid emails that are skipped.
+}
+
+// eventsKey is the context key used to store the events of a sync run.
+type eventsKey struct{}
+
+// events is a concurrency-safe, time-ordered collection of events.
+type events struct {
+	mu   sync.Mutex
+	list []Event
+}
+
+// contextWithEvents returns a copy of ctx that records events.
+func contextWithEvents(ctx context.Context) context.Context {
+	return context.WithValue(ctx, eventsKey{}, &events{})
+}
+
+// recordEvent records an event against the sync run in ctx. If ctx doesn't record events, it's discarded.
+func recordEvent(ctx context.Context, typ eventType, action string, thing string, err error) {
+	if collector, ok := ctx.Value(eventsKey{}).(*events); ok {
+		collector.mu.Lock()
+		defer collector.mu.Unlock()
+
+		collector.list = append(collector.list, Event{
+			Time:   time.Now(),
+			Type:   typ,
+			Action: action,
+			Thing:  thing,
+			Err:    err,
+		})
+	}
+}
+
+// getEvents returns the events recorded so far against the sync run in ctx.
+func getEvents(ctx context.Context) []Event {
+	if collector, ok := ctx.Value(eventsKey{}).(*events); ok {
+		collector.mu.Lock()
+		defer collector.mu.Unlock()
+
+		return append([]Event(nil), collector.list...)
+	}
+
+	return nil
+}
+
+// appliedEvents returns a ProgressFunc that records an event for each thing as the adapter reports it processed,
+// followed by next. Once the adapter has succeeded, the returned flush function records any things left over, for
+// adapters that don't report progress.
+func appliedEvents(
+	ctx context.Context,
+	action string,
+	things []string,
+	next ProgressFunc,
+) (ProgressFunc, func()) {
+	typ := EventAdded
+	if action == "remove" {
+		typ = EventRemoved
+	}
+
+	var (
+		mu      sync.Mutex
+		applied int
+	)
+
+	record := func(processed int) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		for ; applied < processed && applied < len(things); applied++ {
+			recordEvent(ctx, typ, action, things[applied], nil)
+		}
+	}
+
+	progress := func(action string, processed int, total int) {
+		record(processed)
+		next(action, processed, total)
+	}
+
+	flush := func() {
+		record(len(things))
+	}
+
+	return progress, flush
+}
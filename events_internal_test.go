@@ -0,0 +1,114 @@
+package gosync
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// eventSteps returns the type, action and thing of each event, to compare them without timestamps.
+func eventSteps(events []Event) [][3]string {
+	out := make([][3]string, 0, len(events))
+
+	for _, event := range events {
+		out = append(out, [3]string{string(event.Type), event.Action, event.Thing})
+	}
+
+	return out
+}
+
+//nolint:funlen
+func TestSync_Events(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Apply order", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		syncService := New(source)
+		syncService.Events = true
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo", "bar"}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{"baz"}, nil)
+		destination.EXPECT().Remove(mock.Anything, []string{"baz"}).Once().Return(nil)
+		destination.EXPECT().Add(mock.Anything, mock.Anything).Run(func(ctx context.Context, things []string) {
+			// Report progress for the first thing only, leaving the rest to be recorded once Add returns.
+			ReportProgress(ctx, 1, len(things))
+		}).Return(nil).Once()
+
+		result, err := syncService.SyncWithResult(ctx, destination)
+
+		assert.NoError(t, err)
+
+		added := result.Added
+		assert.Equal(t, [][3]string{
+			{"Resolved", "remove", "baz"},
+			{"Removed", "remove", "baz"},
+			{"Resolved", "add", added[0]},
+			{"Resolved", "add", added[1]},
+			{"Added", "add", added[0]},
+			{"Added", "add", added[1]},
+		}, eventSteps(result.Events))
+
+		for i := 1; i < len(result.Events); i++ {
+			assert.False(t, result.Events[i].Time.Before(result.Events[i-1].Time))
+		}
+	})
+
+	t.Run("Errored", func(t *testing.T) {
+		t.Parallel()
+
+		testErr := errors.New("foo") //nolint:goerr113
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		syncService := New(source)
+		syncService.Events = true
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+		destination.EXPECT().Remove(mock.Anything, []string{"foo"}).Once().Return(testErr)
+
+		result, err := syncService.SyncWithResult(ctx, destination)
+
+		assert.ErrorIs(t, err, testErr)
+		assert.Equal(t, [][3]string{
+			{"Resolved", "remove", "foo"},
+			{"Errored", "remove", ""},
+		}, eventSteps(result.Events))
+		assert.ErrorIs(t, result.Events[1].Err, testErr)
+	})
+
+	t.Run("Skipped in dry run mode", func(t *testing.T) {
+		t.Parallel()
+
+		syncService := New(newMemoryAdapter("foo"))
+		syncService.DryRun = true
+		syncService.Events = true
+
+		result, err := syncService.SyncWithResult(ctx, newMemoryAdapter())
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][3]string{
+			{"Resolved", "add", "foo"},
+			{"Skipped", "add", "foo"},
+		}, eventSteps(result.Events))
+	})
+
+	t.Run("Disabled by default", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := New(newMemoryAdapter("foo")).SyncWithResult(ctx, newMemoryAdapter("bar"))
+
+		assert.NoError(t, err)
+		assert.Nil(t, result.Events)
+	})
+}
@@ -0,0 +1,157 @@
+package gosync
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Ensure Filter fully satisfies the Adapter, Namer, ReadOnlyAdapter and TypedAdapter interfaces.
+var (
+	_ Adapter         = &Filter{}
+	_ Namer           = &Filter{}
+	_ ReadOnlyAdapter = &Filter{}
+	_ TypedAdapter    = &Filter{}
+)
+
+// Filter wraps an adapter, and hides things that should never be managed by Go Sync, e.g. service accounts or
+// break-glass admins. Filtered things are dropped from the results of Get and the things passed to Add/Remove, so they
+// are never added or removed, whatever the other adapter contains.
+type Filter struct {
+	adapter Adapter  // The wrapped adapter.
+	allow   []string // allow patterns, of which a thing must match at least one if any are set.
+	deny    []string // deny patterns, which a thing must match none of.
+}
+
+// WithAllow only lets through things matching at least one of the patterns. Patterns are exact matches or globs
+// (e.g. *@example.com, see path.Match), and are case-insensitive.
+func WithAllow(patterns ...string) func(*Filter) {
+	return func(filter *Filter) {
+		filter.allow = append(filter.allow, normalisePatterns(patterns)...)
+	}
+}
+
+// WithDeny drops things matching any of the patterns, even if they're allowed by WithAllow. Patterns are exact matches
+// or globs (e.g. break-glass-*@example.com, see path.Match), and are case-insensitive.
+func WithDeny(patterns ...string) func(*Filter) {
+	return func(filter *Filter) {
+		filter.deny = append(filter.deny, normalisePatterns(patterns)...)
+	}
+}
+
+// NewFilter wraps an adapter, filtering the things it gets, adds and removes.
+func NewFilter(adapter Adapter, optsFn ...func(*Filter)) *Filter {
+	filter := &Filter{
+		adapter: adapter,
+		allow:   nil,
+		deny:    nil,
+	}
+
+	for _, fn := range optsFn {
+		fn(filter)
+	}
+
+	return filter
+}
+
+// normalisePatterns lowercases and trims patterns, so they match things case-insensitively.
+func normalisePatterns(patterns []string) []string {
+	out := make([]string, 0, len(patterns))
+
+	for _, pattern := range patterns {
+		out = append(out, strings.ToLower(strings.TrimSpace(pattern)))
+	}
+
+	return out
+}
+
+// matchesAny returns true if thing matches any of the patterns. Malformed globs only match exactly.
+func matchesAny(patterns []string, thing string) bool {
+	for _, pattern := range patterns {
+		if ok, err := path.Match(pattern, thing); ok || (err != nil && pattern == thing) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// allowed returns true if a thing passes the filter.
+func (f *Filter) allowed(thing string) bool {
+	normalised := strings.ToLower(strings.TrimSpace(thing))
+
+	if matchesAny(f.deny, normalised) {
+		return false
+	}
+
+	return len(f.allow) == 0 || matchesAny(f.allow, normalised)
+}
+
+// apply returns the things that pass the filter.
+func (f *Filter) apply(things []string) []string {
+	out := make([]string, 0, len(things))
+
+	for _, thing := range things {
+		if f.allowed(thing) {
+			out = append(out, thing)
+		}
+	}
+
+	return out
+}
+
+// Name returns the wrapped adapter's name, so a filtered destination keeps its label in logs, metrics and results.
+func (f *Filter) Name() string {
+	return adapterName(f.adapter)
+}
+
+// ReadOnly returns true if the wrapped adapter is read-only, as filtering doesn't change what it can do.
+func (f *Filter) ReadOnly() bool {
+	return isReadOnly(f.adapter)
+}
+
+// Type returns the type of things the wrapped adapter synchronises, if it declares one.
+func (f *Filter) Type() string {
+	return adapterType(f.adapter)
+}
+
+// Get things from the wrapped adapter, without those that are filtered.
+func (f *Filter) Get(ctx context.Context) ([]string, error) {
+	things, err := f.adapter.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("filter.get -> %w", err)
+	}
+
+	return f.apply(things), nil
+}
+
+// Add things to the wrapped adapter, without those that are filtered. If every thing is filtered, the wrapped adapter
+// isn't called.
+func (f *Filter) Add(ctx context.Context, things []string) error {
+	things = f.apply(things)
+	if len(things) == 0 {
+		return nil
+	}
+
+	if err := f.adapter.Add(ctx, things); err != nil {
+		return fmt.Errorf("filter.add -> %w", err)
+	}
+
+	return nil
+}
+
+// Remove things from the wrapped adapter, without those that are filtered. If every thing is filtered, the wrapped
+// adapter isn't called.
+func (f *Filter) Remove(ctx context.Context, things []string) error {
+	things = f.apply(things)
+	if len(things) == 0 {
+		return nil
+	}
+
+	if err := f.adapter.Remove(ctx, things); err != nil {
+		return fmt.Errorf("filter.remove -> %w", err)
+	}
+
+	return nil
+}
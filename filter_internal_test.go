@@ -0,0 +1,129 @@
+package gosync
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//nolint:funlen
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Patterns", func(t *testing.T) {
+		t.Parallel()
+
+		filter := NewFilter(NewMemory(nil),
+			WithAllow("*@example.com", "contractor@partner.io"),
+			WithDeny("break-glass-*@example.com", " Svc-Deploy@Example.com", "[bad"),
+		)
+
+		assert.True(t, filter.allowed("foo@example.com"))
+		assert.True(t, filter.allowed("Contractor@Partner.io"))
+		assert.False(t, filter.allowed("foo@partner.io"))
+		assert.False(t, filter.allowed("break-glass-1@example.com"))
+		assert.False(t, filter.allowed("svc-deploy@example.com"))
+		assert.True(t, NewFilter(NewMemory(nil), WithDeny("[bad")).allowed("bad"))
+		assert.False(t, NewFilter(NewMemory(nil), WithDeny("[bad")).allowed("[bad"))
+	})
+
+	t.Run("Denied things are never provisioned", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMemory([]string{"foo@example.com", "admin@example.com"})
+		destination := NewMemory([]string{"bar@example.com"})
+		deny := WithDeny("admin@example.com")
+
+		// Filtering the destination alone stops the denied thing from being added.
+		assert.NoError(t, New(source).SyncWith(ctx, NewFilter(destination, deny)))
+
+		things, _ := destination.Get(ctx)
+		assert.Equal(t, []string{"foo@example.com"}, things)
+
+		// Filtering the source too keeps the denied thing out of the Result.
+		otherDestination := NewMemory(nil)
+		result, err := New(NewFilter(source, deny)).SyncWithResult(ctx, NewFilter(otherDestination, deny))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@example.com"}, result.Added)
+
+		things, _ = otherDestination.Get(ctx)
+		assert.Equal(t, []string{"foo@example.com"}, things)
+	})
+
+	t.Run("Denied things are never removed", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMemory([]string{"foo@example.com"})
+		destination := NewMemory([]string{"foo@example.com", "break-glass@example.com", "bar@example.com"})
+
+		result, err := New(source).SyncWithResult(ctx, NewFilter(destination, WithDeny("break-glass*")))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"bar@example.com"}, result.Removed)
+
+		things, _ := destination.Get(ctx)
+		assert.Equal(t, []string{"break-glass@example.com", "foo@example.com"}, things)
+	})
+
+	t.Run("Add and remove", func(t *testing.T) {
+		t.Parallel()
+
+		adapter := NewMockAdapter(t)
+		filter := NewFilter(adapter, WithDeny("admin@example.com"))
+
+		adapter.EXPECT().Add(ctx, []string{"foo@example.com"}).Once().Return(nil)
+		adapter.EXPECT().Remove(ctx, []string{"bar@example.com"}).Once().Return(nil)
+
+		assert.NoError(t, filter.Add(ctx, []string{"foo@example.com", "admin@example.com"}))
+		assert.NoError(t, filter.Remove(ctx, []string{"admin@example.com", "bar@example.com"}))
+
+		// The adapter isn't called if every thing is filtered.
+		assert.NoError(t, filter.Add(ctx, []string{"admin@example.com"}))
+		assert.NoError(t, filter.Remove(ctx, []string{"admin@example.com"}))
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+
+		testErr := errors.New("foo") //nolint:goerr113
+
+		adapter := NewMockAdapter(t)
+		filter := NewFilter(adapter)
+
+		adapter.EXPECT().Get(ctx).Once().Return(nil, testErr)
+		adapter.EXPECT().Add(ctx, []string{"foo"}).Once().Return(testErr)
+
+		_, err := filter.Get(ctx)
+		assert.ErrorIs(t, err, testErr)
+		assert.ErrorIs(t, filter.Add(ctx, []string{"foo"}), testErr)
+	})
+}
+
+func TestFilter_Namer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	destination := NewMemory(nil)
+	filter := NewFilter(&namedMemory{destination, "test/named:foo"}, WithDeny("bar"))
+
+	assert.Equal(t, "test/named:foo", filter.Name())
+	assert.False(t, filter.ReadOnly())
+	assert.Empty(t, filter.Type())
+
+	result, err := New(NewMemory([]string{"foo", "bar"})).SyncWithResult(ctx, filter)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "test/named:foo", result.Destination)
+
+	things, _ := destination.Get(ctx)
+	assert.Equal(t, []string{"foo"}, things)
+
+	assert.True(t, NewFilter(readOnlyAdapter{NewRecorder()}).ReadOnly())
+	assert.Equal(t, TypeEmail, NewFilter(typedAdapter{NewRecorder(), TypeEmail}).Type())
+}
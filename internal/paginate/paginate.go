@@ -0,0 +1,44 @@
+/*
+Package paginate provides helpers for adapters that talk to paginated APIs.
+*/
+package paginate
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrRepeatedCursor is returned when an API returns the cursor it was just given, which would otherwise loop forever.
+var ErrRepeatedCursor = errors.New("api returned the same cursor")
+
+// Collect calls fn for each page of a cursor-paginated API, and returns the items from every page. fn is first called
+// with an empty cursor, and then with the next cursor it returned, until it returns an empty one.
+//
+// To stop early, e.g. once the item you're looking for has been found, return an empty next cursor. If fn fails, the
+// items collected so far are returned with the error.
+func Collect[T any](fn func(cursor string) (items []T, next string, err error)) ([]T, error) {
+	var (
+		collected []T
+		cursor    string
+	)
+
+	for page := 1; ; page++ {
+		items, next, err := fn(cursor)
+
+		collected = append(collected, items...)
+
+		if err != nil {
+			return collected, fmt.Errorf("page %d -> %w", page, err)
+		}
+
+		if next == "" {
+			return collected, nil
+		}
+
+		if next == cursor {
+			return collected, fmt.Errorf("page %d(%s) -> %w", page, cursor, ErrRepeatedCursor)
+		}
+
+		cursor = next
+	}
+}
@@ -0,0 +1,83 @@
+package paginate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//nolint:funlen
+func TestCollect(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Multiple pages", func(t *testing.T) {
+		t.Parallel()
+
+		pages := map[string]struct {
+			items []string
+			next  string
+		}{
+			"":  {[]string{"foo", "bar"}, "2"},
+			"2": {[]string{"baz"}, "3"},
+			"3": {nil, ""},
+		}
+
+		var cursors []string
+
+		items, err := Collect(func(cursor string) ([]string, string, error) {
+			cursors = append(cursors, cursor)
+
+			return pages[cursor].items, pages[cursor].next, nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo", "bar", "baz"}, items)
+		assert.Equal(t, []string{"", "2", "3"}, cursors)
+	})
+
+	t.Run("Single page", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+
+		items, err := Collect(func(cursor string) ([]int, string, error) {
+			calls++
+
+			return []int{1, 2}, "", nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, items)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Error mid-pagination", func(t *testing.T) {
+		t.Parallel()
+
+		testErr := errors.New("foo") //nolint:goerr113
+
+		items, err := Collect(func(cursor string) ([]string, string, error) {
+			if cursor == "" {
+				return []string{"foo"}, "2", nil
+			}
+
+			return nil, "", testErr
+		})
+
+		assert.ErrorIs(t, err, testErr)
+		assert.ErrorContains(t, err, "page 2")
+		assert.Equal(t, []string{"foo"}, items, "the items collected before the error are returned")
+	})
+
+	t.Run("Repeated cursor", func(t *testing.T) {
+		t.Parallel()
+
+		items, err := Collect(func(cursor string) ([]string, string, error) {
+			return []string{"foo"}, "same", nil
+		})
+
+		assert.ErrorIs(t, err, ErrRepeatedCursor)
+		assert.Equal(t, []string{"foo", "foo"}, items)
+	})
+}
@@ -0,0 +1,100 @@
+package gosync
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// JournalEntry is a single removal recorded in the removal journal, written as a line of JSON.
+type JournalEntry struct {
+	Time        time.Time `json:"time"`        // Time the thing was about to be removed.
+	Action      string    `json:"action"`      // Action being performed, i.e. remove or remove from source.
+	Thing       string    `json:"thing"`       // Thing being removed, e.g. an email.
+	Destination string    `json:"destination"` // Destination is the name of the adapter, as in Result.Destination.
+}
+
+// removalJournal appends a JournalEntry to a writer for each thing removed. Writes are serialised, as adapters may be
+// called concurrently with WithConcurrency.
+type removalJournal struct {
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+// WithRemovalJournal appends a line of JSON (a JournalEntry) to journal for each thing, immediately before it's passed
+// to an adapter's Remove method, e.g. to an append-only file, as a durable record of everything removed. Unlike
+// WithOnChange, the journal is written before the adapter is called, so if it can't be written the removal fails.
+// Each write is flushed (and synced, for files) before Remove is called, so the journal is complete even if the sync
+// fails later on. Nothing is written in dry run mode.
+func WithRemovalJournal(journal io.Writer) func(*Sync) {
+	return func(sync *Sync) {
+		sync.journal = newRemovalJournal(journal)
+	}
+}
+
+func newRemovalJournal(writer io.Writer) *removalJournal {
+	return &removalJournal{mu: sync.Mutex{}, writer: writer}
+}
+
+// write appends an entry for each thing to the journal, then flushes it.
+func (j *removalJournal) write(action string, destination string, things []string) error {
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	now := time.Now()
+
+	for _, thing := range things {
+		entry := JournalEntry{Time: now, Action: action, Thing: thing, Destination: destination}
+
+		if err := encoder.Encode(entry); err != nil {
+			return fmt.Errorf("encode(%s) -> %w", thing, err)
+		}
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if _, err := j.writer.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("write -> %w", err)
+	}
+
+	if flusher, ok := j.writer.(interface{ Flush() error }); ok {
+		if err := flusher.Flush(); err != nil {
+			return fmt.Errorf("flush -> %w", err)
+		}
+	}
+
+	if syncer, ok := j.writer.(interface{ Sync() error }); ok {
+		if err := syncer.Sync(); err != nil {
+			return fmt.Errorf("sync -> %w", err)
+		}
+	}
+
+	return nil
+}
+
+// journalRemovals wraps an adapter's Remove method, so each thing is written to the removal journal immediately
+// before executeFn is called with it.
+func (s *Sync) journalRemovals(
+	adapter Adapter,
+	action string,
+	executeFn func(context.Context, []string) error,
+) func(context.Context, []string) error {
+	if s.journal == nil {
+		return executeFn
+	}
+
+	destination := adapterName(adapter)
+
+	return func(ctx context.Context, things []string) error {
+		if err := s.journal.write(action, destination, things); err != nil {
+			return fmt.Errorf("journal -> %w", err)
+		}
+
+		return executeFn(ctx, things)
+	}
+}
@@ -0,0 +1,143 @@
+package gosync
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// failingWriter is an io.Writer that always fails.
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("journal is unavailable") //nolint:goerr113
+}
+
+// journalEntries decodes each line of a removal journal.
+func journalEntries(t *testing.T, journal []byte) []JournalEntry {
+	t.Helper()
+
+	var entries []JournalEntry
+
+	decoder := json.NewDecoder(bytes.NewReader(journal))
+	for decoder.More() {
+		var entry JournalEntry
+
+		assert.NoError(t, decoder.Decode(&entry))
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+//nolint:funlen
+func TestWithRemovalJournal(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Before each removal", func(t *testing.T) {
+		t.Parallel()
+
+		var journal bytes.Buffer
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		syncService := New(source, WithRemovalJournal(&journal))
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{"bar", "baz"}, nil)
+		destination.EXPECT().Remove(mock.Anything, mock.Anything).Run(func(context.Context, []string) {
+			// The removals are journaled before the adapter is called.
+			assert.Len(t, journalEntries(t, journal.Bytes()), 2)
+		}).Return(nil).Once()
+		destination.EXPECT().Add(mock.Anything, []string{"foo"}).Once().Return(nil)
+
+		assert.NoError(t, syncService.SyncWith(ctx, destination))
+
+		entries := journalEntries(t, journal.Bytes())
+
+		assert.Len(t, entries, 2)
+		assert.ElementsMatch(t, []string{"bar", "baz"}, []string{entries[0].Thing, entries[1].Thing})
+
+		for _, entry := range entries {
+			assert.Equal(t, "remove", entry.Action)
+			assert.Equal(t, "*gosync.MockAdapter", entry.Destination)
+			assert.False(t, entry.Time.IsZero())
+		}
+	})
+
+	t.Run("Flushed when the sync fails", func(t *testing.T) {
+		t.Parallel()
+
+		var journal bytes.Buffer
+
+		testErr := errors.New("foo") //nolint:goerr113
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		syncService := New(source, WithRemovalJournal(bufio.NewWriter(&journal)))
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{"bar"}, nil)
+		destination.EXPECT().Remove(mock.Anything, []string{"bar"}).Once().Return(testErr)
+
+		assert.ErrorIs(t, syncService.SyncWith(ctx, destination), testErr)
+		assert.Equal(t, []string{"bar"}, []string{journalEntries(t, journal.Bytes())[0].Thing})
+	})
+
+	t.Run("Journal unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		syncService := New(source, WithRemovalJournal(failingWriter{}))
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{"bar"}, nil)
+
+		// Nothing is removed without a record of it.
+		assert.ErrorContains(t, syncService.SyncWith(ctx, destination), "journal is unavailable")
+	})
+
+	t.Run("Dry run", func(t *testing.T) {
+		t.Parallel()
+
+		var journal bytes.Buffer
+
+		syncService := New(NewMemory(nil), WithRemovalJournal(&journal))
+		syncService.DryRun = true
+
+		assert.NoError(t, syncService.SyncWith(ctx, NewMemory([]string{"bar"})))
+		assert.Empty(t, journal.Bytes())
+	})
+
+	t.Run("Bidirectional", func(t *testing.T) {
+		t.Parallel()
+
+		var journal bytes.Buffer
+
+		source := NewMemory([]string{"foo", "bar"})
+
+		syncService := New(source, WithRemovalJournal(&journal))
+		syncService.ConflictPolicy = RemoveUnshared
+
+		assert.NoError(t, syncService.SyncBidirectional(ctx, NewMemory([]string{"foo", "baz"})))
+
+		removed := map[string]string{}
+		for _, entry := range journalEntries(t, journal.Bytes()) {
+			removed[entry.Action] = entry.Thing
+		}
+
+		assert.Equal(t, map[string]string{"remove": "baz", "remove from source": "bar"}, removed)
+	})
+}
@@ -0,0 +1,30 @@
+package gosync
+
+import (
+	"log"
+	"strings"
+)
+
+// StructuredLogger is a logger that accepts key-value pairs alongside each message. It's satisfied by *slog.Logger,
+// so structured logging pipelines can be used without Go Sync depending on log/slog.
+type StructuredLogger interface {
+	Info(msg string, args ...any)
+}
+
+// structuredWriter passes each line written by a *log.Logger to a StructuredLogger.
+type structuredWriter struct {
+	logger StructuredLogger
+	args   []any
+}
+
+func (w structuredWriter) Write(p []byte) (int, error) {
+	w.logger.Info(strings.TrimSuffix(string(p), "\n"), w.args...)
+
+	return len(p), nil
+}
+
+// NewLogLogger returns a *log.Logger for use with WithLogger, which passes each line to a StructuredLogger (e.g. a
+// *slog.Logger) along with a fixed set of key-value pairs, e.g. the adapter's name.
+func NewLogLogger(logger StructuredLogger, args ...any) *log.Logger {
+	return log.New(structuredWriter{logger: logger, args: args}, "", 0)
+}
@@ -0,0 +1,31 @@
+package gosync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// structuredRecorder is a StructuredLogger that records each message and its key-value pairs.
+type structuredRecorder struct {
+	messages []string
+	args     [][]any
+}
+
+func (s *structuredRecorder) Info(msg string, args ...any) {
+	s.messages = append(s.messages, msg)
+	s.args = append(s.args, args)
+}
+
+func TestNewLogLogger(t *testing.T) {
+	t.Parallel()
+
+	recorder := &structuredRecorder{}
+	logger := NewLogLogger(recorder, "adapter", "test")
+
+	logger.Printf("Added %d things", 2)
+	logger.Println("Finished")
+
+	assert.Equal(t, []string{"Added 2 things", "Finished"}, recorder.messages)
+	assert.Equal(t, [][]any{{"adapter", "test"}, {"adapter", "test"}}, recorder.args)
+}
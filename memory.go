@@ -0,0 +1,103 @@
+package gosync
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Ensure Memory fully satisfies the Adapter and ReadOnlyAdapter interfaces.
+var (
+	_ Adapter         = &Memory{}
+	_ ReadOnlyAdapter = &Memory{}
+)
+
+// Memory is an in-memory adapter, for testing real sync flows without mocking any services. It's safe for concurrent
+// use.
+type Memory struct {
+	things   map[string]struct{}
+	readOnly bool
+	mu       sync.Mutex
+}
+
+// WithReadOnly makes Add and Remove fail with ErrReadOnly, to simulate a read-only source.
+func WithReadOnly() func(*Memory) {
+	return func(memory *Memory) {
+		memory.readOnly = true
+	}
+}
+
+// NewMemory creates a new Memory adapter, seeded with things.
+func NewMemory(things []string, optsFn ...func(*Memory)) *Memory {
+	memory := &Memory{
+		things:   make(map[string]struct{}, len(things)),
+		readOnly: false,
+	}
+
+	for _, thing := range things {
+		memory.things[thing] = struct{}{}
+	}
+
+	for _, fn := range optsFn {
+		fn(memory)
+	}
+
+	return memory
+}
+
+// ReadOnly returns true if the adapter was created with WithReadOnly.
+func (m *Memory) ReadOnly() bool {
+	return m.readOnly
+}
+
+// Get returns the things in memory, sorted so that the order is stable between calls.
+func (m *Memory) Get(_ context.Context) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := make([]string, 0, len(m.things))
+	for thing := range m.things {
+		out = append(out, thing)
+	}
+
+	sort.Strings(out)
+
+	return out, nil
+}
+
+// Add things to memory.
+func (m *Memory) Add(ctx context.Context, things []string) error {
+	if m.readOnly {
+		return fmt.Errorf("gosync.memory.add -> %w", ErrReadOnly)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for index, thing := range things {
+		m.things[thing] = struct{}{}
+
+		ReportProgress(ctx, index+1, len(things))
+	}
+
+	return nil
+}
+
+// Remove things from memory.
+func (m *Memory) Remove(ctx context.Context, things []string) error {
+	if m.readOnly {
+		return fmt.Errorf("gosync.memory.remove -> %w", ErrReadOnly)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for index, thing := range things {
+		delete(m.things, thing)
+
+		ReportProgress(ctx, index+1, len(things))
+	}
+
+	return nil
+}
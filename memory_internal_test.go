@@ -0,0 +1,59 @@
+package gosync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemory(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	memory := NewMemory([]string{"foo", "bar"})
+
+	things, err := memory.Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bar", "foo"}, things)
+	assert.False(t, memory.ReadOnly())
+
+	assert.NoError(t, memory.Add(ctx, []string{"baz"}))
+	assert.NoError(t, memory.Remove(ctx, []string{"foo"}))
+
+	things, _ = memory.Get(ctx)
+	assert.Equal(t, []string{"bar", "baz"}, things)
+
+	t.Run("Sync", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMemory([]string{"foo", "bar"}, WithReadOnly())
+		destination := NewMemory([]string{"bar", "baz"})
+
+		result, err := New(source).SyncWithResult(ctx, destination)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo"}, result.Added)
+		assert.Equal(t, []string{"baz"}, result.Removed)
+
+		things, _ := destination.Get(ctx)
+		assert.Equal(t, []string{"bar", "foo"}, things)
+	})
+
+	t.Run("Read only", func(t *testing.T) {
+		t.Parallel()
+
+		memory := NewMemory([]string{"foo"}, WithReadOnly())
+
+		assert.True(t, memory.ReadOnly())
+		assert.ErrorIs(t, memory.Add(ctx, []string{"bar"}), ErrReadOnly)
+		assert.ErrorIs(t, memory.Remove(ctx, []string{"foo"}), ErrReadOnly)
+
+		// A read-only adapter can't be used as a destination.
+		assert.ErrorIs(t, New(NewMemory(nil)).SyncWith(ctx, memory), ErrReadOnly)
+
+		things, _ := memory.Get(ctx)
+		assert.Equal(t, []string{"foo"}, things)
+	})
+}
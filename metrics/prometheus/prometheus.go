@@ -0,0 +1,93 @@
+/*
+Package prometheus records metrics about each sync with Prometheus.
+
+Metrics are labelled with the adapter being synchronised, and any other labels set with gosync.WithLabels:
+
+	gosync_added_total           Counter of things added to destinations.
+	gosync_removed_total         Counter of things removed from destinations.
+	gosync_errors_total          Counter of syncs that failed.
+	gosync_sync_duration_seconds Histogram of how long each sync took.
+*/
+package prometheus
+
+import (
+	"fmt"
+	"time"
+
+	gosync "github.com/ovotech/go-sync"
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// Ensure the Metrics type fully satisfies the gosync.Metrics interface.
+var _ gosync.Metrics = &Metrics{}
+
+// AdapterLabel is the label used to identify the adapter being synchronised. Sync sets it to the destination's name,
+// unless it's set with gosync.WithLabels.
+const AdapterLabel = "adapter"
+
+type Metrics struct {
+	labelNames []string
+	added      *prom.CounterVec
+	removed    *prom.CounterVec
+	errors     *prom.CounterVec
+	duration   *prom.HistogramVec
+}
+
+// New registers Go Sync's metrics with registerer (e.g. prometheus.DefaultRegisterer). The adapter label is always
+// included, and labelNames are any other labels that will be set with gosync.WithLabels, e.g. team or environment.
+// Labels that aren't set on a Sync are left empty.
+func New(registerer prom.Registerer, labelNames ...string) (*Metrics, error) {
+	names := append([]string{AdapterLabel}, labelNames...)
+
+	metrics := &Metrics{
+		labelNames: names,
+		added: prom.NewCounterVec(prom.CounterOpts{
+			Namespace: "gosync",
+			Name:      "added_total",
+			Help:      "Number of things added to destinations.",
+		}, names),
+		removed: prom.NewCounterVec(prom.CounterOpts{
+			Namespace: "gosync",
+			Name:      "removed_total",
+			Help:      "Number of things removed from destinations.",
+		}, names),
+		errors: prom.NewCounterVec(prom.CounterOpts{
+			Namespace: "gosync",
+			Name:      "errors_total",
+			Help:      "Number of syncs that failed.",
+		}, names),
+		duration: prom.NewHistogramVec(prom.HistogramOpts{
+			Namespace: "gosync",
+			Name:      "sync_duration_seconds",
+			Help:      "How long each sync took.",
+			Buckets:   prom.DefBuckets,
+		}, names),
+	}
+
+	for _, collector := range []prom.Collector{metrics.added, metrics.removed, metrics.errors, metrics.duration} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, fmt.Errorf("prometheus.new.register -> %w", err)
+		}
+	}
+
+	return metrics, nil
+}
+
+// Observe records the outcome of a sync.
+func (m *Metrics) Observe(labels map[string]string, added int, removed int, duration time.Duration, err error) {
+	values := make(prom.Labels, len(m.labelNames))
+	for _, name := range m.labelNames {
+		values[name] = labels[name]
+	}
+
+	m.added.With(values).Add(float64(added))
+	m.removed.With(values).Add(float64(removed))
+	m.duration.With(values).Observe(duration.Seconds())
+
+	if err != nil {
+		m.errors.With(values).Inc()
+	} else {
+		// Initialise the counter, so that alerts on its rate work from the first failure.
+		m.errors.With(values).Add(0)
+	}
+}
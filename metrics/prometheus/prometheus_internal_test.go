@@ -0,0 +1,56 @@
+package prometheus
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	registry := prom.NewRegistry()
+
+	metrics, err := New(registry, "team")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"adapter", "team"}, metrics.labelNames)
+
+	// Metrics can only be registered once.
+	_, err = New(registry, "team")
+	assert.Error(t, err)
+}
+
+func TestMetrics_Observe(t *testing.T) {
+	t.Parallel()
+
+	registry := prom.NewRegistry()
+
+	metrics, err := New(registry, "team")
+	assert.NoError(t, err)
+
+	labels := map[string]string{"adapter": "slack", "team": "foo", "unknown": "ignored"}
+
+	metrics.Observe(labels, 2, 1, time.Second, nil)
+	metrics.Observe(labels, 1, 0, time.Second, errors.New("foo")) //nolint:goerr113
+
+	err = testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP gosync_added_total Number of things added to destinations.
+# TYPE gosync_added_total counter
+gosync_added_total{adapter="slack",team="foo"} 3
+# HELP gosync_errors_total Number of syncs that failed.
+# TYPE gosync_errors_total counter
+gosync_errors_total{adapter="slack",team="foo"} 1
+# HELP gosync_removed_total Number of things removed from destinations.
+# TYPE gosync_removed_total counter
+gosync_removed_total{adapter="slack",team="foo"} 1
+`), "gosync_added_total", "gosync_errors_total", "gosync_removed_total")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, testutil.CollectAndCount(metrics.duration))
+}
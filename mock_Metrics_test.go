@@ -0,0 +1,69 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package gosync
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMetrics is an autogenerated mock type for the Metrics type
+type MockMetrics struct {
+	mock.Mock
+}
+
+type MockMetrics_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMetrics) EXPECT() *MockMetrics_Expecter {
+	return &MockMetrics_Expecter{mock: &_m.Mock}
+}
+
+// Observe provides a mock function with given fields: labels, added, removed, duration, err
+func (_m *MockMetrics) Observe(labels map[string]string, added int, removed int, duration time.Duration, err error) {
+	_m.Called(labels, added, removed, duration, err)
+}
+
+// MockMetrics_Observe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Observe'
+type MockMetrics_Observe_Call struct {
+	*mock.Call
+}
+
+// Observe is a helper method to define mock.On call
+//   - labels map[string]string
+//   - added int
+//   - removed int
+//   - duration time.Duration
+//   - err error
+func (_e *MockMetrics_Expecter) Observe(labels interface{}, added interface{}, removed interface{}, duration interface{}, err interface{}) *MockMetrics_Observe_Call {
+	return &MockMetrics_Observe_Call{Call: _e.mock.On("Observe", labels, added, removed, duration, err)}
+}
+
+func (_c *MockMetrics_Observe_Call) Run(run func(labels map[string]string, added int, removed int, duration time.Duration, err error)) *MockMetrics_Observe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[string]string), args[1].(int), args[2].(int), args[3].(time.Duration), args[4].(error))
+	})
+	return _c
+}
+
+func (_c *MockMetrics_Observe_Call) Return() *MockMetrics_Observe_Call {
+	_c.Call.Return()
+	return _c
+}
+
+type mockConstructorTestingTNewMockMetrics interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockMetrics creates a new instance of MockMetrics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockMetrics(t mockConstructorTestingTNewMockMetrics) *MockMetrics {
+	mock := &MockMetrics{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,145 @@
+package gosync
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// failurePolicy specifies how SyncWithAll handles a destination failing part way through a run.
+type failurePolicy string
+
+const (
+	// AbortOnFailure stops the run at the first destination that fails, leaving the remaining destinations untouched.
+	AbortOnFailure failurePolicy = "Abort"
+	// ContinueOnFailure carries on syncing the remaining destinations after one fails.
+	ContinueOnFailure failurePolicy = "Continue"
+)
+
+// WithStagger delays the sync with each destination in SyncWithAll and SyncMany by a random duration of up to
+// stagger, to smooth out bursts of requests to services shared by many destinations (e.g. Slack's rate limits). The
+// delay is cancelled with the run's context. Default is 0, so destinations are synced straight away.
+func WithStagger(stagger time.Duration) func(*Sync) {
+	return func(sync *Sync) {
+		sync.stagger = stagger
+	}
+}
+
+// sleep waits for d, or returns ctx's error if it's done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err() //nolint:wrapcheck
+	case <-timer.C:
+		return nil
+	}
+}
+
+// SyncWithAll synchronises many destination services with the source service, in order. The source is read once,
+// and if that fails the run is aborted before any destination is touched. How a failing destination affects the rest
+// of the run is set by the FailurePolicy.
+//
+// A Result is returned for each destination, in the same order as the adapters. Destinations that weren't synced
+// because the run was aborted have a nil Result.
+func (s *Sync) SyncWithAll(ctx context.Context, adapters ...Adapter) ([]*Result, error) {
+	return s.syncWithAll(ctx, s.FailurePolicy, adapters)
+}
+
+// SyncMany synchronises many destination services with the source service, reading the source once. Unlike
+// SyncWithAll, a failing destination never stops the rest from being synced.
+//
+// A Result is returned for each destination, keyed by its name (from Namer or the adapter's type, with a #n suffix if
+// more than one destination has the same name), so callers can report which ones succeeded. Each Result's Errors holds
+// that destination's error.
+func (s *Sync) SyncMany(ctx context.Context, adapters ...Adapter) (map[string]*Result, error) {
+	results, err := s.syncWithAll(ctx, ContinueOnFailure, adapters)
+	if results == nil {
+		return nil, err
+	}
+
+	var (
+		named = make(map[string]*Result, len(results))
+		seen  = make(map[string]int, len(results))
+	)
+
+	for _, result := range results {
+		name := result.Destination
+
+		seen[name]++
+		if seen[name] > 1 {
+			name = fmt.Sprintf("%s#%d", name, seen[name])
+		}
+
+		named[name] = result
+	}
+
+	return named, err
+}
+
+// syncWithAll synchronises many destinations in order, handling failures with the given policy.
+func (s *Sync) syncWithAll(ctx context.Context, policy failurePolicy, adapters []Adapter) ([]*Result, error) {
+	// The max duration applies to the whole run, not each destination.
+	ctx, cancel := s.withDeadline(ctx)
+	defer cancel()
+
+	// There's nothing reliable to sync to the destinations if the source can't be read.
+	if err := s.generateCache(ctx); err != nil {
+		return nil, fmt.Errorf("sync.syncwithall.generatecache -> %w", err)
+	}
+
+	var (
+		results  = make([]*Result, len(adapters))
+		failures []error
+	)
+
+	for i, adapter := range adapters {
+		result, err := s.staggeredSync(ctx, adapter)
+		results[i] = result
+
+		if err == nil {
+			continue
+		}
+
+		failures = append(failures, err)
+
+		if policy == AbortOnFailure {
+			s.logger.Printf("Destination %d of %d failed, aborting remaining destinations", i+1, len(adapters))
+
+			return results, fmt.Errorf("sync.syncwithall(%d) -> %w", i, err)
+		}
+
+		s.logger.Printf("Destination %d of %d failed, continuing with remaining destinations", i+1, len(adapters))
+	}
+
+	if len(failures) > 0 {
+		return results, fmt.Errorf(
+			"sync.syncwithall -> %d of %d destinations failed: %w",
+			len(failures),
+			len(adapters),
+			joinErrors(failures),
+		)
+	}
+
+	return results, nil
+}
+
+// staggeredSync synchronises a destination after the random delay set by WithStagger. If the run is cancelled during
+// the delay, a Result with the error is returned without touching the destination.
+func (s *Sync) staggeredSync(ctx context.Context, adapter Adapter) (*Result, error) {
+	if s.stagger > 0 {
+		delay := s.jitter(s.stagger)
+
+		s.logger.Printf("Waiting %s before syncing with %s", delay, adapterName(adapter))
+
+		if err := s.sleep(ctx, delay); err != nil {
+			err = fmt.Errorf("sync.syncwithall.stagger(%s) -> %w", delay, err)
+
+			return &Result{Destination: adapterName(adapter), Errors: []error{err}}, err
+		}
+	}
+
+	return s.SyncWithResult(ctx, adapter)
+}
@@ -0,0 +1,249 @@
+package gosync
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+//nolint:funlen
+func TestSync_SyncWithAll(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	testErr := errors.New("foo") //nolint:goerr113
+
+	t.Run("Source failure aborts", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		source.EXPECT().Get(mock.Anything).Return(nil, testErr)
+
+		results, err := New(source).SyncWithAll(ctx, destination, destination)
+
+		assert.ErrorIs(t, err, testErr)
+		assert.Nil(t, results)
+		assert.Zero(t, destination.Calls)
+	})
+
+	t.Run("AbortOnFailure", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMockAdapter(t)
+		first := NewMockAdapter(t)
+		second := NewMockAdapter(t)
+		third := NewMockAdapter(t)
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+		first.EXPECT().Get(mock.Anything).Return([]string{}, nil)
+		first.EXPECT().Add(mock.Anything, []string{"foo"}).Return(nil)
+		second.EXPECT().Get(mock.Anything).Return(nil, testErr)
+
+		results, err := New(source).SyncWithAll(ctx, first, second, third)
+
+		assert.ErrorIs(t, err, testErr)
+		assert.Len(t, results, 3)
+		assert.Equal(t, []string{"foo"}, results[0].Added)
+		assert.ErrorIs(t, results[1].Errors[0], testErr)
+		assert.Nil(t, results[2])
+		assert.Zero(t, third.Calls)
+	})
+
+	t.Run("ContinueOnFailure", func(t *testing.T) {
+		t.Parallel()
+
+		addErr := errors.New("bar") //nolint:goerr113
+
+		source := NewMockAdapter(t)
+		first := NewMockAdapter(t)
+		second := NewMockAdapter(t)
+		third := NewMockAdapter(t)
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+		first.EXPECT().Get(mock.Anything).Return(nil, testErr)
+		second.EXPECT().Get(mock.Anything).Return([]string{"foo"}, nil)
+		third.EXPECT().Get(mock.Anything).Return([]string{}, nil)
+		third.EXPECT().Add(mock.Anything, []string{"foo"}).Return(addErr)
+
+		syncService := New(source)
+		syncService.FailurePolicy = ContinueOnFailure
+
+		results, err := syncService.SyncWithAll(ctx, first, second, third)
+
+		// Every destination's error is returned, not just the first.
+		assert.ErrorIs(t, err, testErr)
+		assert.ErrorIs(t, err, addErr)
+		assert.ErrorContains(t, err, "2 of 3 destinations failed")
+		assert.Len(t, results, 3)
+		assert.Len(t, results[0].Errors, 1)
+		assert.Empty(t, results[1].Errors)
+		assert.Len(t, results[2].Errors, 1)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMockAdapter(t)
+		first := NewMockAdapter(t)
+		second := NewMockAdapter(t)
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+		first.EXPECT().Get(mock.Anything).Return([]string{"foo"}, nil)
+		second.EXPECT().Get(mock.Anything).Return([]string{"foo", "bar"}, nil)
+		second.EXPECT().Remove(mock.Anything, []string{"bar"}).Return(nil)
+
+		results, err := New(source).SyncWithAll(ctx, first, second)
+
+		assert.NoError(t, err)
+		assert.Len(t, results, 2)
+		assert.Equal(t, []string{"bar"}, results[1].Removed)
+	})
+
+	t.Run("Empty source is only got once", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMockAdapter(t)
+		first := NewMockAdapter(t)
+		second := NewMockAdapter(t)
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{}, nil)
+		first.EXPECT().Get(mock.Anything).Return([]string{}, nil)
+		second.EXPECT().Get(mock.Anything).Return([]string{}, nil)
+
+		results, err := New(source).SyncWithAll(ctx, first, second)
+
+		assert.NoError(t, err)
+		assert.Len(t, results, 2)
+		source.AssertNumberOfCalls(t, "Get", 1)
+	})
+}
+
+func TestSync_SyncMany(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	testErr := errors.New("foo") //nolint:goerr113
+
+	source := NewMockAdapter(t)
+	first := NewMockAdapter(t)
+	second := NewMockAdapter(t)
+	third := newMemoryAdapter("bar")
+
+	source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+	first.EXPECT().Get(mock.Anything).Return(nil, testErr)
+	second.EXPECT().Get(mock.Anything).Return([]string{"foo"}, nil)
+
+	// A failing destination doesn't stop the rest, even with the default failure policy.
+	results, err := New(source).SyncMany(ctx, first, second, third)
+
+	assert.ErrorIs(t, err, testErr)
+	assert.ErrorContains(t, err, "1 of 3 destinations failed")
+	assert.Len(t, results, 3)
+
+	// Destinations of the same type are told apart by their position.
+	assert.Len(t, results["*gosync.MockAdapter"].Errors, 1)
+	assert.Empty(t, results["*gosync.MockAdapter#2"].Errors)
+	assert.Equal(t, []string{"bar"}, results["gosync.memoryAdapter"].Removed)
+	assert.Equal(t, []string{"foo"}, results["gosync.memoryAdapter"].Added)
+}
+
+//nolint:funlen
+func TestSync_WithStagger(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Staggered", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			now    time.Duration // now is a fake clock, which only moves when sleeping.
+			starts []time.Duration
+			delays = []time.Duration{10 * time.Second, 40 * time.Second, 0}
+		)
+
+		source := NewMockAdapter(t)
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+
+		destinations := make([]Adapter, 0, len(delays))
+
+		for range delays {
+			destination := NewMockAdapter(t)
+			destination.EXPECT().Get(mock.Anything).Run(func(context.Context) {
+				starts = append(starts, now)
+			}).Return([]string{"foo"}, nil).Once()
+
+			destinations = append(destinations, destination)
+		}
+
+		syncService := New(source, WithStagger(time.Minute))
+		syncService.jitter = func(stagger time.Duration) time.Duration {
+			assert.Equal(t, time.Minute, stagger)
+
+			delay := delays[0]
+			delays = delays[1:]
+
+			return delay
+		}
+		syncService.sleep = func(_ context.Context, d time.Duration) error {
+			now += d
+
+			return nil
+		}
+
+		_, err := syncService.SyncMany(ctx, destinations...)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []time.Duration{10 * time.Second, 50 * time.Second, 50 * time.Second}, starts)
+	})
+
+	t.Run("Default", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+
+		syncService := New(source)
+		syncService.sleep = func(context.Context, time.Duration) error {
+			t.Error("sleep shouldn't be called without a stagger")
+
+			return nil
+		}
+
+		_, err := syncService.SyncWithAll(ctx, destination)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMemory([]string{"foo"})
+		destination := NewMockAdapter(t)
+
+		ctx, cancel := context.WithCancel(ctx)
+
+		// The source has been read, but the run is cancelled while waiting for the first destination.
+		syncService := New(source, WithStagger(time.Hour))
+		syncService.jitter = func(stagger time.Duration) time.Duration {
+			cancel()
+
+			return stagger
+		}
+
+		results, err := syncService.SyncMany(ctx, destination)
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.ErrorIs(t, results["*gosync.MockAdapter"].Errors[0], context.Canceled)
+		assert.Zero(t, destination.Calls)
+	})
+}
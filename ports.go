@@ -1,6 +1,9 @@
 package gosync
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // Adapter interfaces are used to allow Sync to communicate with third party services.
 type Adapter interface {
@@ -13,3 +16,47 @@ type Adapter interface {
 type Service interface {
 	SyncWith(ctx context.Context, adapter Adapter) error // Sync the things in a source service with this service.
 }
+
+// Metrics can be used to record metrics about each sync, e.g. with Prometheus or Datadog.
+type Metrics interface {
+	// Observe is called at the end of each sync, with the labels set using WithLabels.
+	Observe(labels map[string]string, added int, removed int, duration time.Duration, err error)
+}
+
+// AdapterLabel is the label passed to Metrics with the name of the destination adapter, unless it's set with
+// WithLabels.
+const AdapterLabel = "adapter"
+
+// RateLimiter throttles calls to a third party service, and may be shared between adapters that call the same service.
+// It's satisfied by *rate.Limiter from golang.org/x/time/rate.
+type RateLimiter interface {
+	// Wait blocks until a call is allowed, or returns an error if ctx is done first.
+	Wait(ctx context.Context) error
+}
+
+// ReadOnlyAdapter can be implemented by adapters that can only be used as a source, so that Validate can catch them
+// being used as a destination.
+type ReadOnlyAdapter interface {
+	ReadOnly() bool // ReadOnly returns true if the adapter can't Add/Remove.
+}
+
+// TypedAdapter can be implemented by adapters to declare the type of things they synchronise, e.g. TypeEmail, so that
+// Validate can catch adapters of different types being synchronised.
+type TypedAdapter interface {
+	Type() string // Type of things the adapter synchronises.
+}
+
+// Namer can be implemented by adapters to give a stable, human-readable name for logs, metrics and results, e.g.
+// slack/conversation:C0123456. Adapters that don't implement it are named after their type.
+type Namer interface {
+	Name() string // Name of the adapter.
+}
+
+// ConfiguredAdapter can be implemented by adapters to describe how they're configured, e.g. for logging at startup or
+// including in run metadata. Secrets (e.g. tokens and passwords) must never be included, use Redacted instead.
+type ConfiguredAdapter interface {
+	Config() map[string]string // Config returns the adapter's sanitised configuration.
+}
+
+// Redacted is used in place of secrets in a ConfiguredAdapter's Config.
+const Redacted = "[redacted]"
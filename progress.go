@@ -0,0 +1,52 @@
+package gosync
+
+import (
+	"context"
+	"log"
+)
+
+// defaultProgressInterval is how often progress is logged by default, in things processed.
+const defaultProgressInterval = 100
+
+// ProgressFunc is called as an adapter works through the things passed to Add/Remove.
+type ProgressFunc func(action string, processed int, total int)
+
+// progressKey is the context key used to store the progress reporter of an Add/Remove call.
+type progressKey struct{}
+
+// progressReporter links progress reported by an adapter to the action Sync is performing.
+type progressReporter struct {
+	action string
+	fn     ProgressFunc
+}
+
+// WithProgress sets a callback for the progress of adapters' Add/Remove calls, e.g. to update a progress bar.
+// By default, progress is logged every 100 things.
+func WithProgress(fn ProgressFunc) func(*Sync) {
+	return func(sync *Sync) {
+		sync.progress = fn
+	}
+}
+
+// LogProgress returns a ProgressFunc that logs every n things processed, and once all things have been processed.
+// This gives operators reassurance that a large sync is advancing, without logging every thing.
+func LogProgress(logger *log.Logger, n int) ProgressFunc {
+	return func(action string, processed int, total int) {
+		if (n > 0 && processed%n == 0) || processed == total {
+			logger.Printf("Processed %d/%d things to %s", processed, total, action)
+		}
+	}
+}
+
+// contextWithProgress returns a copy of ctx that passes progress reported by adapters to fn.
+func contextWithProgress(ctx context.Context, action string, fn ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressKey{}, &progressReporter{action: action, fn: fn})
+}
+
+// ReportProgress reports that an adapter has processed a number of the total things passed to Add/Remove. Adapters
+// that process things one at a time should call this after each thing. If ctx doesn't track progress, it's discarded.
+func ReportProgress(ctx context.Context, processed int, total int) {
+	if reporter, ok := ctx.Value(progressKey{}).(*progressReporter); ok {
+		reporter.fn(reporter.action, processed, total)
+	}
+}
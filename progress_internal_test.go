@@ -0,0 +1,74 @@
+package gosync
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLogProgress(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	progress := LogProgress(log.New(&buf, "", 0), 2)
+
+	for i := 1; i <= 5; i++ {
+		progress("add", i, 5)
+	}
+
+	assert.Equal(t, []string{
+		"Processed 2/5 things to add",
+		"Processed 4/5 things to add",
+		"Processed 5/5 things to add",
+	}, strings.Split(strings.TrimSpace(buf.String()), "\n"))
+}
+
+func TestReportProgress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Untracked context", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NotPanics(t, func() { ReportProgress(context.TODO(), 1, 1) })
+	})
+
+	t.Run("Sync", func(t *testing.T) {
+		t.Parallel()
+
+		type call struct {
+			action           string
+			processed, total int
+		}
+
+		var calls []call
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+		syncService := New(source, WithProgress(func(action string, processed int, total int) {
+			calls = append(calls, call{action, processed, total})
+		}))
+
+		// The destination reports progress as it processes each thing.
+		report := func(ctx context.Context, things []string) {
+			for i := range things {
+				ReportProgress(ctx, i+1, len(things))
+			}
+		}
+
+		source.EXPECT().Get(mock.Anything).Return([]string{"foo"}, nil)
+		destination.EXPECT().Get(mock.Anything).Return([]string{"bar", "baz"}, nil)
+		destination.EXPECT().Remove(mock.Anything, mock.Anything).Run(report).Return(nil)
+		destination.EXPECT().Add(mock.Anything, []string{"foo"}).Run(report).Return(nil)
+
+		err := syncService.SyncWith(context.TODO(), destination)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []call{{"remove", 1, 2}, {"remove", 2, 2}, {"add", 1, 1}}, calls)
+	})
+}
@@ -0,0 +1,99 @@
+package gosync
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure Recorder fully satisfies the Adapter interface.
+var _ Adapter = &Recorder{}
+
+// Recorded is a call made to a Recorder.
+type Recorded struct {
+	Method string   // Method is one of Get, Add or Remove.
+	Things []string // Things passed to Add/Remove, or returned from Get.
+}
+
+// Recorder is a no-op adapter that records every call made to it, for testing sync pipelines.
+// Get returns the things it was created with, and Add/Remove don't change them.
+type Recorder struct {
+	things []string
+	calls  []Recorded
+	mu     sync.Mutex
+}
+
+// NewRecorder creates a new Recorder, which returns things from Get.
+func NewRecorder(things ...string) *Recorder {
+	return &Recorder{
+		things: things,
+		calls:  make([]Recorded, 0),
+	}
+}
+
+// record stores a copy of a call.
+func (r *Recorder) record(method string, things []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, Recorded{Method: method, Things: append([]string(nil), things...)})
+}
+
+// Get records the call, and returns the things the Recorder was created with.
+func (r *Recorder) Get(_ context.Context) ([]string, error) {
+	r.record("Get", r.things)
+
+	return append([]string(nil), r.things...), nil
+}
+
+// Add records the call, but doesn't add anything.
+func (r *Recorder) Add(_ context.Context, things []string) error {
+	r.record("Add", things)
+
+	return nil
+}
+
+// Remove records the call, but doesn't remove anything.
+func (r *Recorder) Remove(_ context.Context, things []string) error {
+	r.record("Remove", things)
+
+	return nil
+}
+
+// Calls returns every call made to the Recorder, in order.
+func (r *Recorder) Calls() []Recorded {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Recorded(nil), r.calls...)
+}
+
+// thingsFor returns all things passed to a method, in order.
+func (r *Recorder) thingsFor(method string) []string {
+	out := make([]string, 0)
+
+	for _, call := range r.Calls() {
+		if call.Method == method {
+			out = append(out, call.Things...)
+		}
+	}
+
+	return out
+}
+
+// Added returns all things passed to Add, in order.
+func (r *Recorder) Added() []string {
+	return r.thingsFor("Add")
+}
+
+// Removed returns all things passed to Remove, in order.
+func (r *Recorder) Removed() []string {
+	return r.thingsFor("Remove")
+}
+
+// Reset clears the recorded calls.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = make([]Recorded, 0)
+}
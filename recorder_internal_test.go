@@ -0,0 +1,81 @@
+package gosync
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	recorder := NewRecorder("foo", "bar")
+
+	things, err := recorder.Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"foo", "bar"}, things)
+
+	assert.NoError(t, recorder.Add(ctx, []string{"fizz"}))
+	assert.NoError(t, recorder.Remove(ctx, []string{"foo"}))
+	assert.NoError(t, recorder.Add(ctx, []string{"buzz"}))
+
+	assert.Equal(t, []Recorded{
+		{Method: "Get", Things: []string{"foo", "bar"}},
+		{Method: "Add", Things: []string{"fizz"}},
+		{Method: "Remove", Things: []string{"foo"}},
+		{Method: "Add", Things: []string{"buzz"}},
+	}, recorder.Calls())
+	assert.Equal(t, []string{"fizz", "buzz"}, recorder.Added())
+	assert.Equal(t, []string{"foo"}, recorder.Removed())
+
+	// Recording is a no-op, so Get is unchanged.
+	things, _ = recorder.Get(ctx)
+	assert.Equal(t, []string{"foo", "bar"}, things)
+
+	recorder.Reset()
+	assert.Empty(t, recorder.Calls())
+}
+
+func TestRecorder_Sync(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	source := NewRecorder("foo", "bar")
+	destination := NewRecorder("bar", "baz")
+
+	err := New(source).SyncWith(ctx, destination)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"foo"}, destination.Added())
+	assert.Equal(t, []string{"baz"}, destination.Removed())
+	assert.Empty(t, source.Added())
+	assert.Empty(t, source.Removed())
+}
+
+func TestRecorder_Concurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	recorder := NewRecorder()
+
+	var waitGroup sync.WaitGroup
+
+	for i := 0; i < 50; i++ {
+		waitGroup.Add(1)
+
+		go func() {
+			defer waitGroup.Done()
+
+			_ = recorder.Add(ctx, []string{"foo"})
+		}()
+	}
+
+	waitGroup.Wait()
+
+	assert.Len(t, recorder.Calls(), 50)
+}
@@ -0,0 +1,83 @@
+package gosync
+
+import (
+	"context"
+	"sync"
+)
+
+// Result is a summary of a single sync with a destination adapter.
+type Result struct {
+	Destination string   // Destination is the destination adapter's name, from Namer or its type if not implemented.
+	Added       []string // Things that were added to the destination.
+	Removed     []string // Things that were removed from the destination.
+	// WouldAdd and WouldRemove are things that would have been added/removed, if Sync.DryRun is enabled.
+	WouldAdd    []string
+	WouldRemove []string
+	// NoChange is true if the source and destination already matched, so Add and Remove weren't called.
+	NoChange bool
+	// Unmanaged are things in the destination outside of the scope set with WithManaged.
+	Unmanaged []string
+	// Snapshot is the things in the destination after the sync, if Sync.Snapshot is enabled.
+	Snapshot []string
+	// Events are each step of the sync in the order they happened, if Sync.Events is enabled.
+	Events []Event
+	// Warnings are non-fatal issues encountered during the sync, e.g. things an adapter skipped.
+	// A sync that completed with warnings has still succeeded.
+	Warnings []error
+	// Errors are fatal issues that caused the sync to fail.
+	Errors []error
+	// size is the number of things in the destination before it was changed.
+	size int
+}
+
+// Counts returns the number of things that were added to and removed from the destination, e.g. for dashboards.
+func (r *Result) Counts() (int, int) {
+	return len(r.Added), len(r.Removed)
+}
+
+// count returns the number of things in the destination after the sync, from Snapshot if it was taken.
+func (r *Result) count() int {
+	if r.Snapshot != nil {
+		return len(r.Snapshot)
+	}
+
+	return r.size + len(r.Added) - len(r.Removed)
+}
+
+// warningsKey is the context key used to store the warnings of a sync run.
+type warningsKey struct{}
+
+// warnings is a concurrency-safe collection of warnings.
+type warnings struct {
+	mu   sync.Mutex
+	list []error
+}
+
+// ContextWithWarnings returns a copy of ctx that collects warnings reported by adapters using Warn.
+// Sync does this for every run, so it's only needed when calling adapters directly (e.g. in tests).
+func ContextWithWarnings(ctx context.Context) context.Context {
+	return context.WithValue(ctx, warningsKey{}, &warnings{})
+}
+
+// Warn reports a non-fatal warning against the sync run in ctx. Adapters should use this for things they skip or
+// mute, rather than failing the sync. If ctx doesn't collect warnings, the warning is discarded.
+func Warn(ctx context.Context, warning error) {
+	if collector, ok := ctx.Value(warningsKey{}).(*warnings); ok {
+		collector.mu.Lock()
+		defer collector.mu.Unlock()
+
+		collector.list = append(collector.list, warning)
+	}
+}
+
+// Warnings returns the warnings reported so far against the sync run in ctx.
+func Warnings(ctx context.Context) []error {
+	if collector, ok := ctx.Value(warningsKey{}).(*warnings); ok {
+		collector.mu.Lock()
+		defer collector.mu.Unlock()
+
+		return append([]error(nil), collector.list...)
+	}
+
+	return nil
+}
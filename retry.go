@@ -0,0 +1,175 @@
+package gosync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// Ensure Retry fully satisfies the Adapter, Namer, ReadOnlyAdapter and TypedAdapter interfaces.
+var (
+	_ Adapter         = &Retry{}
+	_ Namer           = &Retry{}
+	_ ReadOnlyAdapter = &Retry{}
+	_ TypedAdapter    = &Retry{}
+)
+
+const (
+	defaultMaxRetries = 3
+	defaultBackoff    = time.Second
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// Retry wraps an adapter, and retries calls that fail with exponential backoff and jitter. As a failed Add/Remove is
+// retried with all of its things, the wrapped adapter should tolerate things that have already been added/removed.
+type Retry struct {
+	adapter    Adapter              // The wrapped adapter.
+	maxRetries int                  // Number of times a failed call is retried.
+	backoff    time.Duration        // Backoff before the first retry, which doubles for each subsequent retry.
+	maxBackoff time.Duration        // Maximum backoff between retries.
+	retryable  func(err error) bool // retryable returns false for errors that shouldn't be retried.
+	jitter     func(d time.Duration) time.Duration
+}
+
+// WithMaxRetries sets the number of times a failed call is retried.
+func WithMaxRetries(maxRetries int) func(*Retry) {
+	return func(retry *Retry) {
+		retry.maxRetries = maxRetries
+	}
+}
+
+// WithBackoff sets the backoff before the first retry, which doubles for each subsequent retry up to maxBackoff.
+func WithBackoff(backoff time.Duration, maxBackoff time.Duration) func(*Retry) {
+	return func(retry *Retry) {
+		retry.backoff = backoff
+		retry.maxBackoff = maxBackoff
+	}
+}
+
+// WithRetryable sets which errors are retried, e.g. to avoid retrying permanent errors. By default, all errors are
+// retried except ErrReadOnly, ErrNotImplemented and context cancellation.
+func WithRetryable(retryable func(err error) bool) func(*Retry) {
+	return func(retry *Retry) {
+		retry.retryable = retryable
+	}
+}
+
+// isRetryable is the default retryable function, which skips errors that will never succeed on retry.
+func isRetryable(err error) bool {
+	return !errors.Is(err, ErrReadOnly) &&
+		!errors.Is(err, ErrNotImplemented) &&
+		!errors.Is(err, context.Canceled) &&
+		!errors.Is(err, context.DeadlineExceeded)
+}
+
+// fullJitter returns a random duration between 0 and d, so many clients don't retry at the same time.
+func fullJitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(d))) //nolint:gosec
+}
+
+// NewRetry wraps an adapter, retrying failed calls.
+func NewRetry(adapter Adapter, optsFn ...func(*Retry)) *Retry {
+	retry := &Retry{
+		adapter:    adapter,
+		maxRetries: defaultMaxRetries,
+		backoff:    defaultBackoff,
+		maxBackoff: defaultMaxBackoff,
+		retryable:  isRetryable,
+		jitter:     fullJitter,
+	}
+
+	for _, fn := range optsFn {
+		fn(retry)
+	}
+
+	return retry
+}
+
+// Name returns the name of the wrapped adapter, so that logs, metrics and results are labelled with it.
+func (r *Retry) Name() string {
+	return adapterName(r.adapter)
+}
+
+// ReadOnly returns true if the wrapped adapter is read-only.
+func (r *Retry) ReadOnly() bool {
+	return isReadOnly(r.adapter)
+}
+
+// Type returns the type of things the wrapped adapter synchronises, or an empty string if it doesn't declare one.
+func (r *Retry) Type() string {
+	return adapterType(r.adapter)
+}
+
+// do calls fn until it succeeds, the error isn't retryable, the retries are exhausted, or ctx is done.
+func (r *Retry) do(ctx context.Context, fn func() error) error {
+	backoff := r.backoff
+
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= r.maxRetries || !r.retryable(err) {
+			return err
+		}
+
+		timer := time.NewTimer(r.jitter(backoff))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return fmt.Errorf("%w (after %d attempts, last error: %s)", ctx.Err(), attempt+1, err)
+		case <-timer.C:
+		}
+
+		backoff *= 2
+		if backoff > r.maxBackoff {
+			backoff = r.maxBackoff
+		}
+	}
+}
+
+// Get things from the wrapped adapter, retrying on failure.
+func (r *Retry) Get(ctx context.Context) ([]string, error) {
+	var things []string
+
+	err := r.do(ctx, func() error {
+		var err error
+		things, err = r.adapter.Get(ctx)
+
+		return err //nolint:wrapcheck
+	})
+	if err != nil {
+		return nil, fmt.Errorf("retry.get -> %w", err)
+	}
+
+	return things, nil
+}
+
+// Add things to the wrapped adapter, retrying on failure.
+func (r *Retry) Add(ctx context.Context, things []string) error {
+	err := r.do(ctx, func() error {
+		return r.adapter.Add(ctx, things) //nolint:wrapcheck
+	})
+	if err != nil {
+		return fmt.Errorf("retry.add -> %w", err)
+	}
+
+	return nil
+}
+
+// Remove things from the wrapped adapter, retrying on failure.
+func (r *Retry) Remove(ctx context.Context, things []string) error {
+	err := r.do(ctx, func() error {
+		return r.adapter.Remove(ctx, things) //nolint:wrapcheck
+	})
+	if err != nil {
+		return fmt.Errorf("retry.remove -> %w", err)
+	}
+
+	return nil
+}
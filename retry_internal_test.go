@@ -0,0 +1,143 @@
+package gosync
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRetry(t *testing.T) {
+	t.Parallel()
+
+	adapter := NewMockAdapter(t)
+	retry := NewRetry(adapter, WithMaxRetries(5), WithBackoff(time.Millisecond, time.Second))
+
+	assert.Equal(t, 5, retry.maxRetries)
+	assert.Equal(t, time.Millisecond, retry.backoff)
+	assert.Equal(t, time.Second, retry.maxBackoff)
+	assert.Zero(t, adapter.Calls)
+}
+
+//nolint:funlen
+func TestRetry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	testErr := errors.New("foo") //nolint:goerr113
+
+	// noJitter records each backoff, and doesn't wait.
+	noJitter := func(backoffs *[]time.Duration) func(*Retry) {
+		return func(retry *Retry) {
+			retry.jitter = func(d time.Duration) time.Duration {
+				*backoffs = append(*backoffs, d)
+
+				return 0
+			}
+		}
+	}
+
+	t.Run("Fails twice then succeeds", func(t *testing.T) {
+		t.Parallel()
+
+		var backoffs []time.Duration
+
+		adapter := NewMockAdapter(t)
+		retry := NewRetry(adapter, WithBackoff(time.Second, 3*time.Second), noJitter(&backoffs))
+
+		adapter.EXPECT().Get(ctx).Twice().Return(nil, testErr)
+		adapter.EXPECT().Get(ctx).Once().Return([]string{"foo"}, nil)
+		adapter.EXPECT().Add(ctx, []string{"bar"}).Twice().Return(testErr)
+		adapter.EXPECT().Add(ctx, []string{"bar"}).Once().Return(nil)
+
+		things, err := retry.Get(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo"}, things)
+
+		err = retry.Add(ctx, []string{"bar"})
+		assert.NoError(t, err)
+
+		// The backoff doubles on each retry, up to the maximum.
+		assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, time.Second, 2 * time.Second}, backoffs)
+	})
+
+	t.Run("Retries exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		var backoffs []time.Duration
+
+		adapter := NewMockAdapter(t)
+		retry := NewRetry(adapter, WithMaxRetries(2), noJitter(&backoffs))
+
+		adapter.EXPECT().Remove(ctx, []string{"foo"}).Times(3).Return(testErr)
+
+		err := retry.Remove(ctx, []string{"foo"})
+
+		assert.ErrorIs(t, err, testErr)
+		assert.Len(t, backoffs, 2)
+	})
+
+	t.Run("Permanent errors", func(t *testing.T) {
+		t.Parallel()
+
+		adapter := NewMockAdapter(t)
+
+		// ErrReadOnly is never retried by default.
+		adapter.EXPECT().Add(ctx, []string{"foo"}).Once().Return(ErrReadOnly)
+		assert.ErrorIs(t, NewRetry(adapter).Add(ctx, []string{"foo"}), ErrReadOnly)
+
+		// Callers can choose which errors are permanent.
+		retry := NewRetry(adapter, WithRetryable(func(err error) bool {
+			return !errors.Is(err, testErr)
+		}))
+
+		adapter.EXPECT().Remove(ctx, []string{"foo"}).Once().Return(testErr)
+		assert.ErrorIs(t, retry.Remove(ctx, []string{"foo"}), testErr)
+	})
+
+	t.Run("Cancelled between attempts", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(ctx)
+
+		adapter := NewMockAdapter(t)
+		retry := NewRetry(adapter, WithBackoff(time.Hour, time.Hour))
+		retry.jitter = func(d time.Duration) time.Duration {
+			cancel()
+
+			return d
+		}
+
+		adapter.EXPECT().Get(ctx).Once().Return(nil, testErr)
+
+		start := time.Now()
+		_, err := retry.Get(ctx)
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+}
+
+func TestRetry_Namer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	// The wrapped adapter's name, read-only flag and type are used, rather than the Retry's own.
+	retry := NewRetry(&namedMemory{NewMemory([]string{"foo"}), "test/named:foo"})
+
+	assert.Equal(t, "test/named:foo", retry.Name())
+	assert.False(t, retry.ReadOnly())
+	assert.Empty(t, retry.Type())
+
+	result, err := New(NewMemory([]string{"foo"})).SyncWithResult(ctx, retry)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "test/named:foo", result.Destination)
+
+	assert.Equal(t, "*gosync.Recorder", NewRetry(NewRecorder()).Name())
+	assert.True(t, NewRetry(readOnlyAdapter{NewRecorder()}).ReadOnly())
+	assert.Equal(t, TypeEmail, NewRetry(typedAdapter{NewRecorder(), TypeEmail}).Type())
+}
@@ -0,0 +1,43 @@
+package gosync
+
+import (
+	"context"
+	"fmt"
+)
+
+// Ensure Static fully satisfies the Adapter and ReadOnlyAdapter interfaces.
+var (
+	_ Adapter         = &Static{}
+	_ ReadOnlyAdapter = &Static{}
+)
+
+// Static is a read-only source of a fixed list of things, e.g. break-glass admins hardcoded in config. It's also the
+// simplest possible adapter, and a good starting point for writing new ones.
+type Static struct {
+	things []string
+}
+
+// StaticAdapter creates a new Static adapter, whose Get returns things.
+func StaticAdapter(things ...string) *Static {
+	return &Static{things: append([]string{}, things...)}
+}
+
+// ReadOnly always returns true, as a static adapter can only be used as a source.
+func (s *Static) ReadOnly() bool {
+	return true
+}
+
+// Get returns the things the adapter was created with.
+func (s *Static) Get(_ context.Context) ([]string, error) {
+	return append([]string{}, s.things...), nil
+}
+
+// Add always fails with ErrReadOnly, as a static adapter can only be used as a source.
+func (s *Static) Add(_ context.Context, _ []string) error {
+	return fmt.Errorf("gosync.static.add -> %w", ErrReadOnly)
+}
+
+// Remove always fails with ErrReadOnly, as a static adapter can only be used as a source.
+func (s *Static) Remove(_ context.Context, _ []string) error {
+	return fmt.Errorf("gosync.static.remove -> %w", ErrReadOnly)
+}
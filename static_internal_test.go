@@ -0,0 +1,41 @@
+package gosync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStaticAdapter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	things := []string{"foo", "bar"}
+	static := StaticAdapter(things...)
+
+	// Changing the original slice doesn't change the adapter.
+	things[0] = "baz"
+
+	got, err := static.Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"foo", "bar"}, got)
+	assert.True(t, static.ReadOnly())
+
+	assert.ErrorIs(t, static.Add(ctx, []string{"baz"}), ErrReadOnly)
+	assert.ErrorIs(t, static.Remove(ctx, []string{"foo"}), ErrReadOnly)
+
+	t.Run("Union", func(t *testing.T) {
+		t.Parallel()
+
+		source := Union(NewMemory([]string{"oncall"}), StaticAdapter("admin", "oncall"))
+		destination := NewMemory([]string{"leaver"})
+
+		result, err := New(source).SyncWithResult(ctx, destination)
+
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"oncall", "admin"}, result.Added)
+		assert.Equal(t, []string{"leaver"}, result.Removed)
+	})
+}
@@ -0,0 +1,69 @@
+package gosync
+
+import (
+	"sync"
+	"time"
+)
+
+// DestinationStats are the outcome of the most recent syncs with a destination, recorded with WithStats.
+type DestinationStats struct {
+	LastSync    time.Time // LastSync is when the last sync finished, successfully or not.
+	LastSuccess time.Time // LastSuccess is when the last successful sync finished, or zero if none have succeeded.
+	LastError   error     // LastError is the error of the last sync, or nil if it succeeded.
+	Count       int       // Count is the number of things in the destination after the last successful sync.
+}
+
+// Stats records the outcome of each sync per destination, e.g. for a health check endpoint in a long-lived service.
+// Unlike Metrics, it's read synchronously with Snapshot. It's safe for concurrent use, and can be shared by several
+// Syncs with WithStats.
+type Stats struct {
+	mu           sync.RWMutex
+	destinations map[string]DestinationStats
+	now          func() time.Time // now is the clock used to timestamp syncs, and can be replaced in tests.
+}
+
+// NewStats creates a new, empty Stats.
+func NewStats() *Stats {
+	return &Stats{
+		mu:           sync.RWMutex{},
+		destinations: make(map[string]DestinationStats),
+		now:          time.Now,
+	}
+}
+
+// WithStats records the outcome of each sync in stats, by the destination's name as in Result.Destination.
+func WithStats(stats *Stats) func(*Sync) {
+	return func(sync *Sync) {
+		sync.stats = stats
+	}
+}
+
+// Snapshot returns a copy of the stats of every destination synchronised so far, by name.
+func (s *Stats) Snapshot() map[string]DestinationStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	out := make(map[string]DestinationStats, len(s.destinations))
+	for destination, stats := range s.destinations {
+		out[destination] = stats
+	}
+
+	return out
+}
+
+// record records the outcome of a sync with a destination.
+func (s *Stats) record(result *Result, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := s.destinations[result.Destination]
+	stats.LastSync = s.now()
+	stats.LastError = err
+
+	if err == nil {
+		stats.LastSuccess = stats.LastSync
+		stats.Count = result.count()
+	}
+
+	s.destinations[result.Destination] = stats
+}
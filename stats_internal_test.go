@@ -0,0 +1,116 @@
+package gosync
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// namedMemory is a Memory adapter that implements Namer, to sync with several destinations.
+type namedMemory struct {
+	*Memory
+	name string
+}
+
+func (n *namedMemory) Name() string {
+	return n.name
+}
+
+//nolint:funlen
+func TestWithStats(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	synced := time.Date(2022, 10, 6, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		stats := NewStats()
+		stats.now = func() time.Time { return synced }
+
+		syncService := New(NewMemory([]string{"foo", "bar", "baz"}), WithStats(stats))
+
+		assert.NoError(t, syncService.SyncWith(ctx, NewMemory([]string{"foo", "qux"})))
+		assert.Equal(t, map[string]DestinationStats{
+			"*gosync.Memory": {LastSync: synced, LastSuccess: synced, LastError: nil, Count: 3},
+		}, stats.Snapshot())
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		testErr := errors.New("foo") //nolint:goerr113
+		stats := NewStats()
+		stats.now = func() time.Time { return synced }
+
+		destination := NewMockAdapter(t)
+		syncService := New(NewMemory([]string{"foo"}), WithStats(stats))
+
+		destination.EXPECT().Get(mock.Anything).Return([]string{"foo", "bar"}, nil).Once()
+		destination.EXPECT().Remove(mock.Anything, []string{"bar"}).Return(nil).Once()
+
+		assert.NoError(t, syncService.SyncWith(ctx, destination))
+
+		failed := synced.Add(time.Hour)
+		stats.now = func() time.Time { return failed }
+
+		destination.EXPECT().Get(mock.Anything).Return(nil, testErr).Once()
+
+		assert.ErrorIs(t, syncService.SyncWith(ctx, destination), testErr)
+
+		// The last success and count are kept from the previous sync.
+		snapshot := stats.Snapshot()["*gosync.MockAdapter"]
+
+		assert.Equal(t, failed, snapshot.LastSync)
+		assert.Equal(t, synced, snapshot.LastSuccess)
+		assert.ErrorIs(t, snapshot.LastError, testErr)
+		assert.Equal(t, 1, snapshot.Count)
+	})
+
+	t.Run("Snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		stats := NewStats()
+
+		syncService := New(NewMemory([]string{"foo", "bar"}), WithStats(stats))
+		syncService.Snapshot = true
+
+		assert.NoError(t, syncService.SyncWith(ctx, NewMemory([]string{"Foo"})))
+		assert.Equal(t, 2, stats.Snapshot()["*gosync.Memory"].Count)
+	})
+
+	t.Run("Shared", func(t *testing.T) {
+		t.Parallel()
+
+		var wg sync.WaitGroup
+
+		stats := NewStats()
+
+		for _, destination := range []string{"foo", "bar", "baz"} {
+			wg.Add(2) //nolint:gomnd
+
+			go func(name string) {
+				defer wg.Done()
+
+				syncService := New(NewMemory([]string{"foo"}), WithStats(stats))
+				assert.NoError(t, syncService.SyncWith(ctx, &namedMemory{Memory: NewMemory(nil), name: name}))
+			}(destination)
+
+			go func() {
+				defer wg.Done()
+
+				_ = stats.Snapshot()
+			}()
+		}
+
+		wg.Wait()
+
+		assert.Len(t, stats.Snapshot(), 3)
+	})
+}
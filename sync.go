@@ -2,9 +2,12 @@ package gosync
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"strings"
+	"time"
 )
 
 // Ensure Sync fully satisfies the Service interface.
@@ -14,9 +17,9 @@ var _ Service = &Sync{}
 type operatingMode string
 
 const (
-	// AddOnly only runs add operations.
+	// AddOnly only runs add operations. Things are never removed from the destination.
 	AddOnly operatingMode = "Add"
-	// RemoveOnly only runs remove operations.
+	// RemoveOnly only runs remove operations. Things are never added to the destination.
 	RemoveOnly operatingMode = "Remove"
 	// RemoveAdd first removes things, then adds them.
 	RemoveAdd operatingMode = "RemoveAdd"
@@ -24,6 +27,16 @@ const (
 	AddRemove operatingMode = "AddRemove"
 )
 
+// adapterName returns the name of an adapter for logs, metrics and results, using Namer if it's implemented, or the
+// adapter's type otherwise, e.g. *conversation.Conversation.
+func adapterName(adapter Adapter) string {
+	if namer, ok := adapter.(Namer); ok {
+		return namer.Name()
+	}
+
+	return fmt.Sprintf("%T", adapter)
+}
+
 // generateHashMap takes a list of strings and returns a hashed map of { item => true }.
 func generateHashMap(i []string) map[string]bool {
 	out := map[string]bool{}
@@ -35,21 +48,74 @@ func generateHashMap(i []string) map[string]bool {
 }
 
 type Sync struct {
-	DryRun        bool            // DryRun mode calculates membership, but doesn't add or remove.
-	OperatingMode operatingMode   // Change the order of Sync's operation. Default is RemoveAdd.
-	source        Adapter         // The source adapter.
-	cache         map[string]bool // cache prevents polling the source more than once.
-	logger        *log.Logger
+	DryRun            bool                      // DryRun mode calculates membership, but doesn't add or remove.
+	OperatingMode     operatingMode             // Change the order of Sync's operation. Default is RemoveAdd.
+	ConflictPolicy    conflictPolicy            // Change how SyncBidirectional resolves conflicts. Default is NeverRemove.
+	FailurePolicy     failurePolicy             // Change how SyncWithAll handles failures. Default is AbortOnFailure.
+	Snapshot          bool                      // Snapshot gets the destination again after syncing, into Result.Snapshot.
+	KeepUnmanaged     bool                      // KeepUnmanaged never removes things outside of WithManaged's scope.
+	RetryOnCacheEmpty bool                      // RetryOnCacheEmpty gets the destination again if Remove needs it.
+	Events            bool                      // Events records each step of a sync into Result.Events.
+	CaseSensitive     bool                      // CaseSensitive matches things exactly, rather than normalising them.
+	ContinueOnError   bool                      // ContinueOnError changes things one at a time, past any failures.
+	NormaliseEmails   bool                      // NormaliseEmails canonicalises emails, skipping invalid ones.
+	StrictEmails      bool                      // StrictEmails normalises emails, but fails on invalid ones.
+	source            Adapter                   // The source adapter.
+	cache             map[string]string         // cache prevents polling the source more than once.
+	fetched           bool                      // fetched is set once the source has been got into the cache.
+	comparator        func(thing string) string // comparator returns the identity of a thing, used when diffing.
+	metrics           Metrics                   // metrics is called at the end of each sync.
+	stats             *Stats                    // stats records the outcome of each sync.
+	labels            map[string]string         // labels are a fixed set of labels passed to metrics.
+	progress          ProgressFunc              // progress is called as adapters work through Add/Remove.
+	maxDuration       time.Duration             // maxDuration is the deadline for a run, after which it's cancelled.
+	managed           func(thing string) bool   // managed returns false for things the source could never produce.
+	maxRemovalCount   int                       // maxRemovalCount is the most things that can be removed in a sync.
+	maxRemovalPercent float64                   // maxRemovalPercent is the most of the destination that can be removed.
+	concurrency       int                       // concurrency is the number of chunks Add/Remove calls are split into.
+	onChange          func(event ChangeEvent)   // onChange is called before each thing is added or removed.
+	journal           *removalJournal           // journal records each thing before it's removed.
+	stagger           time.Duration             // stagger is the most each destination's sync is randomly delayed by.
+	// jitter picks each destination's delay, and sleep waits for it (or until ctx is done). Both are replaced in tests.
+	jitter func(time.Duration) time.Duration
+	sleep  func(ctx context.Context, d time.Duration) error
+	logger *log.Logger
 }
 
 // New creates a new Sync service.
 func New(source Adapter, optsFn ...func(*Sync)) *Sync {
 	sync := &Sync{
-		DryRun:        false,
-		OperatingMode: RemoveAdd,
-		source:        source,
-		cache:         make(map[string]bool),
-		logger:        log.New(os.Stderr, "[go-sync/sync] ", log.LstdFlags|log.Lshortfile|log.Lmsgprefix),
+		DryRun:            false,
+		OperatingMode:     RemoveAdd,
+		ConflictPolicy:    NeverRemove,
+		FailurePolicy:     AbortOnFailure,
+		Snapshot:          false,
+		KeepUnmanaged:     false,
+		RetryOnCacheEmpty: false,
+		Events:            false,
+		CaseSensitive:     false,
+		ContinueOnError:   false,
+		NormaliseEmails:   false,
+		StrictEmails:      false,
+		source:            source,
+		cache:             make(map[string]string),
+		fetched:           false,
+		comparator:        nil,
+		metrics:           nil,
+		stats:             nil,
+		labels:            map[string]string{},
+		progress:          nil,
+		maxDuration:       0,
+		managed:           nil,
+		maxRemovalCount:   0,
+		maxRemovalPercent: 0,
+		concurrency:       1,
+		onChange:          nil,
+		journal:           nil,
+		stagger:           0,
+		jitter:            fullJitter,
+		sleep:             sleep,
+		logger:            log.New(os.Stderr, "[go-sync/sync] ", log.LstdFlags|log.Lshortfile|log.Lmsgprefix),
 	}
 
 	for _, fn := range optsFn {
@@ -59,13 +125,61 @@ func New(source Adapter, optsFn ...func(*Sync)) *Sync {
 	return sync
 }
 
+// identity returns the identity of a thing using the comparator. By default, things are lowercased and trimmed of
+// whitespace, unless CaseSensitive is set.
+func (s *Sync) identity(thing string) string {
+	if s.comparator == nil {
+		if s.CaseSensitive {
+			return thing
+		}
+
+		return strings.ToLower(strings.TrimSpace(thing))
+	}
+
+	return s.comparator(thing)
+}
+
+// index takes a list of things and returns a map of { identity => thing }, using the comparator.
+func (s *Sync) index(things []string) map[string]string {
+	out := make(map[string]string, len(things))
+
+	for _, thing := range things {
+		key := s.identity(thing)
+		if _, ok := out[key]; !ok {
+			out[key] = thing
+		}
+	}
+
+	return out
+}
+
+// dedupe returns things without duplicates, keeping the first of each identity in order. Duplicates are never
+// meaningful for set membership, but would inflate counts and cause redundant calls to adapters.
+func (s *Sync) dedupe(things []string) []string {
+	out := make([]string, 0, len(things))
+	seen := make(map[string]bool, len(things))
+
+	for _, thing := range things {
+		key := s.identity(thing)
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		out = append(out, thing)
+	}
+
+	return out
+}
+
 // getThingsToAdd determines things that should be added to the destination service.
 func (s *Sync) getThingsToAdd(things []string) []string {
 	out := make([]string, 0, len(things))
-	hashMap := generateHashMap(things)
+	hashMap := s.index(things)
 
-	for thing := range s.cache {
-		if !hashMap[thing] {
+	for key, thing := range s.cache {
+		if _, ok := hashMap[key]; !ok {
 			out = append(out, thing)
 		}
 	}
@@ -77,9 +191,8 @@ func (s *Sync) getThingsToAdd(things []string) []string {
 func (s *Sync) getThingsToRemove(things []string) []string {
 	var out []string
 
-	hashMap := generateHashMap(things)
-	for thing := range hashMap {
-		if !s.cache[thing] {
+	for key, thing := range s.index(things) {
+		if _, ok := s.cache[key]; !ok {
 			out = append(out, thing)
 		}
 	}
@@ -89,7 +202,8 @@ func (s *Sync) getThingsToRemove(things []string) []string {
 
 // generateCache populates the cache with a map of things for efficient lookup.
 func (s *Sync) generateCache(ctx context.Context) error {
-	if len(s.cache) == 0 {
+	// A source with nothing in it is only got once too, so the emptiness of the cache can't be used.
+	if !s.fetched {
 		s.logger.Println("Getting things from source adapter")
 
 		things, err := s.source.Get(ctx)
@@ -97,7 +211,9 @@ func (s *Sync) generateCache(ctx context.Context) error {
 			return fmt.Errorf("get -> %w", err)
 		}
 
-		s.cache = generateHashMap(things)
+		// Duplicates are dropped when the things are indexed.
+		s.cache = s.index(things)
+		s.fetched = true
 	}
 
 	return nil
@@ -110,22 +226,211 @@ func WithLogger(logger *log.Logger) func(*Sync) {
 	}
 }
 
-// perform processes adding/removing things from a destination service.
+// WithComparator sets how Sync determines whether things in the source and destination are the same identity, e.g.
+// to canonicalise emails. The function returns the identity of a thing, and things with equal identities are
+// considered equal. Adapters are still passed their own values in Add/Remove. Default is case-insensitive, ignoring
+// leading/trailing whitespace.
+func WithComparator(comparator func(thing string) string) func(*Sync) {
+	return func(sync *Sync) {
+		sync.comparator = comparator
+	}
+}
+
+// WithMetrics records metrics about each sync.
+func WithMetrics(metrics Metrics) func(*Sync) {
+	return func(sync *Sync) {
+		sync.metrics = metrics
+	}
+}
+
+// WithLabels sets labels (e.g. team, environment) that are passed to metrics, to group them meaningfully.
+// To avoid unbounded cardinality, labels are a fixed set copied when Sync is created, and never derived from things.
+func WithLabels(labels map[string]string) func(*Sync) {
+	return func(sync *Sync) {
+		sync.labels = make(map[string]string, len(labels))
+
+		for key, value := range labels {
+			sync.labels[key] = value
+		}
+	}
+}
+
+// metricsLabels returns the labels passed to metrics for a sync with a destination. AdapterLabel is set to the
+// destination's name, unless it's been set with WithLabels.
+func (s *Sync) metricsLabels(destination string) map[string]string {
+	if _, ok := s.labels[AdapterLabel]; ok {
+		return s.labels
+	}
+
+	labels := make(map[string]string, len(s.labels)+1)
+	for key, value := range s.labels {
+		labels[key] = value
+	}
+
+	labels[AdapterLabel] = destination
+
+	return labels
+}
+
+// WithMaxDuration sets an overall deadline for each run, e.g. to bound the runtime of a cron job. Once exceeded, the
+// context passed to adapters is cancelled and the run fails with context.DeadlineExceeded. Changes made before the
+// deadline are still recorded in the Result. Default is no deadline.
+func WithMaxDuration(maxDuration time.Duration) func(*Sync) {
+	return func(sync *Sync) {
+		sync.maxDuration = maxDuration
+	}
+}
+
+// WithManaged sets the scope of things that the source can manage, e.g. emails in the source's domain. Things in the
+// destination outside of this scope (e.g. service accounts or guests) are reported in Result.Unmanaged, and if
+// KeepUnmanaged is set, they're never removed.
+func WithManaged(managed func(thing string) bool) func(*Sync) {
+	return func(sync *Sync) {
+		sync.managed = managed
+	}
+}
+
+// WithMaxRemovalCount aborts a sync before anything is changed if it would remove more than count things from the
+// destination, e.g. if a misconfigured source returns nothing. Default is no limit.
+func WithMaxRemovalCount(count int) func(*Sync) {
+	return func(sync *Sync) {
+		sync.maxRemovalCount = count
+	}
+}
+
+// WithMaxRemovalPercent aborts a sync before anything is changed if it would remove more than percent (0-100) of the
+// things currently in the destination. Default is no limit.
+func WithMaxRemovalPercent(percent float64) func(*Sync) {
+	return func(sync *Sync) {
+		sync.maxRemovalPercent = percent
+	}
+}
+
+// InDomains returns a function for WithManaged, which only manages emails in the given domains.
+func InDomains(domains ...string) func(thing string) bool {
+	lookup := make(map[string]bool, len(domains))
+	for _, domain := range domains {
+		lookup[strings.ToLower(domain)] = true
+	}
+
+	return func(thing string) bool {
+		index := strings.LastIndex(thing, "@")
+
+		return index != -1 && lookup[strings.ToLower(thing[index+1:])]
+	}
+}
+
+// partitionManaged splits things into those that are managed and those that aren't.
+func (s *Sync) partitionManaged(things []string) ([]string, []string) {
+	if s.managed == nil {
+		return things, nil
+	}
+
+	var managed, unmanaged []string
+
+	for _, thing := range things {
+		if s.managed(thing) {
+			managed = append(managed, thing)
+		} else {
+			unmanaged = append(unmanaged, thing)
+		}
+	}
+
+	return managed, unmanaged
+}
+
+// checkRemovals returns ErrUnsafeRemoval if removing a number of things from a destination of size total would exceed
+// the limits set with WithMaxRemovalCount/WithMaxRemovalPercent.
+func (s *Sync) checkRemovals(removals int, total int) error {
+	if s.maxRemovalCount > 0 && removals > s.maxRemovalCount {
+		return fmt.Errorf("%w: %d removals exceeds the limit of %d", ErrUnsafeRemoval, removals, s.maxRemovalCount)
+	}
+
+	if s.maxRemovalPercent > 0 && total > 0 {
+		percent := float64(removals) / float64(total) * 100 //nolint:gomnd
+
+		if percent > s.maxRemovalPercent {
+			return fmt.Errorf(
+				"%w: %d removals (%.1f%% of %d things) exceeds the limit of %.1f%%",
+				ErrUnsafeRemoval, removals, percent, total, s.maxRemovalPercent,
+			)
+		}
+	}
+
+	return nil
+}
+
+// withDeadline returns a copy of ctx that's cancelled once the max duration has elapsed, if one is set.
+func (s *Sync) withDeadline(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.maxDuration <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, s.maxDuration)
+}
+
+// removeFn returns the function used to remove things from a destination adapter. If RetryOnCacheEmpty is set and
+// the adapter's cache has been lost (e.g. it was reset, or a previous step ran against another instance), Get is called
+// to warm the cache, and the removal retried once.
+func (s *Sync) removeFn(adapter Adapter) func(context.Context, []string) error {
+	if !s.RetryOnCacheEmpty {
+		return adapter.Remove
+	}
+
+	return func(ctx context.Context, things []string) error {
+		err := adapter.Remove(ctx, things)
+		if !errors.Is(err, ErrCacheEmpty) {
+			return err //nolint:wrapcheck
+		}
+
+		s.logger.Println("Destination cache is empty, getting things from destination adapter before retrying")
+
+		if _, err := adapter.Get(ctx); err != nil {
+			return fmt.Errorf("retry.get -> %w", err)
+		}
+
+		if err := adapter.Remove(ctx, things); err != nil {
+			return fmt.Errorf("retry.remove -> %w", err)
+		}
+
+		return nil
+	}
+}
+
+// perform processes adding/removing things from a destination service. Things that are changed are appended to
+// changed, and in dry run mode, things that would have been changed are appended to planned instead.
 func (s *Sync) perform(
 	ctx context.Context,
 	action string,
 	things []string,
 	diffFn func(things []string) []string,
 	executeFn func(context.Context, []string) error,
+	changed *[]string,
+	planned *[]string,
 ) func() error {
 	return func() error {
 		s.logger.Printf("Processing things to %s\n", action)
 
-		thingsToChange := diffFn(things)
+		thingsToChange, err := s.normaliseEmails(ctx, action, diffFn(things))
+		if err != nil {
+			return fmt.Errorf("%s(%v) -> %w", action, things, err)
+		}
+
+		for _, thing := range thingsToChange {
+			recordEvent(ctx, EventResolved, action, thing, nil)
+		}
 
 		if s.DryRun {
 			s.logger.Printf("Would %s %s, but running in dry run mode", action, thingsToChange)
 
+			for _, thing := range thingsToChange {
+				recordEvent(ctx, EventSkipped, action, thing, nil)
+			}
+
+			if planned != nil {
+				*planned = append(*planned, thingsToChange...)
+			}
+
 			return nil
 		}
 
@@ -133,9 +438,30 @@ func (s *Sync) perform(
 			return nil
 		}
 
+		// Don't start making changes if the run has already been cancelled.
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("%s(%v) -> %w", action, things, err)
+		}
+
 		s.logger.Printf("%s: %s", action, thingsToChange)
 
-		err := executeFn(ctx, thingsToChange)
+		progress := s.progress
+		if progress == nil {
+			progress = LogProgress(s.logger, defaultProgressInterval)
+		}
+
+		execute := s.execute
+		if s.ContinueOnError {
+			execute = s.executeEach
+		}
+
+		applied, err := execute(ctx, action, thingsToChange, progress, executeFn)
+
+		// Record things that were changed, even if others failed.
+		if changed != nil {
+			*changed = append(*changed, applied...)
+		}
+
 		if err != nil {
 			return fmt.Errorf("%s(%v) -> %w", action, things, err)
 		}
@@ -144,9 +470,71 @@ func (s *Sync) perform(
 	}
 }
 
+// inSync returns true if nothing would be added to or removed from the destination in the current operating mode.
+func (s *Sync) inSync(things []string, removable []string) bool {
+	if s.OperatingMode != RemoveOnly && len(s.getThingsToAdd(things)) > 0 {
+		return false
+	}
+
+	if s.OperatingMode != AddOnly && len(s.getThingsToRemove(removable)) > 0 {
+		return false
+	}
+
+	return true
+}
+
 // SyncWith synchronises the destination service with the source service, adding & removing things as necessary.
 func (s *Sync) SyncWith(ctx context.Context, adapter Adapter) error {
-	s.logger.Println("Starting sync")
+	_, err := s.SyncWithResult(ctx, adapter)
+
+	return err
+}
+
+// SyncWithResult synchronises the destination service with the source service, and returns a Result summarising the
+// sync. The Result is returned even if the sync fails.
+func (s *Sync) SyncWithResult(ctx context.Context, adapter Adapter) (*Result, error) {
+	ctx = ContextWithWarnings(ctx)
+	if s.Events {
+		ctx = contextWithEvents(ctx)
+	}
+
+	ctx, cancel := s.withDeadline(ctx)
+	defer cancel()
+
+	result := &Result{Destination: adapterName(adapter)}
+	start := time.Now()
+
+	err := s.syncWith(ctx, adapter, result)
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("sync.syncwithresult(max duration %s) -> %w", s.maxDuration, err)
+	}
+
+	if s.metrics != nil {
+		s.metrics.Observe(s.metricsLabels(result.Destination), len(result.Added), len(result.Removed), time.Since(start), err)
+	}
+
+	if s.stats != nil {
+		s.stats.record(result, err)
+	}
+
+	result.Events = getEvents(ctx)
+	result.Warnings = Warnings(ctx)
+	if len(result.Warnings) > 0 {
+		s.logger.Printf("Sync reported %d warnings: %v", len(result.Warnings), result.Warnings)
+	}
+
+	if err != nil {
+		result.Errors = append(result.Errors, err)
+
+		return result, err
+	}
+
+	return result, nil
+}
+
+// syncWith performs the synchronisation with a destination adapter.
+func (s *Sync) syncWith(ctx context.Context, adapter Adapter, result *Result) error {
+	s.logger.Printf("Starting sync with %s", adapterName(adapter))
 
 	// Call to populate the cache from the source adapter.
 	if err := s.generateCache(ctx); err != nil {
@@ -160,39 +548,115 @@ func (s *Sync) SyncWith(ctx context.Context, adapter Adapter) error {
 		return fmt.Errorf("sync.syncwith.get -> %w", err)
 	}
 
+	if deduped := s.dedupe(things); len(deduped) < len(things) {
+		s.logger.Printf("Ignoring %d duplicate things from destination adapter", len(things)-len(deduped))
+
+		things = deduped
+	}
+
+	result.size = len(things)
+
+	// Things that can be removed from the destination.
+	removable := things
+
+	managed, unmanaged := s.partitionManaged(things)
+	if len(unmanaged) > 0 {
+		s.logger.Printf("Destination has %d unmanaged things: %s", len(unmanaged), unmanaged)
+
+		result.Unmanaged = unmanaged
+
+		if s.KeepUnmanaged {
+			removable = managed
+
+			// Nothing is ever removed in AddOnly mode, so there's no removal to skip.
+			if s.OperatingMode != AddOnly {
+				for _, thing := range s.getThingsToRemove(unmanaged) {
+					recordEvent(ctx, EventSkipped, "remove", thing, nil)
+				}
+			}
+		}
+	}
+
+	// Check the removals are safe before anything is changed.
+	if s.OperatingMode != AddOnly {
+		if err := s.checkRemovals(len(s.getThingsToRemove(removable)), len(things)); err != nil {
+			if !s.DryRun {
+				return fmt.Errorf("sync.syncwith.checkremovals -> %w", err)
+			}
+
+			// Nothing will be changed in dry run mode, so report what would have happened instead.
+			Warn(ctx, fmt.Errorf("sync.syncwith.checkremovals -> %w", err))
+		}
+	}
+
 	s.logger.Printf("Running in %s operating mode", s.OperatingMode)
 
 	operations := make([]func() error, 0, 2) //nolint:gomnd
+	add := s.notifyChanges(adapter, "add", adapter.Add)
+	remove := s.notifyChanges(adapter, "remove", s.journalRemovals(adapter, "remove", s.removeFn(adapter)))
 
 	switch s.OperatingMode {
 	case AddOnly:
 		operations = []func() error{
-			s.perform(ctx, "add", things, s.getThingsToAdd, adapter.Add),
+			s.perform(ctx, "add", things, s.getThingsToAdd, add, &result.Added, &result.WouldAdd),
 		}
 	case RemoveOnly:
 		operations = []func() error{
-			s.perform(ctx, "remove", things, s.getThingsToRemove, adapter.Remove),
+			s.perform(ctx, "remove", removable, s.getThingsToRemove, remove, &result.Removed, &result.WouldRemove),
 		}
 	case RemoveAdd:
 		operations = []func() error{
-			s.perform(ctx, "remove", things, s.getThingsToRemove, adapter.Remove),
-			s.perform(ctx, "add", things, s.getThingsToAdd, adapter.Add),
+			s.perform(ctx, "remove", removable, s.getThingsToRemove, remove, &result.Removed, &result.WouldRemove),
+			s.perform(ctx, "add", things, s.getThingsToAdd, add, &result.Added, &result.WouldAdd),
 		}
 	case AddRemove:
 		operations = []func() error{
-			s.perform(ctx, "add", things, s.getThingsToAdd, adapter.Add),
-			s.perform(ctx, "remove", things, s.getThingsToRemove, adapter.Remove),
+			s.perform(ctx, "add", things, s.getThingsToAdd, add, &result.Added, &result.WouldAdd),
+			s.perform(ctx, "remove", removable, s.getThingsToRemove, remove, &result.Removed, &result.WouldRemove),
 		}
 	}
 
+	// Don't call Add or Remove at all if there's nothing to change, as some adapters fail on empty input.
+	if s.inSync(things, removable) {
+		s.logger.Printf("Already in sync with %s, nothing to change", adapterName(adapter))
+
+		result.NoChange = true
+		operations = nil
+	}
+
+	var failures []error
+
 	for _, fn := range operations {
 		err = fn()
-		if err != nil {
+		if err == nil {
+			continue
+		}
+
+		if !s.ContinueOnError {
 			return fmt.Errorf("sync.syncwith.execute -> %w", err)
 		}
+
+		// Carry on with the remaining operations, e.g. adding things even though some couldn't be removed.
+		failures = append(failures, err)
+	}
+
+	if s.Snapshot {
+		s.logger.Println("Getting snapshot of things from destination adapter")
+
+		// The changes have already been applied, so a failed snapshot shouldn't fail the sync.
+		snapshot, err := adapter.Get(ctx)
+		if err != nil {
+			Warn(ctx, fmt.Errorf("sync.syncwith.snapshot -> %w", err))
+		} else {
+			result.Snapshot = snapshot
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("sync.syncwith.execute -> %w", joinErrors(failures))
 	}
 
-	s.logger.Println("Finished sync")
+	s.logger.Printf("Finished sync with %s", adapterName(adapter))
 
 	return nil
 }
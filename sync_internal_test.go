@@ -3,9 +3,12 @@ package gosync
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestNew(t *testing.T) {
@@ -16,6 +19,7 @@ func TestNew(t *testing.T) {
 
 	assert.Empty(t, syncService.cache)
 	assert.Equal(t, RemoveAdd, syncService.OperatingMode)
+	assert.Equal(t, NeverRemove, syncService.ConflictPolicy)
 	assert.False(t, syncService.DryRun)
 	assert.Zero(t, adapter.Calls)
 }
@@ -37,10 +41,10 @@ func TestSync_SyncWith(t *testing.T) { //nolint:maintidx
 
 			syncService := New(source)
 
-			source.EXPECT().Get(ctx).Once().Return([]string{"foo", "bar"}, nil)
-			destination.EXPECT().Get(ctx).Once().Return([]string{}, nil)
-			destination.EXPECT().Add(ctx, []string{"foo", "bar"}).Maybe().Return(nil)
-			destination.EXPECT().Add(ctx, []string{"bar", "foo"}).Maybe().Return(nil)
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo", "bar"}, nil)
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{}, nil)
+			destination.EXPECT().Add(mock.Anything, []string{"foo", "bar"}).Maybe().Return(nil)
+			destination.EXPECT().Add(mock.Anything, []string{"bar", "foo"}).Maybe().Return(nil)
 
 			err := syncService.SyncWith(ctx, destination)
 
@@ -57,10 +61,10 @@ func TestSync_SyncWith(t *testing.T) { //nolint:maintidx
 
 			testErr := errors.New("foo") //nolint:goerr113
 
-			source.EXPECT().Get(ctx).Once().Return([]string{"foo", "bar"}, nil)
-			destination.EXPECT().Get(ctx).Once().Return([]string{}, nil)
-			destination.EXPECT().Add(ctx, []string{"foo", "bar"}).Maybe().Return(testErr)
-			destination.EXPECT().Add(ctx, []string{"bar", "foo"}).Maybe().Return(testErr)
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo", "bar"}, nil)
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{}, nil)
+			destination.EXPECT().Add(mock.Anything, []string{"foo", "bar"}).Maybe().Return(testErr)
+			destination.EXPECT().Add(mock.Anything, []string{"bar", "foo"}).Maybe().Return(testErr)
 
 			err := syncService.SyncWith(ctx, destination)
 
@@ -75,12 +79,12 @@ func TestSync_SyncWith(t *testing.T) { //nolint:maintidx
 			destination := NewMockAdapter(t)
 
 			syncService := New(source)
-			syncService.cache = map[string]bool{}
+			syncService.cache = map[string]string{}
 
 			testErr := errors.New("foo") //nolint:goerr113
 
-			source.EXPECT().Get(ctx).Once().Return([]string{"foo", "bar"}, nil)
-			destination.EXPECT().Get(ctx).Once().Return([]string{}, testErr)
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo", "bar"}, nil)
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{}, testErr)
 
 			err := syncService.SyncWith(ctx, destination)
 
@@ -99,10 +103,10 @@ func TestSync_SyncWith(t *testing.T) { //nolint:maintidx
 
 			syncService := New(source)
 
-			source.EXPECT().Get(ctx).Once().Return([]string{}, nil)
-			destination.EXPECT().Get(ctx).Once().Return([]string{"foo", "bar"}, nil)
-			destination.EXPECT().Remove(ctx, []string{"foo", "bar"}).Maybe().Return(nil)
-			destination.EXPECT().Remove(ctx, []string{"bar", "foo"}).Maybe().Return(nil)
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{}, nil)
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{"foo", "bar"}, nil)
+			destination.EXPECT().Remove(mock.Anything, []string{"foo", "bar"}).Maybe().Return(nil)
+			destination.EXPECT().Remove(mock.Anything, []string{"bar", "foo"}).Maybe().Return(nil)
 
 			err := syncService.SyncWith(ctx, destination)
 
@@ -119,10 +123,10 @@ func TestSync_SyncWith(t *testing.T) { //nolint:maintidx
 
 			testErr := errors.New("foo") //nolint:goerr113
 
-			source.EXPECT().Get(ctx).Once().Return([]string{}, nil)
-			destination.EXPECT().Get(ctx).Once().Return([]string{"foo", "bar"}, nil)
-			destination.EXPECT().Remove(ctx, []string{"foo", "bar"}).Maybe().Return(testErr)
-			destination.EXPECT().Remove(ctx, []string{"bar", "foo"}).Maybe().Return(testErr)
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{}, nil)
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{"foo", "bar"}, nil)
+			destination.EXPECT().Remove(mock.Anything, []string{"foo", "bar"}).Maybe().Return(testErr)
+			destination.EXPECT().Remove(mock.Anything, []string{"bar", "foo"}).Maybe().Return(testErr)
 
 			err := syncService.SyncWith(ctx, destination)
 
@@ -140,8 +144,8 @@ func TestSync_SyncWith(t *testing.T) { //nolint:maintidx
 
 			testErr := errors.New("foo") //nolint:goerr113
 
-			source.EXPECT().Get(ctx).Once().Return([]string{}, nil)
-			destination.EXPECT().Get(ctx).Once().Return([]string{}, testErr)
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{}, nil)
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{}, testErr)
 
 			err := syncService.SyncWith(ctx, destination)
 
@@ -158,15 +162,52 @@ func TestSync_SyncWith(t *testing.T) { //nolint:maintidx
 
 			testErr := errors.New("foo") //nolint:goerr113
 
-			source.EXPECT().Get(ctx).Once().Return([]string{}, nil)
-			destination.EXPECT().Get(ctx).Once().Return([]string{"foo", "bar"}, nil)
-			destination.EXPECT().Remove(ctx, []string{"foo", "bar"}).Maybe().Return(testErr)
-			destination.EXPECT().Remove(ctx, []string{"bar", "foo"}).Maybe().Return(testErr)
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{}, nil)
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{"foo", "bar"}, nil)
+			destination.EXPECT().Remove(mock.Anything, []string{"foo", "bar"}).Maybe().Return(testErr)
+			destination.EXPECT().Remove(mock.Anything, []string{"bar", "foo"}).Maybe().Return(testErr)
 
 			err := syncService.SyncWith(ctx, destination)
 
 			assert.ErrorIs(t, err, testErr)
 		})
+
+		t.Run("Remove retry on cache empty", func(t *testing.T) {
+			t.Parallel()
+
+			source := NewMockAdapter(t)
+			destination := NewMockAdapter(t)
+
+			syncService := New(source)
+			syncService.RetryOnCacheEmpty = true
+
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{}, nil)
+			destination.EXPECT().Get(mock.Anything).Twice().Return([]string{"foo"}, nil)
+			destination.EXPECT().Remove(mock.Anything, []string{"foo"}).Once().Return(ErrCacheEmpty)
+			destination.EXPECT().Remove(mock.Anything, []string{"foo"}).Once().Return(nil)
+
+			result, err := syncService.SyncWithResult(ctx, destination)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"foo"}, result.Removed)
+		})
+
+		t.Run("Remove cache empty without retry", func(t *testing.T) {
+			t.Parallel()
+
+			source := NewMockAdapter(t)
+			destination := NewMockAdapter(t)
+
+			syncService := New(source)
+
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{}, nil)
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+			destination.EXPECT().Remove(mock.Anything, []string{"foo"}).Once().Return(ErrCacheEmpty)
+
+			err := syncService.SyncWith(ctx, destination)
+
+			assert.ErrorIs(t, err, ErrCacheEmpty)
+		})
 	})
 
 	t.Run("Simultaneous", func(t *testing.T) {
@@ -177,12 +218,12 @@ func TestSync_SyncWith(t *testing.T) { //nolint:maintidx
 
 		syncService := New(source)
 
-		source.EXPECT().Get(ctx).Once().Return([]string{"foo", "bar"}, nil)
-		destination.EXPECT().Get(ctx).Once().Return([]string{"fizz", "buzz"}, nil)
-		destination.EXPECT().Add(ctx, []string{"foo", "bar"}).Maybe().Return(nil)
-		destination.EXPECT().Add(ctx, []string{"bar", "foo"}).Maybe().Return(nil)
-		destination.EXPECT().Remove(ctx, []string{"fizz", "buzz"}).Maybe().Return(nil)
-		destination.EXPECT().Remove(ctx, []string{"buzz", "fizz"}).Maybe().Return(nil)
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo", "bar"}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{"fizz", "buzz"}, nil)
+		destination.EXPECT().Add(mock.Anything, []string{"foo", "bar"}).Maybe().Return(nil)
+		destination.EXPECT().Add(mock.Anything, []string{"bar", "foo"}).Maybe().Return(nil)
+		destination.EXPECT().Remove(mock.Anything, []string{"fizz", "buzz"}).Maybe().Return(nil)
+		destination.EXPECT().Remove(mock.Anything, []string{"buzz", "fizz"}).Maybe().Return(nil)
 
 		err := syncService.SyncWith(ctx, destination)
 
@@ -201,8 +242,8 @@ func TestSync_SyncWith(t *testing.T) { //nolint:maintidx
 			syncService := New(source)
 			syncService.DryRun = true
 
-			source.EXPECT().Get(ctx).Once().Return([]string{"foo", "bar"}, nil)
-			destination.EXPECT().Get(ctx).Once().Return([]string{}, nil)
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo", "bar"}, nil)
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{}, nil)
 			err := syncService.SyncWith(ctx, destination)
 
 			assert.NoError(t, err)
@@ -217,12 +258,52 @@ func TestSync_SyncWith(t *testing.T) { //nolint:maintidx
 			syncService := New(source)
 			syncService.DryRun = true
 
-			source.EXPECT().Get(ctx).Once().Return([]string{}, nil)
-			destination.EXPECT().Get(ctx).Once().Return([]string{"foo", "bar"}, nil)
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{}, nil)
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{"foo", "bar"}, nil)
 			err := syncService.SyncWith(ctx, destination)
 
 			assert.NoError(t, err)
 		})
+
+		t.Run("Result", func(t *testing.T) {
+			t.Parallel()
+
+			source := NewMockAdapter(t)
+			destination := NewMockAdapter(t)
+
+			syncService := New(source)
+			syncService.DryRun = true
+
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{"bar"}, nil)
+			result, err := syncService.SyncWithResult(ctx, destination)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"foo"}, result.WouldAdd)
+			assert.Equal(t, []string{"bar"}, result.WouldRemove)
+			assert.Empty(t, result.Added)
+			assert.Empty(t, result.Removed)
+		})
+
+		t.Run("Read only destination", func(t *testing.T) {
+			t.Parallel()
+
+			source := newMemoryAdapter("foo")
+			destination := NewMockAdapter(t)
+
+			syncService := New(source)
+			syncService.DryRun = true
+
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{"bar"}, nil)
+			destination.EXPECT().Add(mock.Anything, mock.Anything).Maybe().Return(ErrReadOnly)
+			destination.EXPECT().Remove(mock.Anything, mock.Anything).Maybe().Return(ErrReadOnly)
+
+			result, err := syncService.SyncWithResult(ctx, destination)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"foo"}, result.WouldAdd)
+			assert.Equal(t, []string{"bar"}, result.WouldRemove)
+		})
 	})
 
 	t.Run("Equal", func(t *testing.T) {
@@ -233,14 +314,126 @@ func TestSync_SyncWith(t *testing.T) { //nolint:maintidx
 
 		syncService := New(source)
 
-		source.EXPECT().Get(ctx).Once().Return([]string{"foo"}, nil)
-		destination.EXPECT().Get(ctx).Once().Return([]string{"foo"}, nil)
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+
+		err := syncService.SyncWith(ctx, destination)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Context", func(t *testing.T) {
+		t.Parallel()
+
+		type ctxKey string
+
+		// A request-scoped value set by the caller must reach every adapter call.
+		ctx := context.WithValue(context.TODO(), ctxKey("tenant"), "foo")
+		hasTenant := mock.MatchedBy(func(ctx context.Context) bool {
+			return ctx.Value(ctxKey("tenant")) == "foo"
+		})
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		syncService := New(source)
+
+		source.EXPECT().Get(hasTenant).Once().Return([]string{"foo"}, nil)
+		destination.EXPECT().Get(hasTenant).Once().Return([]string{"bar"}, nil)
+		destination.EXPECT().Add(hasTenant, []string{"foo"}).Once().Return(nil)
+		destination.EXPECT().Remove(hasTenant, []string{"bar"}).Once().Return(nil)
 
 		err := syncService.SyncWith(ctx, destination)
 
 		assert.NoError(t, err)
 	})
 
+	t.Run("Comparator", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Case-insensitive by default", func(t *testing.T) {
+			t.Parallel()
+
+			source := NewMockAdapter(t)
+			destination := NewMockAdapter(t)
+
+			syncService := New(source)
+
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{"Foo@Example.com"}, nil)
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{"foo@example.com"}, nil)
+
+			err := syncService.SyncWith(ctx, destination)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("Mixed-case duplicates", func(t *testing.T) {
+			t.Parallel()
+
+			source := NewMockAdapter(t)
+			destination := NewMockAdapter(t)
+
+			syncService := New(source)
+
+			source.EXPECT().Get(mock.Anything).Once().Return(
+				[]string{"John.Doe@Example.com", " john.doe@example.com", "Jane@Example.com"}, nil,
+			)
+			destination.EXPECT().Get(mock.Anything).Once().Return(
+				[]string{"john.doe@example.com ", "JOHN.DOE@EXAMPLE.COM", "old@example.com"}, nil,
+			)
+
+			// Adapters should still receive their own values.
+			destination.EXPECT().Add(mock.Anything, []string{"Jane@Example.com"}).Once().Return(nil)
+			destination.EXPECT().Remove(mock.Anything, []string{"old@example.com"}).Once().Return(nil)
+
+			err := syncService.SyncWith(ctx, destination)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("Case-sensitive", func(t *testing.T) {
+			t.Parallel()
+
+			source := NewMockAdapter(t)
+			destination := NewMockAdapter(t)
+
+			syncService := New(source)
+			syncService.CaseSensitive = true
+
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{"Foo@Example.com"}, nil)
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{"foo@example.com"}, nil)
+			destination.EXPECT().Add(mock.Anything, []string{"Foo@Example.com"}).Once().Return(nil)
+			destination.EXPECT().Remove(mock.Anything, []string{"foo@example.com"}).Once().Return(nil)
+
+			err := syncService.SyncWith(ctx, destination)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("Custom", func(t *testing.T) {
+			t.Parallel()
+
+			source := NewMockAdapter(t)
+			destination := NewMockAdapter(t)
+
+			// Treat the googlemail.com and gmail.com domains as the same identity.
+			syncService := New(source, WithComparator(func(thing string) string {
+				return strings.Replace(thing, "@googlemail.com", "@gmail.com", 1)
+			}))
+
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo@gmail.com", "bar@gmail.com"}, nil)
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{"foo@googlemail.com", "baz@gmail.com"}, nil)
+
+			// Adapters should still receive their own values.
+			destination.EXPECT().Add(mock.Anything, []string{"bar@gmail.com"}).Once().Return(nil)
+			destination.EXPECT().Remove(mock.Anything, []string{"baz@gmail.com"}).Once().Return(nil)
+
+			err := syncService.SyncWith(ctx, destination)
+
+			assert.NoError(t, err)
+		})
+	})
+
 	t.Run("OperatingMode", func(t *testing.T) {
 		t.Parallel()
 
@@ -253,9 +446,9 @@ func TestSync_SyncWith(t *testing.T) { //nolint:maintidx
 			syncService := New(source)
 			syncService.OperatingMode = AddOnly
 
-			source.EXPECT().Get(ctx).Once().Return([]string{"foo"}, nil)
-			destination.EXPECT().Get(ctx).Once().Return([]string{"bar"}, nil)
-			destination.EXPECT().Add(ctx, []string{"foo"}).Once().Return(nil)
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{"bar"}, nil)
+			destination.EXPECT().Add(mock.Anything, []string{"foo"}).Once().Return(nil)
 
 			err := syncService.SyncWith(ctx, destination)
 
@@ -271,15 +464,65 @@ func TestSync_SyncWith(t *testing.T) { //nolint:maintidx
 			syncService := New(source)
 			syncService.OperatingMode = RemoveOnly
 
-			source.EXPECT().Get(ctx).Once().Return([]string{"foo"}, nil)
-			destination.EXPECT().Get(ctx).Once().Return([]string{"bar"}, nil)
-			destination.EXPECT().Remove(ctx, []string{"bar"}).Once().Return(nil)
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{"bar"}, nil)
+			destination.EXPECT().Remove(mock.Anything, []string{"bar"}).Once().Return(nil)
 
 			err := syncService.SyncWith(ctx, destination)
 
 			assert.NoError(t, err)
 		})
 
+		t.Run("AddOnly never removes", func(t *testing.T) {
+			t.Parallel()
+
+			source := NewMockAdapter(t)
+			destination := NewMockAdapter(t)
+
+			// Removing everything would otherwise trip the safety check, and record the unmanaged thing as skipped.
+			syncService := New(source, WithMaxRemovalCount(1), WithManaged(func(thing string) bool {
+				return thing != "svc"
+			}))
+			syncService.OperatingMode = AddOnly
+			syncService.KeepUnmanaged = true
+			syncService.Events = true
+
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{"bar", "baz", "svc"}, nil)
+			destination.EXPECT().Add(mock.Anything, []string{"foo"}).Once().Return(nil)
+
+			result, err := syncService.SyncWithResult(ctx, destination)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"foo"}, result.Added)
+			assert.Empty(t, result.Removed)
+			assert.Empty(t, result.WouldRemove)
+
+			for _, event := range result.Events {
+				assert.NotEqual(t, "remove", event.Action)
+			}
+		})
+
+		t.Run("RemoveOnly never adds", func(t *testing.T) {
+			t.Parallel()
+
+			source := NewMockAdapter(t)
+			destination := NewMockAdapter(t)
+
+			syncService := New(source)
+			syncService.OperatingMode = RemoveOnly
+			syncService.DryRun = true
+
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo", "bar"}, nil)
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{"baz"}, nil)
+
+			result, err := syncService.SyncWithResult(ctx, destination)
+
+			assert.NoError(t, err)
+			assert.Empty(t, result.WouldAdd)
+			assert.Equal(t, []string{"baz"}, result.WouldRemove)
+		})
+
 		t.Run("RemoveAdd", func(t *testing.T) { //nolint:dupl
 			t.Parallel()
 
@@ -289,10 +532,10 @@ func TestSync_SyncWith(t *testing.T) { //nolint:maintidx
 			syncService := New(source)
 			syncService.OperatingMode = RemoveAdd
 
-			source.EXPECT().Get(ctx).Once().Return([]string{"foo"}, nil)
-			destination.EXPECT().Get(ctx).Once().Return([]string{"bar"}, nil)
-			destination.EXPECT().Add(ctx, []string{"foo"}).Once().Return(nil)
-			destination.EXPECT().Remove(ctx, []string{"bar"}).Once().Return(nil)
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{"bar"}, nil)
+			destination.EXPECT().Add(mock.Anything, []string{"foo"}).Once().Return(nil)
+			destination.EXPECT().Remove(mock.Anything, []string{"bar"}).Once().Return(nil)
 
 			err := syncService.SyncWith(ctx, destination)
 
@@ -311,10 +554,10 @@ func TestSync_SyncWith(t *testing.T) { //nolint:maintidx
 			syncService := New(source)
 			syncService.OperatingMode = AddRemove
 
-			source.EXPECT().Get(ctx).Once().Return([]string{"foo"}, nil)
-			destination.EXPECT().Get(ctx).Once().Return([]string{"bar"}, nil)
-			destination.EXPECT().Add(ctx, []string{"foo"}).Once().Return(nil)
-			destination.EXPECT().Remove(ctx, []string{"bar"}).Once().Return(nil)
+			source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+			destination.EXPECT().Get(mock.Anything).Once().Return([]string{"bar"}, nil)
+			destination.EXPECT().Add(mock.Anything, []string{"foo"}).Once().Return(nil)
+			destination.EXPECT().Remove(mock.Anything, []string{"bar"}).Once().Return(nil)
 
 			err := syncService.SyncWith(ctx, destination)
 
@@ -325,3 +568,481 @@ func TestSync_SyncWith(t *testing.T) { //nolint:maintidx
 		})
 	})
 }
+
+//nolint:funlen
+func TestSync_SyncWithResult(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Success with warnings", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		syncService := New(source)
+
+		testWarning := errors.New("foo") //nolint:goerr113
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{"bar"}, nil)
+		destination.EXPECT().Remove(mock.Anything, []string{"bar"}).Once().Return(nil)
+		destination.EXPECT().Add(mock.Anything, []string{"foo"}).
+			Run(func(ctx context.Context, things []string) {
+				Warn(ctx, testWarning)
+			}).
+			Return(nil).
+			Once()
+
+		result, err := syncService.SyncWithResult(ctx, destination)
+
+		assert.NoError(t, err)
+		assert.Empty(t, result.Errors)
+		assert.Len(t, result.Warnings, 1)
+		assert.ErrorIs(t, result.Warnings[0], testWarning)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		syncService := New(source)
+
+		testErr := errors.New("foo") //nolint:goerr113
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{"bar"}, nil)
+		destination.EXPECT().Remove(mock.Anything, []string{"bar"}).Once().Return(nil)
+		destination.EXPECT().Add(mock.Anything, []string{"foo"}).Once().Return(testErr)
+
+		result, err := syncService.SyncWithResult(ctx, destination)
+
+		assert.ErrorIs(t, err, testErr)
+		assert.Equal(t, "*gosync.MockAdapter", result.Destination)
+
+		// Things removed before the failure are still recorded.
+		added, removed := result.Counts()
+		assert.Equal(t, []string{"bar"}, result.Removed)
+		assert.Zero(t, added)
+		assert.Equal(t, 1, removed)
+		assert.Empty(t, result.Warnings)
+		assert.Len(t, result.Errors, 1)
+		assert.ErrorIs(t, result.Errors[0], testErr)
+	})
+
+	t.Run("Snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		source := newMemoryAdapter("foo", "bar")
+		destination := newMemoryAdapter("bar", "baz")
+
+		syncService := New(source)
+		syncService.Snapshot = true
+
+		result, err := syncService.SyncWithResult(ctx, destination)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"bar", "foo"}, result.Snapshot)
+
+		// Without the option, the destination isn't fetched again.
+		syncService.Snapshot = false
+
+		result, err = syncService.SyncWithResult(ctx, destination)
+
+		assert.NoError(t, err)
+		assert.Nil(t, result.Snapshot)
+	})
+
+	t.Run("Snapshot failure", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		syncService := New(source)
+		syncService.Snapshot = true
+
+		testErr := errors.New("foo") //nolint:goerr113
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return(nil, testErr)
+
+		result, err := syncService.SyncWithResult(ctx, destination)
+
+		assert.NoError(t, err)
+		assert.Nil(t, result.Snapshot)
+		assert.Len(t, result.Warnings, 1)
+		assert.ErrorIs(t, result.Warnings[0], testErr)
+	})
+}
+
+func TestSync_WithManaged(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Report only", func(t *testing.T) {
+		t.Parallel()
+
+		source := newMemoryAdapter("foo@example.com")
+		destination := newMemoryAdapter("bar@example.com", "bot@service.example.net")
+
+		syncService := New(source, WithManaged(InDomains("Example.com")))
+
+		result, err := syncService.SyncWithResult(ctx, destination)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"bot@service.example.net"}, result.Unmanaged)
+		assert.ElementsMatch(t, []string{"bar@example.com", "bot@service.example.net"}, result.Removed)
+	})
+
+	t.Run("Keep unmanaged", func(t *testing.T) {
+		t.Parallel()
+
+		source := newMemoryAdapter("foo")
+		destination := newMemoryAdapter("bar", "svc-deploy", "svc-backup")
+
+		syncService := New(source, WithManaged(func(thing string) bool {
+			return !strings.HasPrefix(thing, "svc-")
+		}))
+		syncService.KeepUnmanaged = true
+
+		result, err := syncService.SyncWithResult(ctx, destination)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"svc-backup", "svc-deploy"}, result.Unmanaged)
+		assert.Equal(t, []string{"bar"}, result.Removed)
+		assert.Equal(t, []string{"foo"}, result.Added)
+
+		things, _ := destination.Get(ctx)
+		assert.Equal(t, []string{"foo", "svc-backup", "svc-deploy"}, things)
+	})
+}
+
+//nolint:funlen
+func TestSync_Duplicates(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Source", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		syncService := New(source)
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo@email", "Foo@Email", "foo@email "}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{}, nil)
+		destination.EXPECT().Add(mock.Anything, []string{"foo@email"}).Once().Return(nil)
+
+		result, err := syncService.SyncWithResult(ctx, destination)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"foo@email"}, result.Added)
+	})
+
+	t.Run("Destination", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		// Without deduplication, 2 of 5 things (40%) would be removed, which is within the limit.
+		syncService := New(source, WithMaxRemovalPercent(40), WithManaged(InDomains("email")))
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo@email"}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().
+			Return([]string{"foo@email", "FOO@email", "bar@email", "svc@other", "svc@other"}, nil)
+
+		result, err := syncService.SyncWithResult(ctx, destination)
+
+		assert.ErrorIs(t, err, ErrUnsafeRemoval)
+		assert.ErrorContains(t, err, "2 removals (66.7% of 3 things)")
+		assert.Equal(t, []string{"svc@other"}, result.Unmanaged)
+	})
+}
+
+func TestSync_NoChange(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Identical", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		// Neither Add nor Remove are expected, so calling them fails the test.
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo@email", "bar@email"}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{"bar@email", "foo@email"}, nil)
+
+		result, err := New(source).SyncWithResult(ctx, destination)
+
+		assert.NoError(t, err)
+		assert.True(t, result.NoChange)
+		assert.Empty(t, result.Added)
+		assert.Empty(t, result.Removed)
+	})
+
+	t.Run("Operating mode", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		// The destination has an extra thing, but in AddOnly mode it would never be removed.
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo@email"}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{"foo@email", "bar@email"}, nil)
+
+		syncService := New(source)
+		syncService.OperatingMode = AddOnly
+
+		result, err := syncService.SyncWithResult(ctx, destination)
+
+		assert.NoError(t, err)
+		assert.True(t, result.NoChange)
+	})
+
+	t.Run("Changed", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo@email"}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{}, nil)
+		destination.EXPECT().Add(mock.Anything, []string{"foo@email"}).Once().Return(nil)
+
+		result, err := New(source).SyncWithResult(ctx, destination)
+
+		assert.NoError(t, err)
+		assert.False(t, result.NoChange)
+	})
+}
+
+func TestSync_MaxRemovals(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Count exceeded", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		syncService := New(source, WithMaxRemovalCount(1))
+
+		// Nothing is added or removed, as the sync is aborted first.
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{"bar", "baz"}, nil)
+
+		err := syncService.SyncWith(ctx, destination)
+
+		assert.ErrorIs(t, err, ErrUnsafeRemoval)
+		assert.ErrorContains(t, err, "2 removals exceeds the limit of 1")
+	})
+
+	t.Run("Percent exceeded", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+
+		syncService := New(source, WithMaxRemovalPercent(50))
+		syncService.OperatingMode = AddRemove
+
+		// A misconfigured source returns nothing.
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{"foo", "bar", "baz", "qux"}, nil)
+
+		err := syncService.SyncWith(ctx, destination)
+
+		assert.ErrorIs(t, err, ErrUnsafeRemoval)
+		assert.ErrorContains(t, err, "4 removals (100.0% of 4 things) exceeds the limit of 50.0%")
+	})
+
+	t.Run("Within limits", func(t *testing.T) {
+		t.Parallel()
+
+		source := newMemoryAdapter("foo", "bar", "baz")
+		destination := newMemoryAdapter("foo", "bar", "qux")
+
+		syncService := New(source, WithMaxRemovalCount(1), WithMaxRemovalPercent(50))
+
+		result, err := syncService.SyncWithResult(ctx, destination)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"qux"}, result.Removed)
+	})
+
+	t.Run("Dry run", func(t *testing.T) {
+		t.Parallel()
+
+		syncService := New(newMemoryAdapter(), WithMaxRemovalCount(1))
+		syncService.DryRun = true
+
+		result, err := syncService.SyncWithResult(ctx, newMemoryAdapter("foo", "bar"))
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Warnings, 1)
+		assert.ErrorIs(t, result.Warnings[0], ErrUnsafeRemoval)
+		assert.ElementsMatch(t, []string{"foo", "bar"}, result.WouldRemove)
+	})
+}
+
+// slowAdapter is an adapter whose Add blocks until ctx is done.
+type slowAdapter struct {
+	memoryAdapter
+}
+
+func (s slowAdapter) Add(ctx context.Context, _ []string) error {
+	<-ctx.Done()
+
+	return ctx.Err() //nolint:wrapcheck
+}
+
+func TestSync_WithMaxDuration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Cancelled at the deadline", func(t *testing.T) {
+		t.Parallel()
+
+		source := newMemoryAdapter("foo")
+		destination := slowAdapter{newMemoryAdapter("bar")}
+
+		syncService := New(source, WithMaxDuration(50*time.Millisecond))
+
+		start := time.Now()
+		result, err := syncService.SyncWithResult(ctx, destination)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), 5*time.Second)
+
+		// Progress made before the deadline is recorded.
+		assert.Equal(t, []string{"bar"}, result.Removed)
+		assert.Empty(t, result.Added)
+		assert.Len(t, result.Errors, 1)
+	})
+
+	t.Run("No deadline", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := New(newMemoryAdapter()).withDeadline(ctx)
+		defer cancel()
+
+		_, ok := ctx.Deadline()
+		assert.False(t, ok)
+	})
+}
+
+func TestWarn(t *testing.T) {
+	t.Parallel()
+
+	testWarning := errors.New("foo") //nolint:goerr113
+
+	// Warnings are discarded if the context doesn't collect them.
+	Warn(context.TODO(), testWarning)
+	assert.Empty(t, Warnings(context.TODO()))
+
+	ctx := ContextWithWarnings(context.TODO())
+	Warn(ctx, testWarning)
+	assert.Equal(t, []error{testWarning}, Warnings(ctx))
+}
+
+func TestSync_Metrics(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("Labels", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+		metrics := NewMockMetrics(t)
+
+		labels := map[string]string{"team": "foo", "environment": "prod"}
+		syncService := New(source, WithMetrics(metrics), WithLabels(labels))
+
+		// Labels are fixed when Sync is created.
+		labels["team"] = "bar"
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo", "bar"}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return([]string{"fizz"}, nil)
+		destination.EXPECT().Add(mock.Anything, mock.Anything).Once().Return(nil)
+		destination.EXPECT().Remove(mock.Anything, []string{"fizz"}).Once().Return(nil)
+		metrics.EXPECT().
+			Observe(map[string]string{"team": "foo", "environment": "prod", AdapterLabel: "*gosync.MockAdapter"}, 2, 1,
+				mock.Anything, nil).
+			Once()
+
+		err := syncService.SyncWith(ctx, destination)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewMockAdapter(t)
+		destination := NewMockAdapter(t)
+		metrics := NewMockMetrics(t)
+
+		syncService := New(source, WithMetrics(metrics))
+
+		testErr := errors.New("foo") //nolint:goerr113
+
+		source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+		destination.EXPECT().Get(mock.Anything).Once().Return(nil, testErr)
+		metrics.EXPECT().Observe(map[string]string{AdapterLabel: "*gosync.MockAdapter"}, 0, 0, mock.Anything, mock.Anything).
+			Run(func(_ map[string]string, _ int, _ int, _ time.Duration, err error) {
+				assert.ErrorIs(t, err, testErr)
+			}).
+			Once()
+
+		err := syncService.SyncWith(ctx, destination)
+
+		assert.ErrorIs(t, err, testErr)
+	})
+}
+
+// namedAdapter is a mocked adapter that implements Namer.
+type namedAdapter struct {
+	*MockAdapter
+}
+
+func (n *namedAdapter) Name() string {
+	return "test/named:foo"
+}
+
+func TestSync_Namer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	source := NewMockAdapter(t)
+	destination := &namedAdapter{NewMockAdapter(t)}
+	metrics := NewMockMetrics(t)
+
+	syncService := New(source, WithMetrics(metrics))
+
+	source.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+	destination.EXPECT().Get(mock.Anything).Once().Return([]string{"foo"}, nil)
+	metrics.EXPECT().Observe(map[string]string{AdapterLabel: "test/named:foo"}, 0, 0, mock.Anything, nil).Once()
+
+	result, err := syncService.SyncWithResult(ctx, destination)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "test/named:foo", result.Destination)
+	assert.Equal(t, "*gosync.MockAdapter", adapterName(source))
+}
@@ -0,0 +1,63 @@
+package gosync
+
+import "fmt"
+
+const (
+	// TypeEmail is the type of adapters that synchronise email addresses.
+	TypeEmail = "email"
+	// TypeUsername is the type of adapters that synchronise service-specific usernames.
+	TypeUsername = "username"
+)
+
+// isReadOnly returns true if the adapter has declared that it's read-only.
+func isReadOnly(adapter Adapter) bool {
+	readOnly, ok := adapter.(ReadOnlyAdapter)
+
+	return ok && readOnly.ReadOnly()
+}
+
+// adapterType returns the type of things an adapter synchronises, or an empty string if it doesn't declare one.
+func adapterType(adapter Adapter) string {
+	if typed, ok := adapter.(TypedAdapter); ok {
+		return typed.Type()
+	}
+
+	return ""
+}
+
+// Validate checks that a destination adapter is compatible with the source adapter, to catch misconfiguration before
+// anything is synchronised. Only adapters that implement ReadOnlyAdapter/TypedAdapter can be checked, so a nil error
+// doesn't guarantee that a sync will succeed.
+//
+// Adapters of different types (e.g. emails and usernames) are only compatible if a comparator has been set with
+// WithComparator, to resolve things of one type to the identities of the other. In dry run mode nothing is changed,
+// so read-only destinations are allowed.
+func (s *Sync) Validate(adapter Adapter) error {
+	if isReadOnly(adapter) && !s.DryRun {
+		if isReadOnly(s.source) {
+			return fmt.Errorf(
+				"sync.validate -> %w: both adapters are read-only, so there is nothing to do",
+				ErrIncompatibleAdapters,
+			)
+		}
+
+		return fmt.Errorf(
+			"sync.validate -> %w: the destination is read-only, and can only be used as a source",
+			ErrIncompatibleAdapters,
+		)
+	}
+
+	source, destination := adapterType(s.source), adapterType(adapter)
+
+	if source != "" && destination != "" && source != destination && s.comparator == nil {
+		return fmt.Errorf(
+			"sync.validate -> %w: the source synchronises %s things, but the destination synchronises %s things, "+
+				"and no comparator is set to resolve between them",
+			ErrIncompatibleAdapters,
+			source,
+			destination,
+		)
+	}
+
+	return nil
+}
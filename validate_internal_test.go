@@ -0,0 +1,83 @@
+package gosync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// readOnlyAdapter is a Recorder that declares it's read-only.
+type readOnlyAdapter struct {
+	*Recorder
+}
+
+func (r readOnlyAdapter) ReadOnly() bool {
+	return true
+}
+
+// typedAdapter is a Recorder that declares the type of things it synchronises.
+type typedAdapter struct {
+	*Recorder
+	thingType string
+}
+
+func (t typedAdapter) Type() string {
+	return t.thingType
+}
+
+func TestSync_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Compatible", func(t *testing.T) {
+		t.Parallel()
+
+		// Adapters that don't declare anything can't be checked.
+		assert.NoError(t, New(NewRecorder()).Validate(NewRecorder()))
+
+		source := typedAdapter{NewRecorder(), TypeEmail}
+		destination := typedAdapter{NewRecorder(), TypeEmail}
+		assert.NoError(t, New(source).Validate(destination))
+
+		// A read-only source is fine.
+		assert.NoError(t, New(readOnlyAdapter{NewRecorder()}).Validate(destination))
+	})
+
+	t.Run("Read-only pair", func(t *testing.T) {
+		t.Parallel()
+
+		err := New(readOnlyAdapter{NewRecorder()}).Validate(readOnlyAdapter{NewRecorder()})
+
+		assert.ErrorIs(t, err, ErrIncompatibleAdapters)
+		assert.ErrorContains(t, err, "both adapters are read-only")
+	})
+
+	t.Run("Read-only destination", func(t *testing.T) {
+		t.Parallel()
+
+		err := New(NewRecorder()).Validate(readOnlyAdapter{NewRecorder()})
+
+		assert.ErrorIs(t, err, ErrIncompatibleAdapters)
+		assert.ErrorContains(t, err, "destination is read-only")
+
+		// Nothing is changed in dry run mode, so a read-only destination is fine.
+		syncService := New(NewRecorder())
+		syncService.DryRun = true
+		assert.NoError(t, syncService.Validate(readOnlyAdapter{NewRecorder()}))
+	})
+
+	t.Run("Identity mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		source := typedAdapter{NewRecorder(), TypeUsername}
+		destination := typedAdapter{NewRecorder(), TypeEmail}
+
+		err := New(source).Validate(destination)
+
+		assert.ErrorIs(t, err, ErrIncompatibleAdapters)
+		assert.ErrorContains(t, err, "source synchronises username things, but the destination synchronises email")
+
+		// A comparator can resolve between the types.
+		resolver := WithComparator(func(thing string) string { return thing })
+		assert.NoError(t, New(source, resolver).Validate(destination))
+	})
+}